@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os"
 
 	"github.com/bool64/ctxd"
 	"github.com/dohernandez/qonto/internal/platform/app"
@@ -13,12 +14,26 @@ import (
 	grpcServer "github.com/dohernandez/qonto/pkg/grpc/server"
 	"github.com/dohernandez/qonto/pkg/must"
 	"github.com/dohernandez/qonto/pkg/servicing"
+	"github.com/dohernandez/qonto/pkg/worker"
 )
 
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "statement":
+			statementCommand(ctx, os.Args[2:])
+
+			return
+		case "pain001":
+			pain001Command(ctx, os.Args[2:])
+
+			return
+		}
+	}
+
 	// load configurations
 	cfg, err := config.GetConfig()
 	must.NotFail(ctxd.WrapError(ctx, err, "failed to load configurations"))
@@ -62,15 +77,53 @@ func main() {
 	srvREST, err := grpcRest.InitRESTService(
 		ctx,
 		grpcRest.InitRESTServiceConfig{
-			Listener:         restTListener,
-			Service:          deps.QontoRESTService,
-			UInterceptor:     deps.GRPCUnitaryInterceptors,
-			Handlers:         deps.Handlers,
-			ResponseModifier: deps.ResponseModifier,
+			Listener:              restTListener,
+			Service:               deps.QontoRESTService,
+			UInterceptor:          deps.GRPCUnitaryInterceptors,
+			Handlers:              deps.Handlers,
+			ResponseModifier:      deps.ResponseModifier,
+			IncomingHeaderMatcher: deps.IncomingHeaderMatcher,
+			Marshalers:            deps.Marshalers,
 		},
 	)
 	must.NotFail(ctxd.WrapError(ctx, err, "failed to init REST service"))
 
+	srvTransferBulkJob := worker.NewWorker(
+		"TransferBulkJob",
+		deps.TransactionBulkJob.ProcessNext,
+		worker.WithInterval(cfg.TransferBulkJob.PollInterval),
+		worker.WithErrorHandler(func(ctx context.Context, err error) {
+			deps.CtxdLogger().Error(ctx, "failed to process transfer bulk job", "error", err)
+		}),
+	)
+
+	srvStandingOrder := worker.NewWorker(
+		"StandingOrder",
+		deps.StandingOrder.ProcessNext,
+		worker.WithInterval(cfg.StandingOrder.PollInterval),
+		worker.WithErrorHandler(func(ctx context.Context, err error) {
+			deps.CtxdLogger().Error(ctx, "failed to execute standing order", "error", err)
+		}),
+	)
+
+	srvHold := worker.NewWorker(
+		"Hold",
+		deps.FundHold.ExpireNext,
+		worker.WithInterval(cfg.Hold.PollInterval),
+		worker.WithErrorHandler(func(ctx context.Context, err error) {
+			deps.CtxdLogger().Error(ctx, "failed to release expired hold", "error", err)
+		}),
+	)
+
+	srvScreening := worker.NewWorker(
+		"Screening",
+		deps.ScreeningList.Reload,
+		worker.WithInterval(cfg.Screening.ReloadInterval),
+		worker.WithErrorHandler(func(ctx context.Context, err error) {
+			deps.CtxdLogger().Error(ctx, "failed to reload screening list", "error", err)
+		}),
+	)
+
 	services := servicing.WithGracefulSutDown(
 		func(ctx context.Context) {
 			app.GracefulDBShutdown(ctx, deps)
@@ -85,6 +138,10 @@ func main() {
 		srvMetrics,
 		srvGRPC,
 		srvREST,
+		srvTransferBulkJob,
+		srvStandingOrder,
+		srvHold,
+		srvScreening,
 	)
 	must.NotFail(ctxd.WrapError(ctx, err, "failed to start the services"))
 }
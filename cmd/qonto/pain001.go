@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/platform/app"
+	"github.com/dohernandez/qonto/internal/platform/config"
+	"github.com/dohernandez/qonto/pkg/must"
+	api "github.com/dohernandez/qonto/pkg/proto"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// pain001Command performs the transfers of a SEPA pain.001.001.03 credit transfer initiation file and prints the
+// outcome.
+//
+// The transfers are submitted by the user id given, asserted by the operator running the command as the trusted proxy
+// does for the requests. The transfer bulk exceeding the approval threshold is refused without.
+//
+// qonto pain001 -file payroll.xml -mode partial -user alice
+func pain001Command(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("pain001", flag.ExitOnError)
+
+	file := fs.String("file", "", "pain.001.001.03 XML file")
+	idempotencyKey := fs.String("idempotency-key", "", "idempotency key, the file message id when empty")
+	async := fs.Bool("async", false, "accept the transfers as a job performed by the service in background")
+	mode := fs.String("mode", "atomic", "execution mode: atomic or partial")
+	validateOnly := fs.Bool("validate-only", false, "only check the transfers would be performed")
+	executionDate := fs.String("execution-date", "", "date the transfers are performed on by the service, YYYY-MM-DD")
+	user := fs.String("user", "", "user id asserted as the submitter, required above the approval threshold")
+
+	must.NotFail(fs.Parse(args))
+
+	req := api.ImportTransferBulkRequest{
+		IdempotencyKey:         *idempotencyKey,
+		Async:                  *async,
+		ValidateOnly:           *validateOnly,
+		RequestedExecutionDate: *executionDate,
+	}
+
+	switch *mode {
+	case "atomic":
+	case "partial":
+		req.ExecutionMode = api.TransferBulkRequest_EXECUTION_MODE_PARTIAL
+	default:
+		must.NotFail(ctxd.NewError(ctx, "invalid execution mode", "mode", *mode))
+	}
+
+	var err error
+
+	req.Document, err = os.ReadFile(*file)
+	must.NotFail(ctxd.WrapError(ctx, err, "failed to read the pain.001 file"))
+
+	cfg, err := config.GetConfig()
+	must.NotFail(ctxd.WrapError(ctx, err, "failed to load configurations"))
+
+	deps, err := app.NewServiceLocator(cfg)
+	must.NotFail(ctxd.WrapError(ctx, err, "failed to init locator"))
+
+	defer app.GracefulDBShutdown(ctx, deps)
+
+	// the user id is sent as the trusted proxy sends the X-User-Id header of the requests to the service.
+	if *user != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-user-id", *user))
+	}
+
+	resp, err := deps.QontoService.ImportTransferBulk(ctx, &req)
+	must.NotFail(ctxd.WrapError(ctx, err, "failed to import the pain.001 file"))
+
+	out, err := protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Marshal(resp)
+	must.NotFail(ctxd.WrapError(ctx, err, "failed to encode the outcome"))
+
+	fmt.Println(string(out))
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"io"
+	"os"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/platform/app"
+	"github.com/dohernandez/qonto/internal/platform/config"
+	"github.com/dohernandez/qonto/internal/platform/statement"
+	"github.com/dohernandez/qonto/pkg/must"
+)
+
+// statementCommand exports an account statement into a file, or the standard output.
+//
+// qonto statement -iban FR10474608000002006107XXXXX -from 2021-12-01 -to 2021-12-31 -format camt053 -out statement.xml
+func statementCommand(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("statement", flag.ExitOnError)
+
+	iban := fs.String("iban", "", "IBAN of the bank account")
+	from := fs.String("from", "", "first day of the statement, YYYY-MM-DD")
+	to := fs.String("to", "", "last day of the statement, YYYY-MM-DD")
+	format := fs.String("format", string(statement.FormatCSV), "statement format: camt053, mt940, csv or ofx")
+	out := fs.String("out", "", "output file, the standard output when empty")
+
+	must.NotFail(fs.Parse(args))
+
+	f, err := statement.ParseFormat(*format)
+	must.NotFail(ctxd.WrapError(ctx, err, "invalid statement format"))
+
+	cfg, err := config.GetConfig()
+	must.NotFail(ctxd.WrapError(ctx, err, "failed to load configurations"))
+
+	deps, err := app.NewServiceLocator(cfg)
+	must.NotFail(ctxd.WrapError(ctx, err, "failed to init locator"))
+
+	defer app.GracefulDBShutdown(ctx, deps)
+
+	var w io.Writer = os.Stdout
+
+	if *out != "" {
+		file, err := os.Create(*out)
+		must.NotFail(ctxd.WrapError(ctx, err, "failed to create the statement file"))
+
+		defer func() {
+			must.NotFail(ctxd.WrapError(ctx, file.Close(), "failed to close the statement file"))
+		}()
+
+		w = file
+	}
+
+	bw := bufio.NewWriter(w)
+
+	err = deps.StatementExporter.Export(ctx, bw, statement.Request{
+		Iban:   *iban,
+		From:   *from,
+		To:     *to,
+		Format: f,
+	})
+	must.NotFail(ctxd.WrapError(ctx, err, "failed to export the statement"))
+
+	must.NotFail(ctxd.WrapError(ctx, bw.Flush(), "failed to write the statement"))
+}
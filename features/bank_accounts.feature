@@ -0,0 +1,57 @@
+Feature: Bank account details
+  As a customer, I want to know the balance of my account
+  without trying a transfer.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         | currency |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX | EUR      |
+
+  Scenario: Getting the bank account by id
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/1"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "organizationName": "ACME Corp",
+      "iban": "FR10474608000002006107XXXXX",
+      "bic": "OIVUSCLQXXX",
+      "currency": "EUR",
+      "balanceCents": "10000000",
+      "availableBalanceCents": "10000000",
+      "overdraftLimitCents": "0",
+      "overdraftUsedCents": "0"
+    }
+    """
+
+  Scenario: Getting the bank account by iban and bic
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts?iban=FR10474608000002006107XXXXX&bic=OIVUSCLQXXX"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "organizationName": "ACME Corp",
+      "iban": "FR10474608000002006107XXXXX",
+      "bic": "OIVUSCLQXXX",
+      "currency": "EUR",
+      "balanceCents": "10000000",
+      "availableBalanceCents": "10000000",
+      "overdraftLimitCents": "0",
+      "overdraftUsedCents": "0"
+    }
+    """
+
+  Scenario: Rejected request, neither id nor iban and bic
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts?iban=FR10474608000002006107XXXXX"
+
+    Then I should have response with status "Bad Request"
+
+  Scenario: Rejected request, account not found
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/2"
+
+    Then I should have response with status "Not Found"
@@ -0,0 +1,174 @@
+Feature: Holds
+  As an organization, I want amounts of my account reserved for pending operations, e.g. card authorizations,
+  so that the transfers cannot spend the money those operations debit later.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 100000        | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+    And the clock is set to "2021-12-13T09:00:00Z"
+
+  Scenario: Held amount not spent by the transfers, then captured
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/holds"
+    And I request HTTP endpoint with body
+    """
+    {
+      "amountCents": "80000",
+      "counterpartyName": "Hotel California",
+      "description": "Room 42"
+    }
+    """
+
+    Then I should have response with status "Created"
+    And I should have response with body
+    """
+    {
+      "hold": {
+        "id": "1",
+        "amountCents": "80000",
+        "currency": "EUR",
+        "counterpartyName": "Hotel California",
+        "description": "Room 42",
+        "status": "active",
+        "capturedCents": "0",
+        "transactionId": "0",
+        "expiresAt": "2021-12-20T09:00:00Z",
+        "createdAt": "2021-12-13T09:00:00Z"
+      },
+      "balanceCents": "100000",
+      "availableBalanceCents": "20000",
+      "currency": "EUR"
+    }
+    """
+
+    # the balance covers the transfer, the available balance does not.
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "ACME Corp",
+      "organization_bic": "OIVUSCLQXXX",
+      "organization_iban": "FR10474608000002006107XXXXX",
+      "credit_transfers": [
+        {
+          "amount": "300",
+          "currency": "EUR",
+          "counterparty_name": "Bip Bip",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "EE303680981021245685",
+          "description": "Wonderland/4410"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Unprocessable Entity"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/holds/1/capture"
+    And I request HTTP endpoint with body
+    """
+    {"amountCents": "75000"}
+    """
+
+    Then I should have response with status "OK"
+    And these rows are available in table "holds" of database "postgres":
+      | id | bank_account_id | amount_cents | status   | captured_cents | transaction_id |
+      | 1  | 1               | 80000        | captured | 75000          | 1              |
+    And these rows are available in table "transactions" of database "postgres":
+      | id | bank_account_id | counterparty_name | amount_cents | debited_cents |
+      | 1  | 1               | Hotel California  | 75000        | 75000         |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents | held_cents |
+      | 1  | 25000         | 0          |
+    And these rows are available in table "postings" of database "postgres":
+      | journal_entry_id | ledger_account     | bank_account_id | transaction_id | amount_cents | currency |
+      | 1                | bank_account       | 1               | 0              | -75000       | EUR      |
+      | 1                | outgoing_transfers | 0               | 1              | 75000        | EUR      |
+
+    # a captured hold is closed.
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/holds/1/release"
+
+    Then I should have response with status "Conflict"
+
+  Scenario: Hold released
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/holds"
+    And I request HTTP endpoint with body
+    """
+    {"amountCents": "80000", "counterpartyName": "Hotel California"}
+    """
+
+    Then I should have response with status "Created"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/holds/1/release"
+
+    Then I should have response with status "OK"
+    And these rows are available in table "holds" of database "postgres":
+      | id | status   | captured_cents |
+      | 1  | released | 0              |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents | held_cents |
+      | 1  | 100000        | 0          |
+    And no rows are available in table "transactions" of database "postgres"
+
+  Scenario: Expired hold released by the worker
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/holds"
+    And I request HTTP endpoint with body
+    """
+    {"amountCents": "80000", "counterpartyName": "Hotel California", "expiresAt": "2021-12-14T09:00:00Z"}
+    """
+
+    Then I should have response with status "Created"
+
+    When the clock is set to "2021-12-14T09:00:00Z"
+    And the expired holds are released
+
+    Then these rows are available in table "holds" of database "postgres":
+      | id | status  |
+      | 1  | expired |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents | held_cents |
+      | 1  | 100000        | 0          |
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/holds/1/capture"
+    And I request HTTP endpoint with body
+    """
+    {}
+    """
+
+    Then I should have response with status "Conflict"
+
+  Scenario: Rejected hold, not enough available balance
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/holds"
+    And I request HTTP endpoint with body
+    """
+    {"amountCents": "100001", "counterpartyName": "Hotel California"}
+    """
+
+    Then I should have response with status "Conflict"
+    And no rows are available in table "holds" of database "postgres"
+
+  Scenario: Rejected hold, invalid input
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/holds"
+    And I request HTTP endpoint with body
+    """
+    {"amountCents": "0", "counterpartyName": "Hotel California", "expiresAt": "tomorrow"}
+    """
+
+    Then I should have response with status "Bad Request"
+    And I should have response with body
+    """
+    {
+      "code": 3,
+      "message": "<ignore-diff>",
+      "details": [
+        {
+          "@type": "type.googleapis.com/google.rpc.BadRequest",
+          "fieldViolations": [
+            {"field": "amount_cents", "description": "must be positive"},
+            {"field": "expires_at", "description": "must be a RFC 3339 time"}
+          ]
+        }
+      ]
+    }
+    """
@@ -0,0 +1,62 @@
+Feature: Importing pain.001 files
+  As a customer, I want to perform the transfers of the SEPA pain.001 credit transfer
+  initiation files our ERP produces, without converting them by hand.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+
+  Scenario: Importing a pain.001 file successfully
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/pain001"
+    And I request HTTP endpoint with header "Content-Type: application/xml"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/pain001.xml
+    """
+
+    Then I should have response with status "Created"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "<ignore-diff>",
+      "transactionIds": ["1", "2", "3"],
+      "debitedCents": "6225150",
+      "feesCents": "0",
+      "balanceCents": "3774850",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "executed",
+      "rows": [
+        {"row": 0, "status": "executed", "transactionId": "1", "reason": ""},
+        {"row": 1, "status": "executed", "transactionId": "2", "reason": ""},
+        {"row": 2, "status": "executed", "transactionId": "3", "reason": ""}
+      ]
+    }
+    """
+    And these rows are available in table "transactions" of database "postgres":
+      | counterparty_name | counterparty_iban           | counterparty_bic | amount_cents | amount_currency | bank_account_id | description                         |
+      | Bip Bip           | EE303680981021245685        | CRLYFRPPTOU      | 1450         | EUR             | 1               | Wonderland/4410                     |
+      | Wile E Coyote     | DE44354208100362090817      | ZDRPLBQI         | 6123800      | EUR             | 1               | //TeslaMotors/Invoice/12            |
+      | Bugs Bunny        | FR9810009380540930414023042 | RNJZNTMC         | 99900        | EUR             | 1               | 2020 09 24/2020 09 25/GoldenCarrot/ |
+    And these rows are available in table "transfer_bulks" of database "postgres":
+      | idempotency_key |
+      | PAYROLL-2021-11 |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 3774850       |
+
+  Scenario: Importing a pain.001 file which control sum does not match its transfers
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/pain001"
+    And I request HTTP endpoint with header "Content-Type: application/xml"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/pain001_ctrlsum_mismatch.xml
+    """
+
+    Then I should have response with status "Bad Request"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 10000000      |
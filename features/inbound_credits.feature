@@ -0,0 +1,165 @@
+Feature: Inbound credits
+  As a bank, I want to book the credit transfers received by an account, once per reference of the sender bank,
+  so that a credit transfer delivered twice does not credit the account twice.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 100000        | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+    And the clock is set to "2021-12-11T09:00:00Z"
+
+  Scenario: Credit transfer booked once
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/credits"
+    And I request HTTP endpoint with body
+    """
+    {
+      "amount": "150",
+      "currency": "EUR",
+      "counterpartyName": "Road Runner",
+      "counterpartyIban": "FR1420041010050500013M02606",
+      "counterpartyBic": "CRLYFRPPTOU",
+      "description": "Invoice 42",
+      "externalReference": "E2E-2021-12-11-001"
+    }
+    """
+
+    Then I should have response with status "Created"
+    And I should have response with body
+    """
+    {
+      "transaction": {
+        "id": "1",
+        "counterpartyName": "Road Runner",
+        "counterpartyIban": "FR1420041010050500013M02606",
+        "counterpartyBic": "CRLYFRPPTOU",
+        "amountCents": "-15000",
+        "amountCurrency": "EUR",
+        "debitedCents": "-15000",
+        "debitedCurrency": "EUR",
+        "exchangeRate": "1",
+        "description": "Invoice 42",
+        "transferBulkId": "0",
+        "createdAt": "2021-12-11T09:00:00Z",
+        "reversedTransactionId": "0",
+        "reversalReason": "",
+        "externalReference": "E2E-2021-12-11-001",
+        "internal": false,
+        "chargedTransactionId": "0"
+      },
+      "balanceCents": "115000",
+      "currency": "EUR"
+    }
+    """
+
+    # the same credit transfer delivered again is not booked twice.
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/credits"
+    And I request HTTP endpoint with body
+    """
+    {
+      "amount": "150",
+      "currency": "EUR",
+      "counterpartyName": "Road Runner",
+      "counterpartyIban": "FR1420041010050500013M02606",
+      "counterpartyBic": "CRLYFRPPTOU",
+      "description": "Invoice 42",
+      "externalReference": "E2E-2021-12-11-001"
+    }
+    """
+
+    Then I should have response with status "Created"
+    And these rows are available in table "transactions" of database "postgres":
+      | id | bank_account_id | debited_cents | external_reference |
+      | 1  | 1               | -15000        | E2E-2021-12-11-001 |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 115000        |
+    And these rows are available in table "postings" of database "postgres":
+      | journal_entry_id | ledger_account     | bank_account_id | transaction_id | amount_cents | currency |
+      | 1                | bank_account       | 1               | 0              | 15000        | EUR      |
+      | 1                | incoming_transfers | 0               | 1              | -15000       | EUR      |
+
+  Scenario: Rejected credit transfer, external reference already used with a different credit transfer
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/credits"
+    And I request HTTP endpoint with body
+    """
+    {
+      "amount": "150",
+      "currency": "EUR",
+      "counterpartyName": "Road Runner",
+      "counterpartyIban": "FR1420041010050500013M02606",
+      "counterpartyBic": "CRLYFRPPTOU",
+      "description": "Invoice 42",
+      "externalReference": "E2E-2021-12-11-001"
+    }
+    """
+
+    Then I should have response with status "Created"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/credits"
+    And I request HTTP endpoint with body
+    """
+    {
+      "amount": "200",
+      "currency": "EUR",
+      "counterpartyName": "Road Runner",
+      "counterpartyIban": "FR1420041010050500013M02606",
+      "counterpartyBic": "CRLYFRPPTOU",
+      "description": "Invoice 43",
+      "externalReference": "E2E-2021-12-11-001"
+    }
+    """
+
+    Then I should have response with status "Bad Request"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 115000        |
+
+  Scenario: Rejected credit transfer, invalid input
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/credits"
+    And I request HTTP endpoint with body
+    """
+    {
+      "amount": "-150",
+      "currency": "EUR",
+      "counterpartyName": "Road Runner",
+      "counterpartyIban": "FR1420041010050500013M02606",
+      "counterpartyBic": "CRLYFRPPTOU",
+      "description": "Invoice 42"
+    }
+    """
+
+    Then I should have response with status "Bad Request"
+    And I should have response with body
+    """
+    {
+      "code": 3,
+      "message": "<ignore-diff>",
+      "details": [
+        {
+          "@type": "type.googleapis.com/google.rpc.BadRequest",
+          "fieldViolations": [
+            {"field": "amount", "description": "invalid amount: must be positive"},
+            {"field": "external_reference", "description": "must not be empty"}
+          ]
+        }
+      ]
+    }
+    """
+
+  Scenario: Rejected credit transfer, account not found
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR1420041010050500013M02606/credits"
+    And I request HTTP endpoint with body
+    """
+    {
+      "amount": "150",
+      "currency": "EUR",
+      "counterpartyName": "Road Runner",
+      "counterpartyIban": "EE303680981021245685",
+      "counterpartyBic": "CRLYFRPPTOU",
+      "description": "Invoice 42",
+      "externalReference": "E2E-2021-12-11-001"
+    }
+    """
+
+    Then I should have response with status "Not Found"
@@ -0,0 +1,115 @@
+Feature: Internal transfers
+  As an organization, I want my transfers to another account of the bank to credit that account
+  so that the money is received at once, without leaving the bank.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 100000        | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+      | 2  | Road Runner       | 5000          | FR1420041010050500013M02606 | CRLYFRPPTOU |
+
+  Scenario: Internal credit transfer credits the receiving account
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "ACME Corp",
+      "organization_bic": "OIVUSCLQXXX",
+      "organization_iban": "FR10474608000002006107XXXXX",
+      "credit_transfers": [
+        {
+          "amount": "100",
+          "currency": "EUR",
+          "counterparty_name": "Road Runner",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "FR1420041010050500013M02606",
+          "description": "Seeds"
+        },
+        {
+          "amount": "20",
+          "currency": "EUR",
+          "counterparty_name": "Bip Bip",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "EE303680981021245685",
+          "description": "Wonderland/4410"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Created"
+    And these rows are available in table "transactions" of database "postgres":
+      | id | bank_account_id | counterparty_iban           | amount_cents | debited_cents | transfer_bulk_id | internal |
+      | 1  | 1               | FR1420041010050500013M02606 | 10000        | 10000         | 1                | true     |
+      | 2  | 1               | EE303680981021245685        | 2000         | 2000          | 1                | false    |
+      | 3  | 2               | FR10474608000002006107XXXXX | -10000       | -10000        | 0                | true     |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 88000         |
+      | 2  | 15000         |
+    And these rows are available in table "postings" of database "postgres":
+      | journal_entry_id | ledger_account     | bank_account_id | transaction_id | amount_cents | currency |
+      | 1                | bank_account       | 1               | 0              | -12000       | EUR      |
+      | 1                | outgoing_transfers | 0               | 1              | 10000        | EUR      |
+      | 1                | outgoing_transfers | 0               | 2              | 2000         | EUR      |
+      | 1                | bank_account       | 2               | 0              | 10000        | EUR      |
+      | 1                | incoming_transfers | 0               | 3              | -10000       | EUR      |
+
+    # the receiver sends the money back, the accounts are locked in the same order.
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "Road Runner",
+      "organization_bic": "CRLYFRPPTOU",
+      "organization_iban": "FR1420041010050500013M02606",
+      "credit_transfers": [
+        {
+          "amount": "150",
+          "currency": "EUR",
+          "counterparty_name": "ACME Corp",
+          "counterparty_bic": "OIVUSCLQXXX",
+          "counterparty_iban": "FR10474608000002006107XXXXX",
+          "description": "Refund"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Created"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 103000        |
+      | 2  | 0             |
+
+  Scenario: Internal credit transfer not reversible
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "ACME Corp",
+      "organization_bic": "OIVUSCLQXXX",
+      "organization_iban": "FR10474608000002006107XXXXX",
+      "credit_transfers": [
+        {
+          "amount": "100",
+          "currency": "EUR",
+          "counterparty_name": "Road Runner",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "FR1420041010050500013M02606",
+          "description": "Seeds"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Created"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/1/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "duplicate"}
+    """
+
+    Then I should have response with status "Conflict"
@@ -0,0 +1,72 @@
+Feature: Ledger
+  As an accountant, I want every balance change booked as balanced journal entries so that
+  the balance of every account can be reconciled with its history.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+    And these rows are stored in table "journal_entries" of database "postgres":
+      | id | description     |
+      | 1  | Opening balance |
+    And these rows are stored in table "postings" of database "postgres":
+      | id | journal_entry_id | ledger_account   | bank_account_id | amount_cents | currency |
+      | 1  | 1                | bank_account     | 1               | 10000000     | EUR      |
+      | 2  | 1                | opening_balances | 0               | -10000000    | EUR      |
+
+  Scenario: Performing transfers posts a single balanced journal entry
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample1.json
+    """
+
+    Then I should have response with status "Created"
+    And these rows are available in table "journal_entries" of database "postgres":
+      | id | transfer_bulk_id | description     |
+      | 1  | 0                | Opening balance |
+      | 2  | 1                | Transfer bulk   |
+    And these rows are available in table "postings" of database "postgres":
+      | journal_entry_id | ledger_account     | bank_account_id | transaction_id | amount_cents | currency |
+      | 1                | bank_account       | 1               | 0              | 10000000     | EUR      |
+      | 1                | opening_balances   | 0               | 0              | -10000000    | EUR      |
+      | 2                | bank_account       | 1               | 0              | -6225150     | EUR      |
+      | 2                | outgoing_transfers | 0               | 1              | 1450         | EUR      |
+      | 2                | outgoing_transfers | 0               | 2              | 6123800      | EUR      |
+      | 2                | outgoing_transfers | 0               | 3              | 99900        | EUR      |
+
+    When I request HTTP endpoint with method "GET" and URI "/v1/ledger/check"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "consistent": true,
+      "balanceDiscrepancies": [],
+      "unbalancedJournalEntryIds": []
+    }
+    """
+
+  Scenario: Checking the ledger reports balances not derived from postings
+    Given these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 2  | Wonka Industries  | 500000        | FR7630006000011234567890189 | AGRIFRPPXXX |
+
+    When I request HTTP endpoint with method "GET" and URI "/v1/ledger/check"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "consistent": false,
+      "balanceDiscrepancies": [
+        {
+          "bankAccountId": "2",
+          "balanceCents": "500000",
+          "postingsCents": "0"
+        }
+      ],
+      "unbalancedJournalEntryIds": []
+    }
+    """
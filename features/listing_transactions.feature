@@ -0,0 +1,141 @@
+Feature: Listing transactions
+  As a customer, I want to list and fetch the transactions of my account
+  without querying the database.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+      | 2  | Road Runner       | 10000000      | FR1420041010050500013M02606 | CRLYFRPPTOU |
+    And these rows are stored in table "transactions" of database "postgres":
+      | id | counterparty_name | counterparty_iban           | counterparty_bic | amount_cents | amount_currency | debited_cents | debited_currency | exchange_rate | bank_account_id | description              | created_at           |
+      | 1  | Bip Bip           | EE303680981021245685        | CRLYFRPPTOU      | 1450         | EUR             | 1450          | EUR              | 1             | 1               | Wonderland/4410          | 2021-12-01T09:00:00Z |
+      | 2  | Wile E Coyote     | DE44354208100362090817      | ZDRPLBQI         | 6123800      | EUR             | 6123800       | EUR              | 1             | 1               | //TeslaMotors/Invoice/12 | 2021-12-02T09:00:00Z |
+      | 3  | Bugs Bunny        | FR9810009380540930414023042 | RNJZNTMC         | 99900        | EUR             | 99900         | EUR              | 1             | 1               | GoldenCarrot             | 2021-12-03T09:00:00Z |
+      | 4  | Bip Bip           | EE303680981021245685        | CRLYFRPPTOU      | 2000         | EUR             | 2000          | EUR              | 1             | 2               | Wonderland/4411          | 2021-12-03T09:00:00Z |
+
+  Scenario: Listing transactions newest first, a page at a time
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions?page_size=2"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "transactions": [
+        {
+          "id": "3",
+          "counterpartyName": "Bugs Bunny",
+          "counterpartyIban": "FR9810009380540930414023042",
+          "counterpartyBic": "RNJZNTMC",
+          "amountCents": "99900",
+          "amountCurrency": "EUR",
+          "debitedCents": "99900",
+          "debitedCurrency": "EUR",
+          "exchangeRate": "1",
+          "description": "GoldenCarrot",
+          "transferBulkId": "0",
+          "createdAt": "2021-12-03T09:00:00Z",
+          "reversedTransactionId": "0",
+          "reversalReason": "",
+          "externalReference": "",
+          "internal": false,
+          "chargedTransactionId": "0"
+        },
+        {
+          "id": "2",
+          "counterpartyName": "Wile E Coyote",
+          "counterpartyIban": "DE44354208100362090817",
+          "counterpartyBic": "ZDRPLBQI",
+          "amountCents": "6123800",
+          "amountCurrency": "EUR",
+          "debitedCents": "6123800",
+          "debitedCurrency": "EUR",
+          "exchangeRate": "1",
+          "description": "//TeslaMotors/Invoice/12",
+          "transferBulkId": "0",
+          "createdAt": "2021-12-02T09:00:00Z",
+          "reversedTransactionId": "0",
+          "reversalReason": "",
+          "externalReference": "",
+          "internal": false,
+          "chargedTransactionId": "0"
+        }
+      ],
+      "nextPageToken": "<ignore-diff>"
+    }
+    """
+
+  Scenario: Listing transactions matching the filters
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions?counterparty=bip&from=2021-12-01T00:00:00Z&to=2021-12-31T00:00:00Z&max_amount_cents=5000&sort=SORT_OLDEST_FIRST"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "transactions": [
+        {
+          "id": "1",
+          "counterpartyName": "Bip Bip",
+          "counterpartyIban": "EE303680981021245685",
+          "counterpartyBic": "CRLYFRPPTOU",
+          "amountCents": "1450",
+          "amountCurrency": "EUR",
+          "debitedCents": "1450",
+          "debitedCurrency": "EUR",
+          "exchangeRate": "1",
+          "description": "Wonderland/4410",
+          "transferBulkId": "0",
+          "createdAt": "2021-12-01T09:00:00Z",
+          "reversedTransactionId": "0",
+          "reversalReason": "",
+          "externalReference": "",
+          "internal": false,
+          "chargedTransactionId": "0"
+        }
+      ],
+      "nextPageToken": ""
+    }
+    """
+
+  Scenario: Rejected listing, invalid filters
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions?from=yesterday&page_size=1000"
+
+    Then I should have response with status "Bad Request"
+
+  Scenario: Rejected listing, account not found
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/FR7630006000011234567890189/transactions"
+
+    Then I should have response with status "Not Found"
+
+  Scenario: Fetching a transaction
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/2"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "2",
+      "counterpartyName": "Wile E Coyote",
+      "counterpartyIban": "DE44354208100362090817",
+      "counterpartyBic": "ZDRPLBQI",
+      "amountCents": "6123800",
+      "amountCurrency": "EUR",
+      "debitedCents": "6123800",
+      "debitedCurrency": "EUR",
+      "exchangeRate": "1",
+      "description": "//TeslaMotors/Invoice/12",
+      "transferBulkId": "0",
+      "createdAt": "2021-12-02T09:00:00Z",
+      "reversedTransactionId": "0",
+      "reversalReason": "",
+      "externalReference": "",
+      "internal": false,
+      "chargedTransactionId": "0"
+    }
+    """
+
+  Scenario: Rejected fetching, transaction of another account
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/4"
+
+    Then I should have response with status "Not Found"
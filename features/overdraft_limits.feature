@@ -0,0 +1,135 @@
+Feature: Overdraft limits
+  As a business customer with an agreed overdraft facility, I want my transfers to take my balance negative
+  down to the agreed limit, and to know how much of the overdraft I use.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000         | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+
+  Scenario: Transfers going negative within the overdraft limit
+    When I request HTTP endpoint with method "PUT" and URI "/v1/admin/accounts/FR10474608000002006107XXXXX/overdraft"
+    And I request HTTP endpoint with body
+    """
+    {"limitCents": "50000"}
+    """
+
+    Then I should have response with status "OK"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents | overdraft_limit_cents |
+      | 1  | 10000         | 50000                 |
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "ACME Corp",
+      "organization_bic": "OIVUSCLQXXX",
+      "organization_iban": "FR10474608000002006107XXXXX",
+      "credit_transfers": [
+        {
+          "amount": "400",
+          "currency": "EUR",
+          "counterparty_name": "Bip Bip",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "EE303680981021245685",
+          "description": "Wonderland/4410"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Created"
+
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/1"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "organizationName": "ACME Corp",
+      "iban": "FR10474608000002006107XXXXX",
+      "bic": "OIVUSCLQXXX",
+      "currency": "EUR",
+      "balanceCents": "-30000",
+      "availableBalanceCents": "-30000",
+      "overdraftLimitCents": "50000",
+      "overdraftUsedCents": "30000"
+    }
+    """
+
+    # the overdraft left does not cover the transfer.
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "ACME Corp",
+      "organization_bic": "OIVUSCLQXXX",
+      "organization_iban": "FR10474608000002006107XXXXX",
+      "credit_transfers": [
+        {
+          "amount": "200.01",
+          "currency": "EUR",
+          "counterparty_name": "Bip Bip",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "EE303680981021245685",
+          "description": "Wonderland/4411"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Unprocessable Entity"
+    And I should have response with body
+    """
+    {
+      "code": 9,
+      "message": "bank account overdraft limit exceeded",
+      "details": "<ignore-diff>"
+    }
+    """
+
+  Scenario: Transfers without overdraft facility
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "ACME Corp",
+      "organization_bic": "OIVUSCLQXXX",
+      "organization_iban": "FR10474608000002006107XXXXX",
+      "credit_transfers": [
+        {
+          "amount": "100.01",
+          "currency": "EUR",
+          "counterparty_name": "Bip Bip",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "EE303680981021245685",
+          "description": "Wonderland/4410"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Unprocessable Entity"
+    And I should have response with body
+    """
+    {
+      "code": 9,
+      "message": "bank account not enough balance",
+      "details": "<ignore-diff>"
+    }
+    """
+
+  Scenario: Rejected overdraft limit, negative limit
+    When I request HTTP endpoint with method "PUT" and URI "/v1/admin/accounts/FR10474608000002006107XXXXX/overdraft"
+    And I request HTTP endpoint with body
+    """
+    {"limitCents": "-1"}
+    """
+
+    Then I should have response with status "Bad Request"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | overdraft_limit_cents |
+      | 1  | 0                     |
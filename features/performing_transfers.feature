@@ -20,13 +20,92 @@ Feature: Performing transfers
     And I should have other responses with status "Unprocessable Entity"
     And these rows are available in table "transactions" of database "postgres":
       | counterparty_name | counterparty_iban           | counterparty_bic | amount_cents | amount_currency | bank_account_id | description                         |
-      | Bip Bip           | EE383680981021245685        | CRLYFRPPTOU      | 1450         | EUR             | 1               | Wonderland/4410                     |
-      | Wile E Coyote     | DE9935420810036209081725212 | ZDRPLBQI         | 6123800      | EUR             | 1               | //TeslaMotors/Invoice/12            |
-      | Bugs Bunny        | FR0010009380540930414023042 | RNJZNTMC         | 99900        | EUR             | 1               | 2020 09 24/2020 09 25/GoldenCarrot/ |
+      | Bip Bip           | EE303680981021245685        | CRLYFRPPTOU      | 1450         | EUR             | 1               | Wonderland/4410                     |
+      | Wile E Coyote     | DE44354208100362090817      | ZDRPLBQI         | 6123800      | EUR             | 1               | //TeslaMotors/Invoice/12            |
+      | Bugs Bunny        | FR9810009380540930414023042 | RNJZNTMC         | 99900        | EUR             | 1               | 2020 09 24/2020 09 25/GoldenCarrot/ |
     And these rows are available in table "bank_accounts" of database "postgres":
       | id | balance_cents |
       | 1  | 3774850       |
 
+  Scenario: Performing transfers with idempotency key only once
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with header "Idempotency-Key: 6f1d7e1a-payroll-2021-11"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample1.json
+    """
+    And I concurrently request idempotent HTTP endpoint
+
+    Then I should have response with status "Created"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "<ignore-diff>",
+      "transactionIds": ["1", "2", "3"],
+      "debitedCents": "6225150",
+      "feesCents": "0",
+      "balanceCents": "3774850",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "executed",
+      "rows": [
+        {"row": 0, "status": "executed", "transactionId": "1", "reason": ""},
+        {"row": 1, "status": "executed", "transactionId": "2", "reason": ""},
+        {"row": 2, "status": "executed", "transactionId": "3", "reason": ""}
+      ]
+    }
+    """
+    And I should have other responses with status "Created"
+    And I should have other responses with body
+    """
+    {
+      "transferBulkId": "<ignore-diff>",
+      "transactionIds": ["1", "2", "3"],
+      "debitedCents": "6225150",
+      "feesCents": "0",
+      "balanceCents": "3774850",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "executed",
+      "rows": [
+        {"row": 0, "status": "executed", "transactionId": "1", "reason": ""},
+        {"row": 1, "status": "executed", "transactionId": "2", "reason": ""},
+        {"row": 2, "status": "executed", "transactionId": "3", "reason": ""}
+      ]
+    }
+    """
+    And these rows are available in table "transactions" of database "postgres":
+      | counterparty_name | counterparty_iban           | counterparty_bic | amount_cents | amount_currency | bank_account_id | description                         |
+      | Bip Bip           | EE303680981021245685        | CRLYFRPPTOU      | 1450         | EUR             | 1               | Wonderland/4410                     |
+      | Wile E Coyote     | DE44354208100362090817      | ZDRPLBQI         | 6123800      | EUR             | 1               | //TeslaMotors/Invoice/12            |
+      | Bugs Bunny        | FR9810009380540930414023042 | RNJZNTMC         | 99900        | EUR             | 1               | 2020 09 24/2020 09 25/GoldenCarrot/ |
+    And these rows are available in table "transfer_bulks" of database "postgres":
+      | idempotency_key          | bank_account_id | debited_cents | balance_cents |
+      | 6f1d7e1a-payroll-2021-11 | 1               | 6225150       | 3774850       |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 3774850       |
+
+  Scenario: Rejected transfers, idempotency key used with a different request
+    Given these rows are stored in table "transfer_bulks" of database "postgres":
+      | id | idempotency_key          | request_hash |
+      | 1  | 6f1d7e1a-payroll-2021-11 | other        |
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with header "Idempotency-Key: 6f1d7e1a-payroll-2021-11"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample1.json
+    """
+
+    Then I should have response with status "Bad Request"
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 10000000      |
+
   Scenario: Unprocessable transfers, not enough balance
     When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
     And I request HTTP endpoint with body from file
@@ -38,4 +117,196 @@ Feature: Performing transfers
     And no rows are available in table "transactions" of database "postgres"
     And these rows are available in table "bank_accounts" of database "postgres":
       | id | balance_cents |
-      | 1  | 10000000      |
\ No newline at end of file
+      | 1  | 10000000      |
+
+  Scenario: Validating transfers without performing them
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample11.json
+    """
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "0",
+      "transactionIds": [],
+      "debitedCents": "6225150",
+      "feesCents": "0",
+      "balanceCents": "3774850",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "",
+      "rows": [
+        {"row": 0, "status": "executable", "transactionId": "0", "reason": ""},
+        {"row": 1, "status": "executable", "transactionId": "0", "reason": ""},
+        {"row": 2, "status": "executable", "transactionId": "0", "reason": ""}
+      ]
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+    And no rows are available in table "transfer_bulks" of database "postgres"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 10000000      |
+
+  Scenario: Performing transfers partially, transfers not covered by the balance rejected
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample10.json
+    """
+
+    Then I should have response with status "Created"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "<ignore-diff>",
+      "transactionIds": ["1", "2"],
+      "debitedCents": "101350",
+      "feesCents": "0",
+      "balanceCents": "9898650",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "executed",
+      "rows": [
+        {"row": 0, "status": "executed", "transactionId": "1", "reason": ""},
+        {"row": 1, "status": "rejected", "transactionId": "0", "reason": "not enough balance"},
+        {"row": 2, "status": "executed", "transactionId": "2", "reason": ""}
+      ]
+    }
+    """
+    And these rows are available in table "transactions" of database "postgres":
+      | counterparty_name | amount_cents | amount_currency | bank_account_id | description                         |
+      | Bip Bip           | 1450         | EUR             | 1               | Wonderland/4410                     |
+      | Bugs Bunny        | 99900        | EUR             | 1               | 2020 09 24/2020 09 25/GoldenCarrot/ |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 9898650       |
+
+  Scenario: Performing transfers with exact decimal amounts
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample3.json
+    """
+
+    Then I should have response with status "Created"
+    And these rows are available in table "transactions" of database "postgres":
+      | counterparty_name | counterparty_iban           | counterparty_bic | amount_cents | amount_currency | bank_account_id | description              |
+      | Bip Bip           | EE303680981021245685        | CRLYFRPPTOU      | 29           | EUR             | 1               | Wonderland/4411          |
+      | Wile E Coyote     | DE44354208100362090817      | ZDRPLBQI         | 115          | EUR             | 1               | //TeslaMotors/Invoice/13 |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 9999856       |
+
+  Scenario: Rejected transfers, amount exceeds the currency precision
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample4.json
+    """
+
+    Then I should have response with status "Bad Request"
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 10000000      |
+
+  Scenario: Rejected transfers, invalid credit transfers
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample5.json
+    """
+
+    Then I should have response with status "Bad Request"
+    And I should have response with body
+    """
+    {
+      "code": 3,
+      "message": "<ignore-diff>",
+      "details": [
+        {
+          "@type": "type.googleapis.com/google.rpc.BadRequest",
+          "fieldViolations": [
+            {
+              "field": "credit_transfers[1].amount",
+              "description": "invalid amount: must be positive"
+            },
+            {
+              "field": "credit_transfers[1].counterparty_iban",
+              "description": "invalid IBAN: DE IBAN must have 22 characters, got 27"
+            },
+            {
+              "field": "credit_transfers[1].counterparty_bic",
+              "description": "invalid BIC: \"ZDRPLBQ\" must have 8 or 11 characters, bank and country code letters"
+            }
+          ]
+        }
+      ]
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+
+  Scenario: Performing transfers in a foreign currency
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample6.json
+    """
+
+    Then I should have response with status "Created"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "<ignore-diff>",
+      "transactionIds": "<ignore-diff>",
+      "debitedCents": "10262",
+      "feesCents": "0",
+      "balanceCents": "9989738",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "executed",
+      "rows": "<ignore-diff>"
+    }
+    """
+    And these rows are available in table "transactions" of database "postgres":
+      | counterparty_name | amount_cents | amount_currency | debited_cents | debited_currency | exchange_rate | description              |
+      | Bip Bip           | 10000        | USD             | 8812          | EUR              | 0.8812        | Wonderland/4413          |
+      | Wile E Coyote     | 1450         | EUR             | 1450          | EUR              | 1             | //TeslaMotors/Invoice/14 |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents | currency |
+      | 1  | 9989738       | EUR      |
+
+  Scenario: Rejected transfers, no exchange rate into the account currency
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample7.json
+    """
+
+    Then I should have response with status "Bad Request"
+    And I should have response with body
+    """
+    {
+      "code": 3,
+      "message": "<ignore-diff>",
+      "details": [
+        {
+          "@type": "type.googleapis.com/google.rpc.BadRequest",
+          "fieldViolations": [
+            {
+              "field": "credit_transfers[0].currency",
+              "description": "exchange rate not found: from JPY to EUR"
+            }
+          ]
+        }
+      ]
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
@@ -0,0 +1,182 @@
+Feature: Reversing transactions
+  As an operator, I want to reverse a transaction, totally or partially, or all the transactions of a transfer bulk
+  so that the account is credited back without editing the database.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+    And the clock is set to "2021-12-10T09:00:00Z"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample1.json
+    """
+
+    Then I should have response with status "Created"
+
+  Scenario: Transaction reversed partially then totally
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/1/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"amountCents": "500", "reason": "incorrect_amount"}
+    """
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "transactions": [
+        {
+          "id": "4",
+          "counterpartyName": "Bip Bip",
+          "counterpartyIban": "EE303680981021245685",
+          "counterpartyBic": "CRLYFRPPTOU",
+          "amountCents": "-500",
+          "amountCurrency": "EUR",
+          "debitedCents": "-500",
+          "debitedCurrency": "EUR",
+          "exchangeRate": "1",
+          "description": "Wonderland/4410",
+          "transferBulkId": "0",
+          "createdAt": "2021-12-10T09:00:00Z",
+          "reversedTransactionId": "1",
+          "reversalReason": "incorrect_amount",
+          "externalReference": "",
+          "internal": false,
+          "chargedTransactionId": "0"
+        }
+      ],
+      "reversedCents": "500",
+      "balanceCents": "3775350",
+      "currency": "EUR"
+    }
+    """
+
+    # the amount not reversed yet is reversed.
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/1/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "duplicate"}
+    """
+
+    Then I should have response with status "OK"
+    And these rows are available in table "transactions" of database "postgres":
+      | id | debited_cents | reversed_transaction_id | reversal_reason  |
+      | 4  | -500          | 1                       | incorrect_amount |
+      | 5  | -950          | 1                       | duplicate        |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 3776300       |
+    And these rows are available in table "postings" of database "postgres":
+      | journal_entry_id | ledger_account     | bank_account_id | transaction_id | amount_cents | currency |
+      | 2                | bank_account       | 1               | 0              | 500          | EUR      |
+      | 2                | outgoing_transfers | 0               | 4              | -500         | EUR      |
+      | 3                | bank_account       | 1               | 0              | 950          | EUR      |
+      | 3                | outgoing_transfers | 0               | 5              | -950         | EUR      |
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/1/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "duplicate"}
+    """
+
+    Then I should have response with status "Conflict"
+
+    # a reversal is not reversible.
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/4/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "duplicate"}
+    """
+
+    Then I should have response with status "Conflict"
+
+  Scenario: Rejected reversal, amount exceeding the transaction
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/1/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"amountCents": "1451", "reason": "incorrect_amount"}
+    """
+
+    Then I should have response with status "Conflict"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 3774850       |
+
+  Scenario: Rejected reversal, invalid reason
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/1/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "mistake"}
+    """
+
+    Then I should have response with status "Bad Request"
+    And I should have response with body
+    """
+    {
+      "code": 3,
+      "message": "<ignore-diff>",
+      "details": [
+        {
+          "@type": "type.googleapis.com/google.rpc.BadRequest",
+          "fieldViolations": [
+            {"field": "reason", "description": "must be one of duplicate, fraud, incorrect_amount, requested_by_customer or returned"}
+          ]
+        }
+      ]
+    }
+    """
+
+  Scenario: Rejected reversal, transaction not found
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/9/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "duplicate"}
+    """
+
+    Then I should have response with status "Not Found"
+
+  Scenario: Transfer bulk reversed, but the transactions already reversed
+    When I request HTTP endpoint with method "POST" and URI "/v1/accounts/FR10474608000002006107XXXXX/transactions/1/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "duplicate"}
+    """
+
+    Then I should have response with status "OK"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "returned"}
+    """
+
+    Then I should have response with status "OK"
+    And these rows are available in table "transactions" of database "postgres":
+      | id | debited_cents | reversed_transaction_id | reversal_reason | transfer_bulk_id |
+      | 4  | -1450         | 1                       | duplicate       | 0                |
+      | 5  | -6123800      | 2                       | returned        | 0                |
+      | 6  | -99900        | 3                       | returned        | 0                |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 10000000      |
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "returned"}
+    """
+
+    Then I should have response with status "Conflict"
+
+  Scenario: Rejected transfer bulk reversal, transfer bulk not found
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/9/reverse"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "returned"}
+    """
+
+    Then I should have response with status "Not Found"
@@ -0,0 +1,240 @@
+Feature: Scheduling transfers
+  As a customer, I want to prepare transfer bulks in advance, to be performed on a requested execution date,
+  and to cancel them while they are not performed.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+    And the clock is set to "2021-12-05T09:00:00Z"
+
+  Scenario: Transfers scheduled and performed on the execution date
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample12.json
+    """
+
+    Then I should have response with status "Accepted"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "0",
+      "transactionIds": [],
+      "debitedCents": "0",
+      "feesCents": "0",
+      "balanceCents": "0",
+      "currency": "",
+      "jobId": "1",
+      "jobStatus": "scheduled",
+      "status": "",
+      "rows": []
+    }
+    """
+
+    When I request HTTP endpoint with method "GET" and URI "/v1/transfer/bulk/scheduled?organization_iban=FR10474608000002006107XXXXX"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "jobs": [
+        {
+          "id": "1",
+          "status": "scheduled",
+          "totalRows": 3,
+          "processedRows": 0,
+          "rows": [],
+          "transferBulkId": "0",
+          "debitedCents": "0",
+          "balanceCents": "0",
+          "currency": "",
+          "error": "",
+          "requestedExecutionDate": "2021-12-10",
+          "feesCents": "0"
+        }
+      ]
+    }
+    """
+
+    When the pending transfer bulk jobs are processed
+    Then no rows are available in table "transactions" of database "postgres"
+
+    When I add 5 days to the clock
+    And the pending transfer bulk jobs are processed
+    And I request HTTP endpoint with method "GET" and URI "/v1/transfer/bulk/1"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "status": "completed",
+      "totalRows": 3,
+      "processedRows": 3,
+      "rows": [
+        {"row": 0, "status": "completed", "transactionId": "1", "error": ""},
+        {"row": 1, "status": "completed", "transactionId": "2", "error": ""},
+        {"row": 2, "status": "completed", "transactionId": "3", "error": ""}
+      ],
+      "transferBulkId": "1",
+      "debitedCents": "6225150",
+      "balanceCents": "3774850",
+      "currency": "EUR",
+      "error": "",
+      "requestedExecutionDate": "2021-12-10",
+      "feesCents": "0"
+    }
+    """
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 3774850       |
+
+    When I request HTTP endpoint with method "GET" and URI "/v1/transfer/bulk/scheduled"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "jobs": []
+    }
+    """
+
+  Scenario: Scheduled transfers failed, not enough funds on the execution date
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample13.json
+    """
+
+    Then I should have response with status "Accepted"
+
+    When I add 5 days to the clock
+    And the pending transfer bulk jobs are processed
+    And I request HTTP endpoint with method "GET" and URI "/v1/transfer/bulk/1"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "status": "failed",
+      "totalRows": 3,
+      "processedRows": 3,
+      "rows": [
+        {"row": 0, "status": "failed", "transactionId": "0", "error": ""},
+        {"row": 1, "status": "failed", "transactionId": "0", "error": ""},
+        {"row": 2, "status": "failed", "transactionId": "0", "error": ""}
+      ],
+      "transferBulkId": "0",
+      "debitedCents": "0",
+      "balanceCents": "0",
+      "currency": "",
+      "error": "not enough balance",
+      "requestedExecutionDate": "2021-12-10",
+      "feesCents": "0"
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 10000000      |
+
+  Scenario: Scheduled transfers cancelled before the execution date
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample12.json
+    """
+
+    Then I should have response with status "Accepted"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/cancel"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "status": "cancelled",
+      "totalRows": 3,
+      "processedRows": 0,
+      "rows": [],
+      "transferBulkId": "0",
+      "debitedCents": "0",
+      "balanceCents": "0",
+      "currency": "",
+      "error": "",
+      "requestedExecutionDate": "2021-12-10",
+      "feesCents": "0"
+    }
+    """
+
+    When I add 5 days to the clock
+    And the pending transfer bulk jobs are processed
+    And I request HTTP endpoint with method "GET" and URI "/v1/transfer/bulk/1"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "status": "cancelled",
+      "totalRows": 3,
+      "processedRows": 0,
+      "rows": [],
+      "transferBulkId": "0",
+      "debitedCents": "0",
+      "balanceCents": "0",
+      "currency": "",
+      "error": "",
+      "requestedExecutionDate": "2021-12-10",
+      "feesCents": "0"
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+
+  Scenario: Performed transfers can not be cancelled
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample8.json
+    """
+
+    Then I should have response with status "Accepted"
+
+    When the pending transfer bulk jobs are processed
+    And I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/cancel"
+
+    Then I should have response with status "Conflict"
+
+  Scenario: Cancelling an unknown scheduled transfer bulk
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/cancel"
+
+    Then I should have response with status "Not Found"
+
+  Scenario: Requested execution date in the past
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample14.json
+    """
+
+    Then I should have response with status "Bad Request"
+    And I should have response with body
+    """
+    {
+      "code": 3,
+      "message": "<ignore-diff>",
+      "details": [
+        {
+          "@type": "type.googleapis.com/google.rpc.BadRequest",
+          "fieldViolations": [
+            {"field": "requested_execution_date", "description": "must not be in the past"}
+          ]
+        }
+      ]
+    }
+    """
+    And no rows are available in table "transfer_bulk_jobs" of database "postgres"
@@ -0,0 +1,300 @@
+Feature: Standing orders
+  As a customer, I want to set up a transfer performed repeatedly, e.g. a monthly rent,
+  and to skip, pause, resume or delete it.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+    And the clock is set to "2021-12-05T09:00:00Z"
+
+  Scenario: Monthly standing order executed until its count is reached
+    When I request HTTP endpoint with method "POST" and URI "/v1/standing-orders"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organizationName": "ACME Corp",
+      "organizationBic": "OIVUSCLQXXX",
+      "organizationIban": "FR10474608000002006107XXXXX",
+      "transfer": {
+        "amount": "1200",
+        "currency": "EUR",
+        "counterpartyName": "Landlord",
+        "counterpartyBic": "CRLYFRPPTOU",
+        "counterpartyIban": "FR1420041010050500013M02606",
+        "description": "Rent"
+      },
+      "frequency": "monthly",
+      "dayOfMonth": 5,
+      "startDate": "2021-12-05",
+      "count": 2
+    }
+    """
+
+    Then I should have response with status "Created"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "organizationName": "ACME Corp",
+      "organizationBic": "OIVUSCLQXXX",
+      "organizationIban": "FR10474608000002006107XXXXX",
+      "transfer": {
+        "amount": "1200.00",
+        "currency": "EUR",
+        "counterpartyName": "Landlord",
+        "counterpartyBic": "CRLYFRPPTOU",
+        "counterpartyIban": "FR1420041010050500013M02606",
+        "description": "Rent"
+      },
+      "frequency": "monthly",
+      "dayOfMonth": 5,
+      "startDate": "2021-12-05",
+      "endDate": "",
+      "count": 2,
+      "status": "active",
+      "nextExecutionDate": "2021-12-05",
+      "executionCount": 0,
+      "createdAt": "<ignore-diff>"
+    }
+    """
+
+    When the due standing orders are processed
+    And the pending transfer bulk jobs are processed
+    And I request HTTP endpoint with method "GET" and URI "/v1/standing-orders/1/executions"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "executions": [
+        {
+          "id": "1",
+          "executionDate": "2021-12-05",
+          "status": "enqueued",
+          "jobId": "1",
+          "jobStatus": "completed",
+          "transferBulkId": "1",
+          "error": ""
+        }
+      ]
+    }
+    """
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 9880000       |
+
+    # executed once per execution date.
+    When the due standing orders are processed
+    Then these rows are available in table "transfer_bulk_jobs" of database "postgres":
+      | id | idempotency_key             | status    |
+      | 1  | standing-order/1/2021-12-05 | completed |
+
+    When I add 31 days to the clock
+    And the due standing orders are processed
+    And the pending transfer bulk jobs are processed
+    And I request HTTP endpoint with method "GET" and URI "/v1/standing-orders/1"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "organizationName": "ACME Corp",
+      "organizationBic": "OIVUSCLQXXX",
+      "organizationIban": "FR10474608000002006107XXXXX",
+      "transfer": {
+        "amount": "1200.00",
+        "currency": "EUR",
+        "counterpartyName": "Landlord",
+        "counterpartyBic": "CRLYFRPPTOU",
+        "counterpartyIban": "FR1420041010050500013M02606",
+        "description": "Rent"
+      },
+      "frequency": "monthly",
+      "dayOfMonth": 5,
+      "startDate": "2021-12-05",
+      "endDate": "",
+      "count": 2,
+      "status": "completed",
+      "nextExecutionDate": "",
+      "executionCount": 2,
+      "createdAt": "<ignore-diff>"
+    }
+    """
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 9760000       |
+
+  Scenario: Standing order skipped, paused, resumed and deleted
+    When I request HTTP endpoint with method "POST" and URI "/v1/standing-orders"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organizationName": "ACME Corp",
+      "organizationBic": "OIVUSCLQXXX",
+      "organizationIban": "FR10474608000002006107XXXXX",
+      "transfer": {
+        "amount": "10",
+        "currency": "EUR",
+        "counterpartyName": "Coffee Shop",
+        "counterpartyBic": "CRLYFRPPTOU",
+        "counterpartyIban": "FR1420041010050500013M02606",
+        "description": "Coffee"
+      },
+      "frequency": "daily",
+      "startDate": "2021-12-06"
+    }
+    """
+
+    Then I should have response with status "Created"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/standing-orders/1/skip"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "organizationName": "ACME Corp",
+      "organizationBic": "OIVUSCLQXXX",
+      "organizationIban": "FR10474608000002006107XXXXX",
+      "transfer": {
+        "amount": "10.00",
+        "currency": "EUR",
+        "counterpartyName": "Coffee Shop",
+        "counterpartyBic": "CRLYFRPPTOU",
+        "counterpartyIban": "FR1420041010050500013M02606",
+        "description": "Coffee"
+      },
+      "frequency": "daily",
+      "dayOfMonth": 0,
+      "startDate": "2021-12-06",
+      "endDate": "",
+      "count": 0,
+      "status": "active",
+      "nextExecutionDate": "2021-12-07",
+      "executionCount": 0,
+      "createdAt": "<ignore-diff>"
+    }
+    """
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/standing-orders/1/pause"
+
+    Then I should have response with status "OK"
+
+    When I add 3 days to the clock
+    And the due standing orders are processed
+
+    Then no rows are available in table "transfer_bulk_jobs" of database "postgres"
+
+    # the execution dates missed while paused are not executed.
+    When I request HTTP endpoint with method "POST" and URI "/v1/standing-orders/1/resume"
+
+    Then I should have response with status "OK"
+    And these rows are available in table "standing_orders" of database "postgres":
+      | id | status | execution_count |
+      | 1  | active | 0               |
+
+    When the due standing orders are processed
+
+    Then these rows are available in table "standing_order_executions" of database "postgres":
+      | id | status   | transfer_bulk_job_id |
+      | 1  | skipped  | 0                    |
+      | 2  | enqueued | 1                    |
+
+    When I request HTTP endpoint with method "DELETE" and URI "/v1/standing-orders/1"
+
+    Then I should have response with status "OK"
+    And these rows are available in table "standing_orders" of database "postgres":
+      | id | status    |
+      | 1  | cancelled |
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/standing-orders/1/pause"
+
+    Then I should have response with status "Conflict"
+
+    When I request HTTP endpoint with method "GET" and URI "/v1/standing-orders?organization_iban=FR10474608000002006107XXXXX"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "standingOrders": []
+    }
+    """
+
+    When I request HTTP endpoint with method "GET" and URI "/v1/standing-orders/1/executions"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "executions": [
+        {
+          "id": "1",
+          "executionDate": "2021-12-06",
+          "status": "skipped",
+          "jobId": "0",
+          "jobStatus": "",
+          "transferBulkId": "0",
+          "error": ""
+        },
+        {
+          "id": "2",
+          "executionDate": "2021-12-08",
+          "status": "enqueued",
+          "jobId": "1",
+          "jobStatus": "pending",
+          "transferBulkId": "0",
+          "error": ""
+        }
+      ]
+    }
+    """
+
+  Scenario: Invalid recurrence
+    When I request HTTP endpoint with method "POST" and URI "/v1/standing-orders"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organizationName": "ACME Corp",
+      "organizationBic": "OIVUSCLQXXX",
+      "organizationIban": "FR10474608000002006107XXXXX",
+      "transfer": {
+        "amount": "1200",
+        "currency": "EUR",
+        "counterpartyName": "Landlord",
+        "counterpartyBic": "CRLYFRPPTOU",
+        "counterpartyIban": "FR1420041010050500013M02606",
+        "description": "Rent"
+      },
+      "frequency": "yearly",
+      "startDate": "2021-12-01"
+    }
+    """
+
+    Then I should have response with status "Bad Request"
+    And I should have response with body
+    """
+    {
+      "code": 3,
+      "message": "<ignore-diff>",
+      "details": [
+        {
+          "@type": "type.googleapis.com/google.rpc.BadRequest",
+          "fieldViolations": [
+            {"field": "frequency", "description": "must be one of daily, weekly or monthly"},
+            {"field": "start_date", "description": "must not be in the past"}
+          ]
+        }
+      ]
+    }
+    """
+    And no rows are available in table "standing_orders" of database "postgres"
+
+  Scenario: Unknown standing order
+    When I request HTTP endpoint with method "GET" and URI "/v1/standing-orders/1"
+
+    Then I should have response with status "Not Found"
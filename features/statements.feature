@@ -0,0 +1,40 @@
+Feature: Exporting account statements
+  As a customer, I want to download the statement of my account for a period
+  in a format my accounting software imports.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+    And these rows are stored in table "transactions" of database "postgres":
+      | id | counterparty_name | counterparty_iban           | counterparty_bic | amount_cents | amount_currency | debited_cents | debited_currency | exchange_rate | bank_account_id | description     | created_at           |
+      | 1  | Bip Bip           | EE303680981021245685        | CRLYFRPPTOU      | 10000        | USD             | 8812          | EUR              | 0.8812        | 1               | Wonderland/4410 | 2021-12-02T09:30:00Z |
+      | 2  | Bugs Bunny        | FR9810009380540930414023042 | RNJZNTMC         | 102538       | EUR             | 102538        | EUR              | 1             | 1               | GoldenCarrot    | 2021-12-03T10:00:00Z |
+      | 3  | Wile E Coyote     | DE44354208100362090817      | ZDRPLBQI         | 5000         | EUR             | 5000          | EUR              | 1             | 1               | Acme/Rocket     | 2022-01-05T08:00:00Z |
+
+  Scenario: Downloading the statement as CSV
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/FR10474608000002006107XXXXX/statement?from=2021-12-01&to=2021-12-31&format=csv"
+
+    Then I should have response with status "OK"
+    And I should have response with header "Content-Type: text/csv"
+    And I should have response with header "Content-Disposition: attachment; filename=statement-FR10474608000002006107XXXXX-2021-12-01-2021-12-31.csv"
+    And I should have response with body from file
+    """
+    ./features/_testdata/statement1.csv
+    """
+
+  Scenario: Downloading the statement in an unknown format
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/FR10474608000002006107XXXXX/statement?from=2021-12-01&to=2021-12-31&format=pdf"
+
+    Then I should have response with status "Bad Request"
+
+  Scenario: Downloading the statement of a period ending before it starts
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/FR10474608000002006107XXXXX/statement?from=2021-12-31&to=2021-12-01"
+
+    Then I should have response with status "Bad Request"
+
+  Scenario: Downloading the statement of an unknown account
+    When I request HTTP endpoint with method "GET" and URI "/v1/accounts/FR7630006000011234567890189/statement?from=2021-12-01&to=2021-12-31"
+
+    Then I should have response with status "Not Found"
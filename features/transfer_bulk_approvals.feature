@@ -0,0 +1,172 @@
+Feature: Transfer bulk approvals
+  As a bank, I want the large transfer bulks approved by a second user before their transfers are performed, keeping
+  the trail of the decisions.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 20000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+
+    # the transfer bulk debits 110000.00 EUR, more than the approval threshold.
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with header "X-User-Id: alice"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample17.json
+    """
+
+    Then I should have response with status "Accepted"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "1",
+      "transactionIds": [],
+      "debitedCents": "11000000",
+      "feesCents": "0",
+      "balanceCents": "20000000",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "awaiting_approval",
+      "rows": [
+        {"row": 0, "status": "awaiting_approval", "transactionId": "0", "reason": ""},
+        {"row": 1, "status": "awaiting_approval", "transactionId": "0", "reason": ""}
+      ]
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 20000000      |
+
+  Scenario: Transfer bulk approved by another user
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/approve"
+    And I request HTTP endpoint with header "X-User-Id: bob"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "payroll checked"}
+    """
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "1",
+      "transactionIds": ["1", "2"],
+      "debitedCents": "11000000",
+      "feesCents": "0",
+      "balanceCents": "9000000",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "executed",
+      "rows": [
+        {"row": 0, "status": "executed", "transactionId": "1", "reason": ""},
+        {"row": 1, "status": "executed", "transactionId": "2", "reason": ""}
+      ]
+    }
+    """
+    And these rows are available in table "transactions" of database "postgres":
+      | id | counterparty_name | amount_cents | amount_currency | bank_account_id |
+      | 1  | Bip Bip           | 6000000      | EUR             | 1               |
+      | 2  | Bugs Bunny        | 5000000      | EUR             | 1               |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 9000000       |
+    And these rows are available in table "transfer_bulks" of database "postgres":
+      | id | status   | submitted_by |
+      | 1  | executed | alice        |
+    And these rows are available in table "transfer_bulk_decisions" of database "postgres":
+      | transfer_bulk_id | decision  | user_id | reason          |
+      | 1                | submitted | alice   |                 |
+      | 1                | approved  | bob     | payroll checked |
+
+    # the transfer bulk is no longer awaiting approval.
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/reject"
+    And I request HTTP endpoint with header "X-User-Id: carol"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "too late"}
+    """
+
+    Then I should have response with status "Conflict"
+
+  Scenario: Transfer bulk rejected by another user
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/reject"
+    And I request HTTP endpoint with header "X-User-Id: bob"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "unknown beneficiary"}
+    """
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "1",
+      "transactionIds": [],
+      "debitedCents": "0",
+      "feesCents": "0",
+      "balanceCents": "20000000",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "rejected",
+      "rows": [
+        {"row": 0, "status": "rejected", "transactionId": "0", "reason": "transfer bulk rejected"},
+        {"row": 1, "status": "rejected", "transactionId": "0", "reason": "transfer bulk rejected"}
+      ]
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 20000000      |
+    And these rows are available in table "transfer_bulk_decisions" of database "postgres":
+      | transfer_bulk_id | decision  | user_id | reason              |
+      | 1                | submitted | alice   |                     |
+      | 1                | rejected  | bob     | unknown beneficiary |
+
+  Scenario: Rejected approval, approved by the submitter
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/approve"
+    And I request HTTP endpoint with header "X-User-Id: alice"
+    And I request HTTP endpoint with body
+    """
+    {}
+    """
+
+    Then I should have response with status "Forbidden"
+    And no rows are available in table "transactions" of database "postgres"
+
+  Scenario: Rejected approval, user not identified
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/approve"
+    And I request HTTP endpoint with body
+    """
+    {}
+    """
+
+    Then I should have response with status "Unauthorized"
+
+  Scenario: Rejected submission, transfer bulk exceeding the threshold submitted without user
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample17.json
+    """
+
+    Then I should have response with status "Unauthorized"
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "transfer_bulks" of database "postgres":
+      | id | status            | submitted_by |
+      | 1  | awaiting_approval | alice        |
+
+  Scenario: Rejected approval, transfer bulk not found
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/2/approve"
+    And I request HTTP endpoint with header "X-User-Id: bob"
+    And I request HTTP endpoint with body
+    """
+    {}
+    """
+
+    Then I should have response with status "Not Found"
@@ -0,0 +1,133 @@
+Feature: Performing transfers asynchronously
+  As a customer, I want to submit large transfer bulks without waiting for them to be performed,
+  and poll the job to know their outcome.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+
+  Scenario: Transfers accepted as a job and performed in background
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample8.json
+    """
+
+    Then I should have response with status "Accepted"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "0",
+      "transactionIds": [],
+      "debitedCents": "0",
+      "feesCents": "0",
+      "balanceCents": "0",
+      "currency": "",
+      "jobId": "1",
+      "jobStatus": "pending",
+      "status": "",
+      "rows": []
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+
+    When I request HTTP endpoint with method "GET" and URI "/v1/transfer/bulk/1"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "status": "pending",
+      "totalRows": 3,
+      "processedRows": 0,
+      "rows": [],
+      "transferBulkId": "0",
+      "debitedCents": "0",
+      "balanceCents": "0",
+      "currency": "",
+      "error": "",
+      "requestedExecutionDate": "",
+      "feesCents": "0"
+    }
+    """
+
+    When the pending transfer bulk jobs are processed
+    And I request HTTP endpoint with method "GET" and URI "/v1/transfer/bulk/1"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "status": "completed",
+      "totalRows": 3,
+      "processedRows": 3,
+      "rows": [
+        {"row": 0, "status": "completed", "transactionId": "1", "error": ""},
+        {"row": 1, "status": "completed", "transactionId": "2", "error": ""},
+        {"row": 2, "status": "completed", "transactionId": "3", "error": ""}
+      ],
+      "transferBulkId": "1",
+      "debitedCents": "6225150",
+      "balanceCents": "3774850",
+      "currency": "EUR",
+      "error": "",
+      "requestedExecutionDate": "",
+      "feesCents": "0"
+    }
+    """
+    And these rows are available in table "transactions" of database "postgres":
+      | counterparty_name | counterparty_iban           | counterparty_bic | amount_cents | amount_currency | bank_account_id | description                         |
+      | Bip Bip           | EE303680981021245685        | CRLYFRPPTOU      | 1450         | EUR             | 1               | Wonderland/4410                     |
+      | Wile E Coyote     | DE44354208100362090817      | ZDRPLBQI         | 6123800      | EUR             | 1               | //TeslaMotors/Invoice/12            |
+      | Bugs Bunny        | FR9810009380540930414023042 | RNJZNTMC         | 99900        | EUR             | 1               | 2020 09 24/2020 09 25/GoldenCarrot/ |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 3774850       |
+
+  Scenario: Job failed, not enough funds in the account
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample9.json
+    """
+
+    Then I should have response with status "Accepted"
+
+    When the pending transfer bulk jobs are processed
+    And I request HTTP endpoint with method "GET" and URI "/v1/transfer/bulk/1"
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "id": "1",
+      "status": "failed",
+      "totalRows": 3,
+      "processedRows": 3,
+      "rows": [
+        {"row": 0, "status": "failed", "transactionId": "0", "error": ""},
+        {"row": 1, "status": "failed", "transactionId": "0", "error": ""},
+        {"row": 2, "status": "failed", "transactionId": "0", "error": ""}
+      ],
+      "transferBulkId": "0",
+      "debitedCents": "0",
+      "balanceCents": "0",
+      "currency": "",
+      "error": "not enough balance",
+      "requestedExecutionDate": "",
+      "feesCents": "0"
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 10000000      |
+
+  Scenario: Job not found
+    When I request HTTP endpoint with method "GET" and URI "/v1/transfer/bulk/1"
+
+    Then I should have response with status "Not Found"
@@ -0,0 +1,95 @@
+Feature: Transfer bulk screening
+  As a bank, I want the counterparties screened against the sanctions and block lists, holding the transfer bulks
+  matching for review before their transfers are performed.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 100000        | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+
+    # the second counterparty name is close to SDN-1001, the third counterparty iban is SDN-1002.
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with header "X-User-Id: alice"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample18.json
+    """
+
+    Then I should have response with status "Accepted"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "1",
+      "transactionIds": [],
+      "debitedCents": "36440",
+      "feesCents": "0",
+      "balanceCents": "100000",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "held_for_review",
+      "rows": [
+        {"row": 0, "status": "awaiting_approval", "transactionId": "0", "reason": ""},
+        {"row": 1, "status": "held_for_review", "transactionId": "0", "reason": "screening match on name: SDN-1001 Yosemite Sam, score 0.94"},
+        {"row": 2, "status": "held_for_review", "transactionId": "0", "reason": "screening match on iban: SDN-1002, score 1.00"}
+      ]
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "transfer_bulks" of database "postgres":
+      | id | status          | submitted_by |
+      | 1  | held_for_review | alice        |
+
+  Scenario: Transfer bulk held for review approved by another user
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/approve"
+    And I request HTTP endpoint with header "X-User-Id: bob"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "false positive, known supplier"}
+    """
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "1",
+      "transactionIds": ["1", "2", "3"],
+      "debitedCents": "36440",
+      "feesCents": "0",
+      "balanceCents": "63560",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "executed",
+      "rows": [
+        {"row": 0, "status": "executed", "transactionId": "1", "reason": ""},
+        {"row": 1, "status": "executed", "transactionId": "2", "reason": ""},
+        {"row": 2, "status": "executed", "transactionId": "3", "reason": ""}
+      ]
+    }
+    """
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 63560         |
+    And these rows are available in table "transfer_bulk_decisions" of database "postgres":
+      | transfer_bulk_id | decision  | user_id | reason                         |
+      | 1                | submitted | alice   |                                |
+      | 1                | approved  | bob     | false positive, known supplier |
+
+  Scenario: Transfer bulk held for review rejected by another user
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/1/reject"
+    And I request HTTP endpoint with header "X-User-Id: bob"
+    And I request HTTP endpoint with body
+    """
+    {"reason": "sanctioned counterparty"}
+    """
+
+    Then I should have response with status "OK"
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "transfer_bulks" of database "postgres":
+      | id | status   |
+      | 1  | rejected |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 100000        |
@@ -0,0 +1,57 @@
+Feature: Transfer fees
+  As a bank, I want to charge fees on the credit transfers, booked as separate transactions and debited with the
+  transfers.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+
+  Scenario: Charging a fee on an instant transfer
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample15.json
+    """
+
+    Then I should have response with status "Created"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "<ignore-diff>",
+      "transactionIds": ["1", "2"],
+      "debitedCents": "101350",
+      "feesCents": "50",
+      "balanceCents": "9898600",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "executed",
+      "rows": [
+        {"row": 0, "status": "executed", "transactionId": "1", "reason": ""},
+        {"row": 1, "status": "executed", "transactionId": "2", "reason": ""}
+      ]
+    }
+    """
+    And these rows are available in table "transactions" of database "postgres":
+      | id | counterparty_name | amount_cents | amount_currency | bank_account_id | description                           | charged_transaction_id |
+      | 1  | Bip Bip           | 1450         | EUR             | 1               | Wonderland/4410                       | 0                      |
+      | 2  | Bugs Bunny        | 99900        | EUR             | 1               | 2020 09 24/2020 09 25/GoldenCarrot/   | 0                      |
+      | 3  | Bip Bip           | 50           | EUR             | 1               | Fee instant transfer: Wonderland/4410 | 1                      |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 9898600       |
+
+  Scenario: Unprocessable transfers, not enough balance for the fees
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/sample16.json
+    """
+
+    Then I should have response with status "Unprocessable Entity"
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 10000000      |
@@ -0,0 +1,171 @@
+Feature: Transfer limits
+  As a compliance officer, I want the credit transfers of an account, or of all the accounts of an organization,
+  to be limited in amount and in number, so that a compromised account cannot be drained.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 1000000       | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+      | 2  | ACME Corp         | 1000000       | FR10474608000002006107YYYYY | OIVUSCLQXXX |
+
+  Scenario: Transfer bulk denied, single transfer limit of the account exceeded
+    When I request HTTP endpoint with method "PUT" and URI "/v1/admin/accounts/FR10474608000002006107XXXXX/limits"
+    And I request HTTP endpoint with body
+    """
+    {"maxTransferCents": "50000"}
+    """
+
+    Then I should have response with status "OK"
+    And these rows are available in table "transfer_limits" of database "postgres":
+      | id | bank_account_id | organization_name | max_transfer_cents | daily_cents |
+      | 1  | 1               |                   | 50000              | 0           |
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "ACME Corp",
+      "organization_bic": "OIVUSCLQXXX",
+      "organization_iban": "FR10474608000002006107XXXXX",
+      "credit_transfers": [
+        {
+          "amount": "500.01",
+          "currency": "EUR",
+          "counterparty_name": "Bip Bip",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "EE303680981021245685",
+          "description": "Wonderland/4410"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Too Many Requests"
+    And I should have response with body
+    """
+    {
+      "code": 8,
+      "message": "transfer limit exceeded: max_transfer_cents of 50000 exceeded, 50001 requested",
+      "details": "<ignore-diff>"
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+
+  Scenario: Transfer bulk denied, daily limit of the organization exceeded by its accounts together
+    When I request HTTP endpoint with method "PUT" and URI "/v1/admin/organizations/ACME%20Corp/limits"
+    And I request HTTP endpoint with body
+    """
+    {"dailyCents": "100000"}
+    """
+
+    Then I should have response with status "OK"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "ACME Corp",
+      "organization_bic": "OIVUSCLQXXX",
+      "organization_iban": "FR10474608000002006107XXXXX",
+      "credit_transfers": [
+        {
+          "amount": "600",
+          "currency": "EUR",
+          "counterparty_name": "Bip Bip",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "EE303680981021245685",
+          "description": "Wonderland/4410"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Created"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "ACME Corp",
+      "organization_bic": "OIVUSCLQXXX",
+      "organization_iban": "FR10474608000002006107YYYYY",
+      "credit_transfers": [
+        {
+          "amount": "400.01",
+          "currency": "EUR",
+          "counterparty_name": "Bip Bip",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "EE303680981021245685",
+          "description": "Wonderland/4411"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Too Many Requests"
+    And I should have response with body
+    """
+    {
+      "code": 8,
+      "message": "transfer limit exceeded: daily_cents of 100000 exceeded, 100001 requested",
+      "details": "<ignore-diff>"
+    }
+    """
+
+  Scenario: Transfer bulk denied, number of transfers within the window exceeded
+    When I request HTTP endpoint with method "PUT" and URI "/v1/admin/accounts/FR10474608000002006107XXXXX/limits"
+    And I request HTTP endpoint with body
+    """
+    {"maxTransfers": "1", "windowSeconds": "3600"}
+    """
+
+    Then I should have response with status "OK"
+
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk"
+    And I request HTTP endpoint with body
+    """
+    {
+      "organization_name": "ACME Corp",
+      "organization_bic": "OIVUSCLQXXX",
+      "organization_iban": "FR10474608000002006107XXXXX",
+      "credit_transfers": [
+        {
+          "amount": "10",
+          "currency": "EUR",
+          "counterparty_name": "Bip Bip",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "EE303680981021245685",
+          "description": "Wonderland/4410"
+        },
+        {
+          "amount": "10",
+          "currency": "EUR",
+          "counterparty_name": "Bip Bip",
+          "counterparty_bic": "CRLYFRPPTOU",
+          "counterparty_iban": "EE303680981021245685",
+          "description": "Wonderland/4411"
+        }
+      ]
+    }
+    """
+
+    Then I should have response with status "Too Many Requests"
+    And I should have response with body
+    """
+    {
+      "code": 8,
+      "message": "transfer limit exceeded: max_transfers of 1 exceeded, 2 requested",
+      "details": "<ignore-diff>"
+    }
+    """
+
+  Scenario: Rejected transfer limits, max transfers without window
+    When I request HTTP endpoint with method "PUT" and URI "/v1/admin/accounts/FR10474608000002006107XXXXX/limits"
+    And I request HTTP endpoint with body
+    """
+    {"maxTransfers": "10"}
+    """
+
+    Then I should have response with status "Bad Request"
+    And no rows are available in table "transfer_limits" of database "postgres"
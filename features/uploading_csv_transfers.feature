@@ -0,0 +1,102 @@
+Feature: Uploading transfers as CSV
+  As a customer, I want to upload the transfers as a CSV exported from my spreadsheet,
+  and check it before performing the transfers.
+
+  Background:
+    Given there is a clean "postgres" database
+    And these rows are stored in table "bank_accounts" of database "postgres":
+      | id | organization_name | balance_cents | iban                        | bic         |
+      | 1  | ACME Corp         | 10000000      | FR10474608000002006107XXXXX | OIVUSCLQXXX |
+
+  Scenario: Uploading transfers with mapped columns successfully
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/csv"
+    And I request HTTP endpoint with header "Content-Type: multipart/form-data; boundary=qonto"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/csv_upload1.multipart
+    """
+
+    Then I should have response with status "Created"
+    And I should have response with body
+    """
+    {
+      "transferBulkId": "<ignore-diff>",
+      "transactionIds": ["1", "2", "3"],
+      "debitedCents": "6225150",
+      "feesCents": "0",
+      "balanceCents": "3774850",
+      "currency": "EUR",
+      "jobId": "0",
+      "jobStatus": "",
+      "status": "executed",
+      "rows": [
+        {"row": 0, "status": "executed", "transactionId": "1", "reason": ""},
+        {"row": 1, "status": "executed", "transactionId": "2", "reason": ""},
+        {"row": 2, "status": "executed", "transactionId": "3", "reason": ""}
+      ]
+    }
+    """
+    And these rows are available in table "transactions" of database "postgres":
+      | counterparty_name | counterparty_iban           | counterparty_bic | amount_cents | amount_currency | bank_account_id | description                         |
+      | Bip Bip           | EE303680981021245685        | CRLYFRPPTOU      | 1450         | EUR             | 1               | Wonderland/4410                     |
+      | Wile E Coyote     | DE44354208100362090817      | ZDRPLBQI         | 6123800      | EUR             | 1               | //TeslaMotors/Invoice/12            |
+      | Bugs Bunny        | FR9810009380540930414023042 | RNJZNTMC         | 99900        | EUR             | 1               | 2020 09 24/2020 09 25/GoldenCarrot/ |
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 3774850       |
+
+  Scenario: Previewing the totals of the uploaded transfers
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/csv"
+    And I request HTTP endpoint with header "Content-Type: multipart/form-data; boundary=qonto"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/csv_upload2.multipart
+    """
+
+    Then I should have response with status "OK"
+    And I should have response with body
+    """
+    {
+      "transferCount": 3,
+      "totals": [
+        {"currency": "EUR", "amountCents": "6225150", "amount": "62251.50"}
+      ]
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
+    And these rows are available in table "bank_accounts" of database "postgres":
+      | id | balance_cents |
+      | 1  | 10000000      |
+
+  Scenario: Uploading transfers with invalid lines
+    When I request HTTP endpoint with method "POST" and URI "/v1/transfer/bulk/csv"
+    And I request HTTP endpoint with header "Content-Type: multipart/form-data; boundary=qonto"
+    And I request HTTP endpoint with body from file
+    """
+    ./features/_testdata/csv_upload3.multipart
+    """
+
+    Then I should have response with status "Bad Request"
+    And I should have response with body
+    """
+    {
+      "code": 3,
+      "message": "<ignore-diff>",
+      "details": [
+        {
+          "@type": "type.googleapis.com/google.rpc.BadRequest",
+          "fieldViolations": [
+            {
+              "field": "lines[3].IBAN",
+              "description": "invalid IBAN: \"DE44354208100362090818\" has a wrong checksum"
+            },
+            {
+              "field": "lines[4].Montant",
+              "description": "invalid amount: must be positive"
+            }
+          ]
+        }
+      ]
+    }
+    """
+    And no rows are available in table "transactions" of database "postgres"
@@ -74,11 +74,13 @@ func TestIntegration(t *testing.T) {
 	srvREST, err := grpcRest.InitRESTService(
 		ctx,
 		grpcRest.InitRESTServiceConfig{
-			Listener:         restTListener,
-			Service:          deps.QontoRESTService,
-			UInterceptor:     deps.GRPCUnitaryInterceptors,
-			Handlers:         deps.Handlers,
-			ResponseModifier: deps.ResponseModifier,
+			Listener:              restTListener,
+			Service:               deps.QontoRESTService,
+			UInterceptor:          deps.GRPCUnitaryInterceptors,
+			Handlers:              deps.Handlers,
+			ResponseModifier:      deps.ResponseModifier,
+			IncomingHeaderMatcher: deps.IncomingHeaderMatcher,
+			Marshalers:            deps.Marshalers,
 			Options: []grpcRest.Option{
 				grpcRest.WithAddrAssigned(),
 			},
@@ -113,6 +115,34 @@ func TestIntegration(t *testing.T) {
 		dbmCleaner.RegisterSteps(s)
 
 		clock.RegisterContext(s)
+
+		// jobs are processed on demand rather than by the worker, for the scenarios to be deterministic.
+		s.Step(`^the pending transfer bulk jobs are processed$`, func(ctx context.Context) error {
+			for {
+				processed, err := deps.TransactionBulkJob.ProcessNext(ctx)
+				if err != nil || !processed {
+					return err
+				}
+			}
+		})
+
+		s.Step(`^the due standing orders are processed$`, func(ctx context.Context) error {
+			for {
+				processed, err := deps.StandingOrder.ProcessNext(ctx)
+				if err != nil || !processed {
+					return err
+				}
+			}
+		})
+
+		s.Step(`^the expired holds are released$`, func(ctx context.Context) error {
+			for {
+				processed, err := deps.FundHold.ExpireNext(ctx)
+				if err != nil || !processed {
+					return err
+				}
+			}
+		})
 	})
 
 	must.NotFail(services.Close())
@@ -129,12 +159,32 @@ func initDBManager(storage *sqluct.Storage) *dbdog.Manager {
 		"postgres": {
 			Storage: storage,
 			Tables: map[string]interface{}{
-				"transactions":  new(model.Transaction),
-				"bank_accounts": new(model.BankAccount),
+				"transactions":       new(model.Transaction),
+				"bank_accounts":      new(model.BankAccount),
+				"transfer_bulks":     new(model.TransferBulk),
+				"journal_entries":    new(model.JournalEntry),
+				"postings":           new(model.Posting),
+				"transfer_bulk_jobs": new(model.TransferBulkJob),
+
+				"standing_orders":           new(model.StandingOrder),
+				"standing_order_executions": new(model.StandingOrderExecution),
+
+				"holds":           new(model.Hold),
+				"transfer_limits": new(model.TransferLimit),
 			},
 			PostCleanup: map[string][]string{
-				"transactions":  {"ALTER SEQUENCE transactions_id_seq RESTART"},
-				"bank_accounts": {"ALTER SEQUENCE bank_accounts_id_seq RESTART"},
+				"transactions":       {"ALTER SEQUENCE transactions_id_seq RESTART"},
+				"bank_accounts":      {"ALTER SEQUENCE bank_accounts_id_seq RESTART"},
+				"transfer_bulks":     {"ALTER SEQUENCE transfer_bulks_id_seq RESTART"},
+				"journal_entries":    {"ALTER SEQUENCE journal_entries_id_seq RESTART"},
+				"postings":           {"ALTER SEQUENCE postings_id_seq RESTART"},
+				"transfer_bulk_jobs": {"ALTER SEQUENCE transfer_bulk_jobs_id_seq RESTART"},
+
+				"standing_orders":           {"ALTER SEQUENCE standing_orders_id_seq RESTART"},
+				"standing_order_executions": {"ALTER SEQUENCE standing_order_executions_id_seq RESTART"},
+
+				"holds":           {"ALTER SEQUENCE holds_id_seq RESTART"},
+				"transfer_limits": {"ALTER SEQUENCE transfer_limits_id_seq RESTART"},
 			},
 		},
 	}
@@ -16,4 +16,58 @@ type BankAccountState struct {
 	BalanceCents     Cents  `db:"balance_cents"`
 	Iban             string `db:"iban"`
 	Bic              string `db:"bic"`
+	// Currency is the ISO 4217 currency of the balance.
+	Currency string `db:"currency"`
+	// HeldCents is the amount reserved by the active holds, not spendable until the holds are captured or released.
+	HeldCents Cents `db:"held_cents"`
+	// OverdraftLimitCents is the agreed overdraft facility, the available balance can go negative down to minus the
+	// limit.
+	OverdraftLimitCents Cents `db:"overdraft_limit_cents"`
+}
+
+// AvailableBalanceCents returns the balance minus the amount reserved by the active holds.
+func (s BankAccountState) AvailableBalanceCents() Cents {
+	return s.BalanceCents - s.HeldCents
+}
+
+// SpendableCents returns the amount the account can spend, the available balance plus the overdraft limit.
+func (s BankAccountState) SpendableCents() Cents {
+	return s.AvailableBalanceCents() + s.OverdraftLimitCents
+}
+
+// BalanceShortfall is the reason the bank account does not cover an amount.
+type BalanceShortfall int
+
+const (
+	// NoShortfall is when the available balance, plus the overdraft limit, covers the amount.
+	NoShortfall BalanceShortfall = iota
+	// BalanceExceeded is when the amount exceeds the available balance, the account has no overdraft facility to use.
+	BalanceExceeded
+	// OverdraftExceeded is when the amount exceeds the available balance plus the overdraft limit.
+	OverdraftExceeded
+)
+
+// Shortfall returns why the account does not cover the amount, NoShortfall when it does.
+//
+// The part of the amount beyond the available balance is covered by the overdraft facility. The amount exceeds the
+// overdraft only when the available balance plus the overdraft limit is lower than the amount, otherwise the account
+// without overdraft facility, a limit of 0, exceeds its available balance.
+func (s BankAccountState) Shortfall(amount Cents) BalanceShortfall {
+	switch {
+	case s.SpendableCents() >= amount:
+		return NoShortfall
+	case s.OverdraftLimitCents > 0:
+		return OverdraftExceeded
+	default:
+		return BalanceExceeded
+	}
+}
+
+// OverdraftUsedCents returns the part of the overdraft facility used, by the debits or reserved by the active holds.
+func (s BankAccountState) OverdraftUsedCents() Cents {
+	if available := s.AvailableBalanceCents(); available < 0 {
+		return -available
+	}
+
+	return 0
 }
@@ -0,0 +1,117 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBankAccountState_overdraft(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		state         model.BankAccountState
+		wantSpendable model.Cents
+		wantUsed      model.Cents
+	}{
+		{
+			name:          "no overdraft facility",
+			state:         model.BankAccountState{BalanceCents: 1000, HeldCents: 200},
+			wantSpendable: 800,
+		},
+		{
+			name:          "overdraft facility not used",
+			state:         model.BankAccountState{BalanceCents: 1000, OverdraftLimitCents: 5000},
+			wantSpendable: 6000,
+		},
+		{
+			name:          "overdraft facility used",
+			state:         model.BankAccountState{BalanceCents: -3000, OverdraftLimitCents: 5000},
+			wantSpendable: 2000,
+			wantUsed:      3000,
+		},
+		{
+			name:          "overdraft facility reserved by the holds",
+			state:         model.BankAccountState{BalanceCents: 1000, HeldCents: 2500, OverdraftLimitCents: 5000},
+			wantSpendable: 3500,
+			wantUsed:      1500,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.wantSpendable, tc.state.SpendableCents())
+			assert.Equal(t, tc.wantUsed, tc.state.OverdraftUsedCents())
+		})
+	}
+}
+
+func TestBankAccountState_Shortfall(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		state  model.BankAccountState
+		amount model.Cents
+		want   model.BalanceShortfall
+	}{
+		{
+			name:   "available balance covering the amount",
+			state:  model.BankAccountState{BalanceCents: 1000, HeldCents: 200},
+			amount: 800,
+			want:   model.NoShortfall,
+		},
+		{
+			name:   "available balance exceeded, no overdraft facility",
+			state:  model.BankAccountState{BalanceCents: 1000, HeldCents: 200},
+			amount: 801,
+			want:   model.BalanceExceeded,
+		},
+		{
+			name:   "available balance exceeded, balance covering the amount but held",
+			state:  model.BankAccountState{BalanceCents: 1000, HeldCents: 200},
+			amount: 1000,
+			want:   model.BalanceExceeded,
+		},
+		{
+			name:   "overdraft facility covering the amount",
+			state:  model.BankAccountState{BalanceCents: 1000, OverdraftLimitCents: 5000},
+			amount: 6000,
+			want:   model.NoShortfall,
+		},
+		{
+			name:   "overdraft limit exceeded",
+			state:  model.BankAccountState{BalanceCents: 1000, OverdraftLimitCents: 5000},
+			amount: 6001,
+			want:   model.OverdraftExceeded,
+		},
+		{
+			name:   "overdraft limit exceeded, limit lowered below the overdraft used",
+			state:  model.BankAccountState{BalanceCents: -3000, OverdraftLimitCents: 2000},
+			amount: 1,
+			want:   model.OverdraftExceeded,
+		},
+		{
+			name:   "available balance exceeded, overdraft facility removed while used",
+			state:  model.BankAccountState{BalanceCents: -3000},
+			amount: 1,
+			want:   model.BalanceExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, tc.state.Shortfall(tc.amount))
+		})
+	}
+}
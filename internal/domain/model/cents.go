@@ -1,9 +1,127 @@
 package model
 
-// Cents represents cents.
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidAmount error represents when the amount is not a valid decimal number.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrAmountPrecision error represents when the amount has more decimals than the currency minor unit allows.
+	ErrAmountPrecision = errors.New("amount exceeds the currency precision")
+	// ErrUnknownCurrency error represents when the currency is not an ISO 4217 currency.
+	ErrUnknownCurrency = errors.New("unknown currency")
+)
+
+var decimalRegexp = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// Cents represents an amount in the minor unit of its currency, e.g. cents for EUR.
 type Cents int64
 
-// ToCents convert float into cents.
-func ToCents(f float64) Cents {
-	return Cents(f * 100)
+// ParseCents parses the decimal amount, e.g. "14.50", into the minor unit of the currency.
+//
+// The amount is parsed exactly, amounts with more decimals than the currency minor unit allows are rejected.
+func ParseCents(amount, currency string) (Cents, error) {
+	digits, ok := CurrencyMinorUnit(currency)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownCurrency, currency)
+	}
+
+	if !decimalRegexp.MatchString(amount) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	r, _ := new(big.Rat).SetString(amount) // nolint: errcheck // amount matches the decimal format.
+	r.Mul(r, new(big.Rat).SetInt(pow10(digits)))
+
+	if !r.IsInt() {
+		return 0, fmt.Errorf("%w: %q has more than %d decimals", ErrAmountPrecision, amount, digits)
+	}
+
+	return toCents(amount, r.Num())
+}
+
+// FloatToCents converts the float amount into the minor unit of the currency, rounding half to even.
+//
+// Floats can not represent most decimal amounts exactly, the amount is taken from its shortest decimal
+// representation, e.g. 0.29 rather than 0.28999999999999998002.
+func FloatToCents(f float64, currency string) (Cents, error) {
+	digits, ok := CurrencyMinorUnit(currency)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownCurrency, currency)
+	}
+
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidAmount, f)
+	}
+
+	amount := strconv.FormatFloat(f, 'f', -1, 64)
+
+	r, _ := new(big.Rat).SetString(amount) // nolint: errcheck // amount is formatted as decimal.
+	r.Mul(r, new(big.Rat).SetInt(pow10(digits)))
+
+	return toCents(amount, roundHalfEven(r))
+}
+
+// FormatCents formats the amount in the minor unit of the currency as decimal, e.g. 1450 EUR as "14.50".
+//
+// Unknown currencies are formatted with two decimals.
+func FormatCents(c Cents, currency string) string {
+	digits, ok := CurrencyMinorUnit(currency)
+	if !ok {
+		digits = 2
+	}
+
+	s := strconv.FormatInt(int64(c), 10)
+
+	var sign string
+
+	if c < 0 {
+		sign, s = "-", s[1:]
+	}
+
+	if digits == 0 {
+		return sign + s
+	}
+
+	if len(s) <= digits {
+		s = strings.Repeat("0", digits-len(s)+1) + s
+	}
+
+	return sign + s[:len(s)-digits] + "." + s[len(s)-digits:]
+}
+
+// roundHalfEven rounds the rational number to the nearest integer, ties to the even one.
+func roundHalfEven(r *big.Rat) *big.Int {
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+
+	// compare twice the absolute remainder against the denominator to find out which integer is the nearest.
+	cmp := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(r.Denom())
+	if cmp > 0 || (cmp == 0 && q.Bit(0) == 1) {
+		if r.Sign() < 0 {
+			return q.Sub(q, big.NewInt(1))
+		}
+
+		return q.Add(q, big.NewInt(1))
+	}
+
+	return q
+}
+
+func toCents(amount string, n *big.Int) (Cents, error) {
+	if !n.IsInt64() {
+		return 0, fmt.Errorf("%w: %q is out of range", ErrInvalidAmount, amount)
+	}
+
+	return Cents(n.Int64()), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
 }
@@ -0,0 +1,84 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCents(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amount   string
+		currency string
+		want     model.Cents
+		err      error
+	}{
+		{amount: "0.29", currency: "EUR", want: 29},
+		{amount: "1.15", currency: "EUR", want: 115},
+		{amount: "14.5", currency: "EUR", want: 1450},
+		{amount: "1500", currency: "JPY", want: 1500},
+		{amount: "1.234", currency: "KWD", want: 1234},
+		{amount: "1.005", currency: "EUR", err: model.ErrAmountPrecision},
+		{amount: "1.5", currency: "JPY", err: model.ErrAmountPrecision},
+		{amount: "1e3", currency: "EUR", err: model.ErrInvalidAmount},
+		{amount: "", currency: "EUR", err: model.ErrInvalidAmount},
+		{amount: "99999999999999999999", currency: "EUR", err: model.ErrInvalidAmount},
+		{amount: "1.00", currency: "XXX", err: model.ErrUnknownCurrency},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.amount+" "+tc.currency, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := model.ParseCents(tc.amount, tc.currency)
+
+			assert.Equal(t, tc.want, got, "ParseCents() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "ParseCents() err got = %v, want %v", err, tc.err)
+		})
+	}
+}
+
+func TestFloatToCents(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amount   float64
+		currency string
+		want     model.Cents
+	}{
+		{amount: 0.29, currency: "EUR", want: 29},
+		{amount: 1.15, currency: "EUR", want: 115},
+		{amount: 0.125, currency: "EUR", want: 12},
+		{amount: 0.135, currency: "EUR", want: 14},
+		{amount: -0.125, currency: "EUR", want: -12},
+		{amount: 1500.5, currency: "JPY", want: 1500},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(model.FormatCents(tc.want, tc.currency), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := model.FloatToCents(tc.amount, tc.currency)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got, "FloatToCents() got = %v, want %v", got, tc.want)
+		})
+	}
+}
+
+func TestFormatCents(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "14.50", model.FormatCents(1450, "EUR"))
+	assert.Equal(t, "0.05", model.FormatCents(5, "EUR"))
+	assert.Equal(t, "-0.05", model.FormatCents(-5, "EUR"))
+	assert.Equal(t, "1500", model.FormatCents(1500, "JPY"))
+	assert.Equal(t, "1.234", model.FormatCents(1234, "KWD"))
+}
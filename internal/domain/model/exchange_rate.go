@@ -0,0 +1,43 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrInvalidRate error represents when the exchange rate is not a positive decimal number.
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+// NoExchangeRate is the exchange rate of the amounts debited in their own currency.
+const NoExchangeRate = "1"
+
+// ConvertCents converts the amount in the minor unit of the currency from into the minor unit of the currency to,
+// rounding half to even.
+//
+// The rate is the decimal amount of the currency to for one unit of the currency from, e.g. "0.8812" for USD to EUR.
+func ConvertCents(amount Cents, from, to, rate string) (Cents, error) {
+	fromDigits, ok := CurrencyMinorUnit(from)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownCurrency, from)
+	}
+
+	toDigits, ok := CurrencyMinorUnit(to)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownCurrency, to)
+	}
+
+	if !decimalRegexp.MatchString(rate) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidRate, rate)
+	}
+
+	r, _ := new(big.Rat).SetString(rate) // nolint: errcheck // rate matches the decimal format.
+	if r.Sign() <= 0 {
+		return 0, fmt.Errorf("%w: %q must be positive", ErrInvalidRate, rate)
+	}
+
+	r.Mul(r, new(big.Rat).SetInt64(int64(amount)))
+	r.Mul(r, new(big.Rat).SetFrac(pow10(toDigits), pow10(fromDigits)))
+
+	return toCents(FormatCents(amount, from), roundHalfEven(r))
+}
@@ -0,0 +1,43 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertCents(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		amount model.Cents
+		from   string
+		to     string
+		rate   string
+		want   model.Cents
+		err    error
+	}{
+		{name: "USD to EUR", amount: 10000, from: "USD", to: "EUR", rate: "0.8812", want: 8812},
+		{name: "rounding half to even", amount: 125, from: "USD", to: "EUR", rate: "0.1", want: 12},
+		{name: "JPY to EUR", amount: 1500, from: "JPY", to: "EUR", rate: "0.0077", want: 1155},
+		{name: "EUR to KWD", amount: 1000, from: "EUR", to: "KWD", rate: "0.3412", want: 3412},
+		{name: "invalid rate", amount: 1000, from: "USD", to: "EUR", rate: "abc", err: model.ErrInvalidRate},
+		{name: "zero rate", amount: 1000, from: "USD", to: "EUR", rate: "0", err: model.ErrInvalidRate},
+		{name: "unknown currency", amount: 1000, from: "XXX", to: "EUR", rate: "1", err: model.ErrUnknownCurrency},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := model.ConvertCents(tc.amount, tc.from, tc.to, tc.rate)
+
+			assert.Equal(t, tc.want, got, "ConvertCents() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "ConvertCents() err got = %v, want %v", err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import "strings"
+
+// TransferKind is the kind of a credit transfer, the fees charged depend on.
+type TransferKind string
+
+const (
+	// TransferKindSEPA is a credit transfer in euro to a bank of the SEPA countries.
+	TransferKindSEPA TransferKind = "sepa"
+	// TransferKindInstant is a SEPA credit transfer requested as instant.
+	TransferKindInstant TransferKind = "instant"
+	// TransferKindInternational is a credit transfer in another currency, or to a bank outside the SEPA countries.
+	TransferKindInternational TransferKind = "international"
+	// TransferKindInternal is a credit transfer to another bank account of the service.
+	TransferKindInternal TransferKind = "internal"
+)
+
+// sepaCountries are the countries of the SEPA scheme, by the country code of their IBAN.
+var sepaCountries = map[string]bool{
+	"AD": true, "AT": true, "BE": true, "BG": true, "CH": true, "CY": true, "CZ": true, "DE": true, "DK": true,
+	"EE": true, "ES": true, "FI": true, "FR": true, "GB": true, "GI": true, "GR": true, "HR": true, "HU": true,
+	"IE": true, "IS": true, "IT": true, "LI": true, "LT": true, "LU": true, "LV": true, "MC": true, "MT": true,
+	"NL": true, "NO": true, "PL": true, "PT": true, "RO": true, "SE": true, "SI": true, "SK": true, "SM": true,
+	"VA": true,
+}
+
+// CreditTransferKind returns the kind of the credit transfer in the currency to the counterparty iban.
+//
+// A credit transfer to another bank account of the service is internal, whatever its currency.
+func CreditTransferKind(counterpartyIban, currency string, internal, instant bool) TransferKind {
+	switch {
+	case internal:
+		return TransferKindInternal
+	case currency != "EUR" || len(counterpartyIban) < 2 || !sepaCountries[strings.ToUpper(counterpartyIban[:2])]:
+		return TransferKindInternational
+	case instant:
+		return TransferKindInstant
+	default:
+		return TransferKindSEPA
+	}
+}
+
+// Fee returns the transaction debiting the fee charged for the transaction, in the bank account currency.
+//
+// The fee is linked to the transaction and part of its transfer bulk, reversed with it, it is not counted by the
+// transfer limits.
+func (t Transaction) Fee(feeCents Cents, kind TransferKind) TransactionState {
+	return TransactionState{
+		CounterpartyName:     t.CounterpartyName,
+		CounterpartyIban:     t.CounterpartyIban,
+		CounterpartyBic:      t.CounterpartyBic,
+		AmountCents:          feeCents,
+		AmountCurrency:       t.DebitedCurrency,
+		BankAccountID:        t.BankAccountID,
+		Description:          "Fee " + string(kind) + " transfer: " + t.Description,
+		TransferBulkID:       t.TransferBulkID,
+		DebitedCents:         feeCents,
+		DebitedCurrency:      t.DebitedCurrency,
+		ExchangeRate:         NoExchangeRate,
+		ChargedTransactionID: t.ID,
+	}
+}
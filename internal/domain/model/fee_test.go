@@ -0,0 +1,67 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreditTransferKind(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		counterpartyIban string
+		currency         string
+		internal         bool
+		instant          bool
+		want             model.TransferKind
+	}{
+		{
+			name:             "euro to a SEPA country",
+			counterpartyIban: "EE303680981021245685",
+			currency:         "EUR",
+			want:             model.TransferKindSEPA,
+		},
+		{
+			name:             "instant euro to a SEPA country",
+			counterpartyIban: "FR1420041010050500013M02606",
+			currency:         "EUR",
+			instant:          true,
+			want:             model.TransferKindInstant,
+		},
+		{
+			name:             "euro outside the SEPA countries",
+			counterpartyIban: "TR330006100519786457841326",
+			currency:         "EUR",
+			instant:          true,
+			want:             model.TransferKindInternational,
+		},
+		{
+			name:             "other currency to a SEPA country",
+			counterpartyIban: "GB29NWBK60161331926819",
+			currency:         "GBP",
+			want:             model.TransferKindInternational,
+		},
+		{
+			name:             "other bank account of the service",
+			counterpartyIban: "TR330006100519786457841326",
+			currency:         "USD",
+			internal:         true,
+			want:             model.TransferKindInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := model.CreditTransferKind(tc.counterpartyIban, tc.currency, tc.internal, tc.instant)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
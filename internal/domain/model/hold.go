@@ -0,0 +1,91 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNotEnoughAvailableBalance error represents when the available balance of the account does not cover the hold.
+	ErrNotEnoughAvailableBalance = errors.New("not enough available balance")
+	// ErrHoldNotActive error represents when the hold was already captured, released or expired.
+	ErrHoldNotActive = errors.New("hold not active")
+	// ErrCaptureExceedsHold error represents when the captured amount is greater than the held amount.
+	ErrCaptureExceedsHold = errors.New("capture amount exceeds the held amount")
+)
+
+// HoldID is the type of Hold id.
+type HoldID int64
+
+// HoldStatus is the status of a Hold.
+//
+// A hold is active from its placement until it is captured, released or it expires.
+type HoldStatus string
+
+const (
+	// HoldActive is the status of a hold reserving its amount.
+	HoldActive HoldStatus = "active"
+	// HoldCaptured is the status of a hold which amount, or part of it, was debited from the bank account.
+	HoldCaptured HoldStatus = "captured"
+	// HoldReleased is the status of a hold released without debiting the bank account.
+	HoldReleased HoldStatus = "released"
+	// HoldExpired is the status of a hold released once its expiry was reached.
+	HoldExpired HoldStatus = "expired"
+)
+
+// Hold represents an amount reserved on a bank account before it is debited, e.g. a card authorization.
+type Hold struct {
+	ID HoldID `db:"id"`
+
+	HoldState
+}
+
+// HoldState represents the Hold internal state/data.
+type HoldState struct {
+	BankAccountID BankAccountID `db:"bank_account_id"`
+	// AmountCents is the held amount, in the bank account currency.
+	AmountCents      Cents      `db:"amount_cents"`
+	Currency         string     `db:"currency"`
+	CounterpartyName string     `db:"counterparty_name"`
+	Description      string     `db:"description"`
+	Status           HoldStatus `db:"status"`
+	// CapturedCents is the amount debited by the capture, and TransactionID the transaction debiting it, 0 until the
+	// hold is captured.
+	CapturedCents Cents         `db:"captured_cents"`
+	TransactionID TransactionID `db:"transaction_id"`
+
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// Capturable returns the amount of the hold captured at the time, the whole held amount when amountCents is 0.
+func (h Hold) Capturable(amountCents Cents, now time.Time) (Cents, error) {
+	if h.Status != HoldActive || !now.Before(h.ExpiresAt) {
+		return 0, ErrHoldNotActive
+	}
+
+	if amountCents == 0 {
+		return h.AmountCents, nil
+	}
+
+	if amountCents > h.AmountCents {
+		return 0, ErrCaptureExceedsHold
+	}
+
+	return amountCents, nil
+}
+
+// Capture returns the transaction debiting the captured amount from the bank account.
+func (h Hold) Capture(amountCents Cents) TransactionState {
+	return TransactionState{
+		CounterpartyName: h.CounterpartyName,
+		AmountCents:      amountCents,
+		AmountCurrency:   h.Currency,
+		BankAccountID:    h.BankAccountID,
+		Description:      h.Description,
+		DebitedCents:     amountCents,
+		DebitedCurrency:  h.Currency,
+		ExchangeRate:     NoExchangeRate,
+	}
+}
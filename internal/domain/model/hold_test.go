@@ -0,0 +1,82 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHold_Capturable(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 13, 9, 0, 0, 0, time.UTC)
+
+	hold := model.Hold{ID: 1, HoldState: model.HoldState{
+		AmountCents: 1500,
+		Status:      model.HoldActive,
+		ExpiresAt:   now.Add(time.Hour),
+	}}
+
+	tests := []struct {
+		name        string
+		hold        func() model.Hold
+		amountCents model.Cents
+		want        model.Cents
+		err         error
+	}{
+		{
+			name: "whole amount captured",
+			want: 1500,
+		},
+		{
+			name:        "part of the amount captured",
+			amountCents: 1000,
+			want:        1000,
+		},
+		{
+			name:        "amount exceeding the hold",
+			amountCents: 1501,
+			err:         model.ErrCaptureExceedsHold,
+		},
+		{
+			name: "hold released",
+			hold: func() model.Hold {
+				h := hold
+				h.Status = model.HoldReleased
+
+				return h
+			},
+			err: model.ErrHoldNotActive,
+		},
+		{
+			name: "hold expired",
+			hold: func() model.Hold {
+				h := hold
+				h.ExpiresAt = now
+
+				return h
+			},
+			err: model.ErrHoldNotActive,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := hold
+			if tc.hold != nil {
+				h = tc.hold()
+			}
+
+			got, err := h.Capturable(tc.amountCents, now)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+var (
+	// ErrInvalidIBAN error represents when the IBAN is malformed, has the wrong length or a wrong checksum.
+	ErrInvalidIBAN = errors.New("invalid IBAN")
+	// ErrInvalidBIC error represents when the BIC is malformed.
+	ErrInvalidBIC = errors.New("invalid BIC")
+)
+
+var (
+	ibanRegexp = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`)
+	bicRegexp  = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+)
+
+// ibanLengths maps the countries of the IBAN registry to the length of their IBAN.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BR": 29,
+	"BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DK": 18, "DO": 28, "EE": 20, "EG": 29,
+	"ES": 24, "FI": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28,
+	"HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27, "JO": 30, "KW": 30, "KZ": 20,
+	"LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22,
+	"MK": 19, "MR": 27, "MT": 31, "MU": 30, "NL": 18, "NO": 15, "PK": 24, "PL": 28, "PS": 29, "PT": 25,
+	"QA": 29, "RO": 24, "RS": 22, "SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27,
+	"ST": 25, "SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+}
+
+// ValidateIBAN validates the IBAN in its electronic format, e.g. "FR1420041010050500013M02606".
+//
+// Checks the length of the IBAN for its country and the mod-97 checksum.
+func ValidateIBAN(iban string) error {
+	if !ibanRegexp.MatchString(iban) {
+		return fmt.Errorf("%w: %q is malformed", ErrInvalidIBAN, iban)
+	}
+
+	country := iban[:2]
+
+	length, ok := ibanLengths[country]
+	if !ok {
+		return fmt.Errorf("%w: unknown country %q", ErrInvalidIBAN, country)
+	}
+
+	if len(iban) != length {
+		return fmt.Errorf("%w: %s IBAN must have %d characters, got %d", ErrInvalidIBAN, country, length, len(iban))
+	}
+
+	// move the country and check digits to the end, letters are numbers from 10 (A) to 35 (Z).
+	var mod int
+
+	for _, c := range iban[4:] + iban[:4] {
+		if c >= 'A' {
+			mod = (mod*100 + int(c-'A') + 10) % 97
+
+			continue
+		}
+
+		mod = (mod*10 + int(c-'0')) % 97
+	}
+
+	if mod != 1 {
+		return fmt.Errorf("%w: %q has a wrong checksum", ErrInvalidIBAN, iban)
+	}
+
+	return nil
+}
+
+// ValidateBIC validates the BIC has 8 or 11 characters, e.g. "CRLYFRPPTOU".
+func ValidateBIC(bic string) error {
+	if !bicRegexp.MatchString(bic) {
+		return fmt.Errorf("%w: %q must have 8 or 11 characters, bank and country code letters", ErrInvalidBIC, bic)
+	}
+
+	return nil
+}
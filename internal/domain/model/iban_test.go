@@ -0,0 +1,48 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIBAN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		iban string
+		err  error
+	}{
+		{iban: "FR1420041010050500013M02606"},
+		{iban: "DE89370400440532013000"},
+		{iban: "NL24ABNA5055036109"},
+		{iban: "EE383680981021245685", err: model.ErrInvalidIBAN},
+		{iban: "DE9935420810036209081725212", err: model.ErrInvalidIBAN},
+		{iban: "ZZ1420041010050500013M02606", err: model.ErrInvalidIBAN},
+		{iban: "fr1420041010050500013m02606", err: model.ErrInvalidIBAN},
+		{iban: "", err: model.ErrInvalidIBAN},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.iban, func(t *testing.T) {
+			t.Parallel()
+
+			err := model.ValidateIBAN(tc.iban)
+
+			assert.ErrorIsf(t, err, tc.err, "ValidateIBAN() err got = %v, want %v", err, tc.err)
+		})
+	}
+}
+
+func TestValidateBIC(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, model.ValidateBIC("CRLYFRPPTOU"))
+	assert.NoError(t, model.ValidateBIC("ZDRPLBQI"))
+	assert.ErrorIs(t, model.ValidateBIC("ZDRPLBQ"), model.ErrInvalidBIC)
+	assert.ErrorIs(t, model.ValidateBIC("CRLYFRPPTOUX"), model.ErrInvalidBIC)
+	assert.ErrorIs(t, model.ValidateBIC("CRLY1RPPTOU"), model.ErrInvalidBIC)
+}
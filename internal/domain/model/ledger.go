@@ -0,0 +1,100 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrUnbalancedJournal error represents when the postings of a journal entry do not balance.
+var ErrUnbalancedJournal = errors.New("unbalanced journal entry")
+
+// LedgerAccount is the account of the ledger a posting is booked on.
+type LedgerAccount string
+
+const (
+	// LedgerAccountBankAccount is the ledger account of a customer bank account, identified by the posting BankAccountID.
+	LedgerAccountBankAccount LedgerAccount = "bank_account"
+	// LedgerAccountOutgoingTransfers is the ledger account of the transfers sent to counterparties.
+	LedgerAccountOutgoingTransfers LedgerAccount = "outgoing_transfers"
+	// LedgerAccountIncomingTransfers is the ledger account of the transfers received from counterparties.
+	LedgerAccountIncomingTransfers LedgerAccount = "incoming_transfers"
+	// LedgerAccountOpeningBalances is the ledger account of the balances existing before the ledger.
+	LedgerAccountOpeningBalances LedgerAccount = "opening_balances"
+	// LedgerAccountFeeIncome is the ledger account of the fees charged to the bank accounts.
+	LedgerAccountFeeIncome LedgerAccount = "fee_income"
+)
+
+// JournalEntryID is the type of JournalEntry id.
+type JournalEntryID int64
+
+// JournalEntry represent an entry of the ledger, made of balanced postings.
+type JournalEntry struct {
+	ID JournalEntryID `db:"id"`
+
+	JournalEntryState
+}
+
+// JournalEntryState represents the JournalEntry internal state/data.
+type JournalEntryState struct {
+	TransferBulkID TransferBulkID `db:"transfer_bulk_id"`
+	Description    string         `db:"description"`
+}
+
+// PostingID is the type of Posting id.
+type PostingID int64
+
+// Posting represent the amount booked on a ledger account by a journal entry.
+type Posting struct {
+	ID PostingID `db:"id"`
+
+	PostingState
+}
+
+// PostingState represents the Posting internal state/data.
+//
+// Credits are positive and debits negative amounts, the balance of a bank account is the sum of its postings.
+type PostingState struct {
+	JournalEntryID JournalEntryID `db:"journal_entry_id"`
+	LedgerAccount  LedgerAccount  `db:"ledger_account"`
+	BankAccountID  BankAccountID  `db:"bank_account_id"`
+	TransactionID  TransactionID  `db:"transaction_id"`
+	AmountCents    Cents          `db:"amount_cents"`
+	Currency       string         `db:"currency"`
+}
+
+// BalanceDiscrepancy represents a bank account which stored balance differs from the sum of its postings.
+type BalanceDiscrepancy struct {
+	BankAccountID BankAccountID `db:"bank_account_id"`
+	BalanceCents  Cents         `db:"balance_cents"`
+	PostingsCents Cents         `db:"postings_cents"`
+}
+
+// ValidateJournal validates the postings of a journal entry balance, debits and credits sum zero for each currency.
+func ValidateJournal(postings []PostingState) error {
+	if len(postings) < 2 {
+		return fmt.Errorf("%w: at least two postings are required, got %d", ErrUnbalancedJournal, len(postings))
+	}
+
+	sums := make(map[string]Cents)
+
+	for _, p := range postings {
+		sums[p.Currency] += p.AmountCents
+	}
+
+	currencies := make([]string, 0, len(sums))
+
+	for currency := range sums {
+		currencies = append(currencies, currency)
+	}
+
+	sort.Strings(currencies)
+
+	for _, currency := range currencies {
+		if sums[currency] != 0 {
+			return fmt.Errorf("%w: %s postings sum %s", ErrUnbalancedJournal, currency, FormatCents(sums[currency], currency))
+		}
+	}
+
+	return nil
+}
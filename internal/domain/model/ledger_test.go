@@ -0,0 +1,62 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateJournal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		postings []model.PostingState
+		err      error
+	}{
+		{
+			name: "balanced",
+			postings: []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 1, AmountCents: -1500, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 1, AmountCents: 1000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 2, AmountCents: 500, Currency: "EUR"},
+			},
+		},
+		{
+			name: "unbalanced",
+			postings: []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 1, AmountCents: -1500, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 1, AmountCents: 1000, Currency: "EUR"},
+			},
+			err: model.ErrUnbalancedJournal,
+		},
+		{
+			name: "balanced amounts in different currencies",
+			postings: []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 1, AmountCents: -1000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 1, AmountCents: 1000, Currency: "USD"},
+			},
+			err: model.ErrUnbalancedJournal,
+		},
+		{
+			name: "single posting",
+			postings: []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 1, AmountCents: 0, Currency: "EUR"},
+			},
+			err: model.ErrUnbalancedJournal,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := model.ValidateJournal(tc.postings)
+
+			assert.ErrorIsf(t, err, tc.err, "ValidateJournal() err got = %v, want %v", err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// ScreeningField is the counterparty field matching an entry of the sanctions and block lists.
+type ScreeningField string
+
+const (
+	// ScreeningName is the counterparty name, matched fuzzily.
+	ScreeningName ScreeningField = "name"
+	// ScreeningIban is the counterparty iban, matched exactly.
+	ScreeningIban ScreeningField = "iban"
+	// ScreeningBic is the counterparty bic, matched exactly.
+	ScreeningBic ScreeningField = "bic"
+)
+
+// ScreeningHit is a credit transfer of a TransferBulk which counterparty matches an entry of the sanctions and block
+// lists.
+type ScreeningHit struct {
+	// Row is the position of the credit transfer in the transfer bulk, starting at 0.
+	Row int `json:"row"`
+	// EntryID and EntryName identify the list entry matched.
+	EntryID   string         `json:"entry_id"`
+	EntryName string         `json:"entry_name"`
+	MatchedOn ScreeningField `json:"matched_on"`
+	// Score is the similarity of the counterparty and the entry, from 0 to 1, 1 for the exact matches.
+	Score float64 `json:"score"`
+}
+
+// Reason returns the reason the credit transfer is held for review.
+func (h ScreeningHit) Reason() string {
+	entry := h.EntryID
+	if h.EntryName != "" {
+		entry += " " + h.EntryName
+	}
+
+	return fmt.Sprintf("screening match on %s: %s, score %.2f", h.MatchedOn, entry, h.Score)
+}
+
+// ScreeningHits are the credit transfers of a TransferBulk held for review, stored as JSON.
+type ScreeningHits []ScreeningHit
+
+// Value returns the JSON encoded hits.
+func (h ScreeningHits) Value() (driver.Value, error) {
+	if h == nil {
+		return "[]", nil
+	}
+
+	return jsonValue([]ScreeningHit(h))
+}
+
+// Scan decodes the JSON encoded hits.
+func (h *ScreeningHits) Scan(src interface{}) error {
+	*h = nil
+
+	return jsonScan(src, (*[]ScreeningHit)(h))
+}
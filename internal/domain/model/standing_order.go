@@ -0,0 +1,276 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrStandingOrderStatus error represents when the standing order status does not allow the operation, e.g. pausing a
+// cancelled standing order.
+var ErrStandingOrderStatus = errors.New("operation not allowed in the standing order status")
+
+// StandingOrderID is the type of StandingOrder id.
+type StandingOrderID int64
+
+// StandingOrderFrequency is how often a standing order is executed.
+type StandingOrderFrequency string
+
+const (
+	// StandingOrderDaily executes the standing order every day.
+	StandingOrderDaily StandingOrderFrequency = "daily"
+	// StandingOrderWeekly executes the standing order every week, on the weekday of the start date.
+	StandingOrderWeekly StandingOrderFrequency = "weekly"
+	// StandingOrderMonthly executes the standing order every month, on the day of the month. The last day of the month
+	// is used for the months shorter than the day.
+	StandingOrderMonthly StandingOrderFrequency = "monthly"
+)
+
+// StandingOrderStatus is the status of a StandingOrder.
+//
+// A standing order is active from its creation, it can be paused and resumed, and goes to completed once the recurrence
+// ended or to cancelled when deleted.
+type StandingOrderStatus string
+
+const (
+	// StandingOrderActive is the status of a standing order executed on its next execution date.
+	StandingOrderActive StandingOrderStatus = "active"
+	// StandingOrderPaused is the status of a standing order not executed until resumed.
+	StandingOrderPaused StandingOrderStatus = "paused"
+	// StandingOrderCompleted is the status of a standing order which recurrence ended.
+	StandingOrderCompleted StandingOrderStatus = "completed"
+	// StandingOrderCancelled is the status of a deleted standing order.
+	StandingOrderCancelled StandingOrderStatus = "cancelled"
+)
+
+// StandingOrder represents a credit transfer executed repeatedly by the service, e.g. a monthly rent.
+type StandingOrder struct {
+	ID StandingOrderID `db:"id"`
+
+	StandingOrderState
+}
+
+// StandingOrderState represents the StandingOrder internal state/data.
+type StandingOrderState struct {
+	OrganizationName string `db:"organization_name"`
+	OrganizationIban string `db:"organization_iban"`
+	OrganizationBic  string `db:"organization_bic"`
+
+	StandingOrderTransfer
+	StandingOrderRecurrence
+
+	Status StandingOrderStatus `db:"status"`
+	// NextExecutionDate is the date of the next execution, nil once completed or cancelled.
+	NextExecutionDate *time.Time `db:"next_execution_date"`
+	// ExecutionCount is the number of executions, skipped executions are not counted.
+	ExecutionCount int `db:"execution_count"`
+	// CreatedBy is the user who created the standing order, its transfer bulks are submitted on the user's behalf.
+	CreatedBy string `db:"created_by"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// StandingOrderTransfer is the credit transfer of a StandingOrder.
+type StandingOrderTransfer struct {
+	AmountCents      Cents  `db:"amount_cents"`
+	Currency         string `db:"currency"`
+	CounterpartyName string `db:"counterparty_name"`
+	CounterpartyIban string `db:"counterparty_iban"`
+	CounterpartyBic  string `db:"counterparty_bic"`
+	Description      string `db:"description"`
+}
+
+// StandingOrderRecurrence is the rule of the execution dates of a StandingOrder.
+//
+// The dates are UTC dates, at midnight.
+type StandingOrderRecurrence struct {
+	Frequency StandingOrderFrequency `db:"frequency"`
+	// DayOfMonth is the day of the monthly executions, from 1 to 31.
+	DayOfMonth int       `db:"day_of_month"`
+	StartDate  time.Time `db:"start_date"`
+	// EndDate is the last date the standing order can be executed, nil when there is no end date.
+	EndDate *time.Time `db:"end_date"`
+	// Count is the number of executions after which the standing order is completed, 0 when unlimited.
+	Count int `db:"count"`
+}
+
+// NextOn returns the first execution date on or after the date, regardless of the end date and the count.
+func (r StandingOrderRecurrence) NextOn(date time.Time) time.Time {
+	if date.Before(r.StartDate) {
+		date = r.StartDate
+	}
+
+	switch r.Frequency {
+	case StandingOrderWeekly:
+		days := int(date.Sub(r.StartDate).Hours() / 24)
+
+		return r.StartDate.AddDate(0, 0, (days+6)/7*7)
+	case StandingOrderMonthly:
+		year, month, _ := date.Date()
+
+		next := monthDay(year, month, r.DayOfMonth)
+		if next.Before(date) {
+			next = monthDay(year, month+1, r.DayOfMonth)
+		}
+
+		return next
+	default:
+		return date
+	}
+}
+
+// Schedule sets the next execution date as the first execution date on or after the date.
+//
+// The standing order is completed when the recurrence ended, either by the end date or by the count.
+func (s *StandingOrderState) Schedule(date time.Time) {
+	next := s.NextOn(date)
+
+	if (s.Count > 0 && s.ExecutionCount >= s.Count) || (s.EndDate != nil && next.After(*s.EndDate)) {
+		s.Status = StandingOrderCompleted
+		s.NextExecutionDate = nil
+
+		return
+	}
+
+	s.NextExecutionDate = &next
+}
+
+// Executed counts the execution of the next execution date and schedules the following one.
+func (s *StandingOrderState) Executed() {
+	executionDate := *s.NextExecutionDate
+
+	s.ExecutionCount++
+	s.Schedule(executionDate.AddDate(0, 0, 1))
+}
+
+// Skip skips the next execution date and schedules the following one, the skipped date is returned.
+//
+// Only active and paused standing orders can be skipped.
+func (s *StandingOrderState) Skip() (time.Time, error) {
+	if s.Status != StandingOrderActive && s.Status != StandingOrderPaused {
+		return time.Time{}, ErrStandingOrderStatus
+	}
+
+	skipped := *s.NextExecutionDate
+
+	s.Schedule(skipped.AddDate(0, 0, 1))
+
+	return skipped, nil
+}
+
+// Pause pauses the standing order, pausing a paused standing order does nothing.
+func (s *StandingOrderState) Pause() error {
+	switch s.Status {
+	case StandingOrderActive:
+		s.Status = StandingOrderPaused
+
+		return nil
+	case StandingOrderPaused:
+		return nil
+	default:
+		return ErrStandingOrderStatus
+	}
+}
+
+// Resume resumes the paused standing order, resuming an active standing order does nothing.
+//
+// The execution dates before today, missed while paused, are not executed.
+func (s *StandingOrderState) Resume(today time.Time) error {
+	switch s.Status {
+	case StandingOrderActive:
+		return nil
+	case StandingOrderPaused:
+		s.Status = StandingOrderActive
+
+		if s.NextExecutionDate.Before(today) {
+			s.Schedule(today)
+		}
+
+		return nil
+	default:
+		return ErrStandingOrderStatus
+	}
+}
+
+// Cancel cancels the standing order, it is no longer executed. Cancelling a cancelled standing order does nothing.
+func (s *StandingOrderState) Cancel() error {
+	switch s.Status {
+	case StandingOrderActive, StandingOrderPaused:
+		s.Status = StandingOrderCancelled
+		s.NextExecutionDate = nil
+
+		return nil
+	case StandingOrderCancelled:
+		return nil
+	default:
+		return ErrStandingOrderStatus
+	}
+}
+
+// Update replaces the credit transfer and the end of the recurrence of the active or paused standing order.
+//
+// The standing order is completed when the new end of the recurrence is already reached.
+func (s *StandingOrderState) Update(transfer StandingOrderTransfer, endDate *time.Time, count int) error {
+	if s.Status != StandingOrderActive && s.Status != StandingOrderPaused {
+		return ErrStandingOrderStatus
+	}
+
+	s.StandingOrderTransfer = transfer
+	s.EndDate = endDate
+	s.Count = count
+
+	s.Schedule(*s.NextExecutionDate)
+
+	return nil
+}
+
+// monthDay returns the day of the month, the last day of the month when the month is shorter.
+func monthDay(year int, month time.Month, day int) time.Time {
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+		day = last
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// StandingOrderExecutionID is the type of StandingOrderExecution id.
+type StandingOrderExecutionID int64
+
+// StandingOrderExecutionStatus is the status of a StandingOrderExecution.
+type StandingOrderExecutionStatus string
+
+const (
+	// StandingOrderExecutionEnqueued is the status of an execution which credit transfer is performed by a transfer
+	// bulk job.
+	StandingOrderExecutionEnqueued StandingOrderExecutionStatus = "enqueued"
+	// StandingOrderExecutionSkipped is the status of a skipped execution, its credit transfer is not performed.
+	StandingOrderExecutionSkipped StandingOrderExecutionStatus = "skipped"
+)
+
+// StandingOrderExecution represents an execution date of a StandingOrder, the history of the standing order.
+type StandingOrderExecution struct {
+	ID StandingOrderExecutionID `db:"id"`
+
+	StandingOrderExecutionState
+}
+
+// StandingOrderExecutionState represents the StandingOrderExecution internal state/data.
+type StandingOrderExecutionState struct {
+	StandingOrderID StandingOrderID              `db:"standing_order_id"`
+	ExecutionDate   time.Time                    `db:"execution_date"`
+	Status          StandingOrderExecutionStatus `db:"status"`
+	// TransferBulkJobID is the job performing the credit transfer, when enqueued.
+	TransferBulkJobID TransferBulkJobID `db:"transfer_bulk_job_id"`
+	CreatedAt         time.Time         `db:"created_at"`
+}
+
+// StandingOrderExecutionOutcome is a StandingOrderExecution with the outcome of its transfer bulk job.
+type StandingOrderExecutionOutcome struct {
+	StandingOrderExecution
+
+	// JobStatus is the status of the transfer bulk job, empty when skipped.
+	JobStatus      TransferBulkJobStatus `db:"job_status"`
+	TransferBulkID TransferBulkID        `db:"transfer_bulk_id"`
+	// Error is the reason the transfer bulk job failed.
+	Error string `db:"error"`
+}
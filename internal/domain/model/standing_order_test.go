@@ -0,0 +1,231 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestStandingOrderRecurrence_NextOn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		recurrence model.StandingOrderRecurrence
+		date       time.Time
+		want       time.Time
+	}{
+		{
+			name:       "daily",
+			recurrence: model.StandingOrderRecurrence{Frequency: model.StandingOrderDaily, StartDate: date(2021, 12, 1)},
+			date:       date(2021, 12, 7),
+			want:       date(2021, 12, 7),
+		},
+		{
+			name:       "daily before the start date",
+			recurrence: model.StandingOrderRecurrence{Frequency: model.StandingOrderDaily, StartDate: date(2021, 12, 10)},
+			date:       date(2021, 12, 7),
+			want:       date(2021, 12, 10),
+		},
+		{
+			name:       "weekly on the weekday of the start date",
+			recurrence: model.StandingOrderRecurrence{Frequency: model.StandingOrderWeekly, StartDate: date(2021, 12, 1)},
+			date:       date(2021, 12, 2),
+			want:       date(2021, 12, 8),
+		},
+		{
+			name:       "weekly on an execution date",
+			recurrence: model.StandingOrderRecurrence{Frequency: model.StandingOrderWeekly, StartDate: date(2021, 12, 1)},
+			date:       date(2021, 12, 15),
+			want:       date(2021, 12, 15),
+		},
+		{
+			name:       "monthly later in the month",
+			recurrence: model.StandingOrderRecurrence{Frequency: model.StandingOrderMonthly, DayOfMonth: 5, StartDate: date(2021, 12, 1)},
+			date:       date(2021, 12, 1),
+			want:       date(2021, 12, 5),
+		},
+		{
+			name:       "monthly next month",
+			recurrence: model.StandingOrderRecurrence{Frequency: model.StandingOrderMonthly, DayOfMonth: 5, StartDate: date(2021, 12, 1)},
+			date:       date(2021, 12, 6),
+			want:       date(2022, 1, 5),
+		},
+		{
+			name:       "monthly on the last day of a shorter month",
+			recurrence: model.StandingOrderRecurrence{Frequency: model.StandingOrderMonthly, DayOfMonth: 31, StartDate: date(2022, 1, 1)},
+			date:       date(2022, 2, 1),
+			want:       date(2022, 2, 28),
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.recurrence.NextOn(tc.date)
+
+			assert.Equal(t, tc.want, got, "NextOn() got = %v, want %v", got, tc.want)
+		})
+	}
+}
+
+func TestStandingOrderState_Executed(t *testing.T) {
+	t.Parallel()
+
+	endDate := date(2022, 1, 31)
+	next := date(2022, 1, 5)
+
+	tests := []struct {
+		name       string
+		recurrence model.StandingOrderRecurrence
+		executions int
+		status     model.StandingOrderStatus
+		next       *time.Time
+	}{
+		{
+			name:       "next execution scheduled",
+			recurrence: model.StandingOrderRecurrence{Frequency: model.StandingOrderMonthly, DayOfMonth: 5, StartDate: date(2021, 12, 1)},
+			executions: 1,
+			status:     model.StandingOrderActive,
+			next:       &next,
+		},
+		{
+			name: "completed by the count",
+			recurrence: model.StandingOrderRecurrence{
+				Frequency: model.StandingOrderMonthly, DayOfMonth: 5, StartDate: date(2021, 12, 1), Count: 2,
+			},
+			executions: 2,
+			status:     model.StandingOrderCompleted,
+		},
+		{
+			name: "completed by the end date",
+			recurrence: model.StandingOrderRecurrence{
+				Frequency: model.StandingOrderMonthly, DayOfMonth: 5, StartDate: date(2021, 12, 1), EndDate: &endDate,
+			},
+			executions: 2,
+			status:     model.StandingOrderCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := model.StandingOrderState{
+				StandingOrderRecurrence: tc.recurrence,
+				Status:                  model.StandingOrderActive,
+			}
+
+			state.Schedule(tc.recurrence.StartDate)
+
+			for i := 0; i < tc.executions; i++ {
+				require.NotNil(t, state.NextExecutionDate)
+
+				state.Executed()
+			}
+
+			assert.Equal(t, tc.executions, state.ExecutionCount)
+			assert.Equal(t, tc.status, state.Status)
+
+			if tc.next == nil {
+				assert.Nil(t, state.NextExecutionDate)
+			} else {
+				assert.Equal(t, tc.next, state.NextExecutionDate)
+			}
+		})
+	}
+}
+
+func TestStandingOrderState_transitions(t *testing.T) {
+	t.Parallel()
+
+	newState := func(status model.StandingOrderStatus) *model.StandingOrderState {
+		next := date(2021, 12, 5)
+
+		return &model.StandingOrderState{
+			StandingOrderRecurrence: model.StandingOrderRecurrence{
+				Frequency:  model.StandingOrderMonthly,
+				DayOfMonth: 5,
+				StartDate:  date(2021, 12, 1),
+			},
+			Status:            status,
+			NextExecutionDate: &next,
+		}
+	}
+
+	t.Run("skip", func(t *testing.T) {
+		t.Parallel()
+
+		state := newState(model.StandingOrderActive)
+
+		skipped, err := state.Skip()
+		require.NoError(t, err)
+
+		assert.Equal(t, date(2021, 12, 5), skipped)
+		assert.Equal(t, date(2022, 1, 5), *state.NextExecutionDate)
+		assert.Equal(t, 0, state.ExecutionCount)
+
+		_, err = newState(model.StandingOrderCancelled).Skip()
+		assert.ErrorIs(t, err, model.ErrStandingOrderStatus)
+	})
+
+	t.Run("pause and resume", func(t *testing.T) {
+		t.Parallel()
+
+		state := newState(model.StandingOrderActive)
+
+		require.NoError(t, state.Pause())
+		assert.Equal(t, model.StandingOrderPaused, state.Status)
+
+		require.NoError(t, state.Pause())
+
+		// the execution date missed while paused is not executed.
+		require.NoError(t, state.Resume(date(2021, 12, 20)))
+		assert.Equal(t, model.StandingOrderActive, state.Status)
+		assert.Equal(t, date(2022, 1, 5), *state.NextExecutionDate)
+
+		assert.ErrorIs(t, newState(model.StandingOrderCompleted).Pause(), model.ErrStandingOrderStatus)
+		assert.ErrorIs(t, newState(model.StandingOrderCancelled).Resume(date(2021, 12, 20)), model.ErrStandingOrderStatus)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		t.Parallel()
+
+		state := newState(model.StandingOrderPaused)
+
+		require.NoError(t, state.Cancel())
+		assert.Equal(t, model.StandingOrderCancelled, state.Status)
+		assert.Nil(t, state.NextExecutionDate)
+
+		require.NoError(t, state.Cancel())
+
+		assert.ErrorIs(t, newState(model.StandingOrderCompleted).Cancel(), model.ErrStandingOrderStatus)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		t.Parallel()
+
+		state := newState(model.StandingOrderActive)
+		transfer := model.StandingOrderTransfer{AmountCents: 120000, Currency: "EUR", Description: "Rent"}
+		endDate := date(2021, 12, 4)
+
+		require.NoError(t, state.Update(transfer, &endDate, 0))
+		assert.Equal(t, transfer, state.StandingOrderTransfer)
+		assert.Equal(t, model.StandingOrderCompleted, state.Status)
+		assert.Nil(t, state.NextExecutionDate)
+
+		assert.ErrorIs(t, state.Update(transfer, nil, 0), model.ErrStandingOrderStatus)
+	})
+}
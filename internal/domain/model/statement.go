@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// Statement represents the statement of a bank account over a period.
+//
+// Transactions debit the bank account by their DebitedCents, a negative debited amount credits the account.
+type Statement struct {
+	BankAccount BankAccount
+	// From is the inclusive start of the period, To its exclusive end.
+	From time.Time
+	To   time.Time
+	// OpeningBalanceCents is the balance at From, ClosingBalanceCents at To, in cents of the account currency.
+	OpeningBalanceCents Cents
+	ClosingBalanceCents Cents
+	// Transactions are the transactions created during the period, oldest first.
+	Transactions []Transaction
+	CreatedAt    time.Time
+}
@@ -1,5 +1,19 @@
 package model
 
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrTransactionNotReversible error represents when the transaction cannot be reversed, e.g. a reversal.
+	ErrTransactionNotReversible = errors.New("transaction not reversible")
+	// ErrTransactionAlreadyReversed error represents when the whole amount of the transaction was already reversed.
+	ErrTransactionAlreadyReversed = errors.New("transaction already reversed")
+	// ErrReversalExceedsAmount error represents when the reversal amount is greater than the amount not reversed yet.
+	ErrReversalExceedsAmount = errors.New("reversal amount exceeds the amount not reversed")
+)
+
 // TransactionID is the type of Transaction id.
 type TransactionID int64
 
@@ -12,11 +26,130 @@ type Transaction struct {
 
 // TransactionState represents the Transaction internal state/data.
 type TransactionState struct {
-	CounterpartyName string        `db:"counterparty_name"`
-	CounterpartyIban string        `db:"counterparty_iban"`
-	CounterpartyBic  string        `db:"counterparty_bic"`
-	AmountCents      Cents         `db:"amount_cents"`
-	AmountCurrency   string        `db:"amount_currency"`
-	BankAccountID    BankAccountID `db:"bank_account_id"`
-	Description      string        `db:"description"`
+	CounterpartyName string         `db:"counterparty_name"`
+	CounterpartyIban string         `db:"counterparty_iban"`
+	CounterpartyBic  string         `db:"counterparty_bic"`
+	AmountCents      Cents          `db:"amount_cents"`
+	AmountCurrency   string         `db:"amount_currency"`
+	BankAccountID    BankAccountID  `db:"bank_account_id"`
+	Description      string         `db:"description"`
+	TransferBulkID   TransferBulkID `db:"transfer_bulk_id"`
+
+	// DebitedCents is the amount debited from the bank account, in the bank account currency.
+	DebitedCents    Cents  `db:"debited_cents"`
+	DebitedCurrency string `db:"debited_currency"`
+	// ExchangeRate is the decimal rate used to convert the amount into the debited amount.
+	ExchangeRate string `db:"exchange_rate"`
+
+	// ReversedTransactionID is the transaction reversed by this one, 0 when the transaction is not a reversal.
+	ReversedTransactionID TransactionID  `db:"reversed_transaction_id"`
+	ReversalReason        ReversalReason `db:"reversal_reason"`
+
+	// ExternalReference identifies an inbound credit at the sender bank, empty for the other transactions.
+	ExternalReference string `db:"external_reference"`
+	// Internal tags both the debit and the credit of a transfer between two bank accounts of the service.
+	Internal bool `db:"internal"`
+	// ChargedTransactionID is the transaction this fee is charged for, 0 when the transaction is not a fee.
+	ChargedTransactionID TransactionID `db:"charged_transaction_id"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Reversible returns the amount of the transaction that can still be reversed, given the amount already reversed.
+//
+// Only the transactions debiting the bank account are reversible, neither a reversal nor an internal transfer, which
+// credited another bank account of the service, is.
+func (t Transaction) Reversible(reversedCents Cents) (Cents, error) {
+	if t.ReversedTransactionID != 0 || t.DebitedCents <= 0 || t.Internal {
+		return 0, ErrTransactionNotReversible
+	}
+
+	remaining := t.DebitedCents - reversedCents
+	if remaining <= 0 {
+		return 0, ErrTransactionAlreadyReversed
+	}
+
+	return remaining, nil
+}
+
+// ContraLedgerAccount returns the ledger account the debit of the transaction is posted against, the fee income for
+// the fees charged and the outgoing transfers otherwise.
+func (t Transaction) ContraLedgerAccount() LedgerAccount {
+	if t.ChargedTransactionID != 0 {
+		return LedgerAccountFeeIncome
+	}
+
+	return LedgerAccountOutgoingTransfers
+}
+
+// Reversal returns the transaction crediting back the amount to the bank account, linked to the transaction.
+//
+// The amount is in the bank account currency, the reversal is not converted back into the transaction currency.
+func (t Transaction) Reversal(amountCents Cents, reason ReversalReason) TransactionState {
+	return TransactionState{
+		CounterpartyName:      t.CounterpartyName,
+		CounterpartyIban:      t.CounterpartyIban,
+		CounterpartyBic:       t.CounterpartyBic,
+		AmountCents:           -amountCents,
+		AmountCurrency:        t.DebitedCurrency,
+		BankAccountID:         t.BankAccountID,
+		Description:           t.Description,
+		DebitedCents:          -amountCents,
+		DebitedCurrency:       t.DebitedCurrency,
+		ExchangeRate:          NoExchangeRate,
+		ReversedTransactionID: t.ID,
+		ReversalReason:        reason,
+	}
+}
+
+// ReversalReason is the reason code of a reversal.
+type ReversalReason string
+
+const (
+	// ReversalDuplicate reverses a transaction performed more than once.
+	ReversalDuplicate ReversalReason = "duplicate"
+	// ReversalFraud reverses a fraudulent transaction.
+	ReversalFraud ReversalReason = "fraud"
+	// ReversalIncorrectAmount reverses the amount debited in excess.
+	ReversalIncorrectAmount ReversalReason = "incorrect_amount"
+	// ReversalRequestedByCustomer reverses a transaction on the customer request, e.g. a refund.
+	ReversalRequestedByCustomer ReversalReason = "requested_by_customer"
+	// ReversalReturned reverses a transaction returned by the counterparty bank.
+	ReversalReturned ReversalReason = "returned"
+)
+
+// TransactionSort is the order of listed transactions.
+type TransactionSort string
+
+const (
+	// TransactionSortNewest lists the most recent transactions first.
+	TransactionSortNewest TransactionSort = "newest"
+	// TransactionSortOldest lists the oldest transactions first.
+	TransactionSortOldest TransactionSort = "oldest"
+)
+
+// TransactionCursor is the position of the last listed transaction, the next transactions are listed after it.
+type TransactionCursor struct {
+	CreatedAt time.Time
+	ID        TransactionID
+}
+
+// TransactionFilter defines the transactions of a bank account to list.
+//
+// Zero values do not filter.
+type TransactionFilter struct {
+	BankAccountID BankAccountID
+	// From is the inclusive lower bound of the transaction creation time.
+	From time.Time
+	// To is the exclusive upper bound of the transaction creation time.
+	To time.Time
+	// Counterparty matches case-insensitively part of the counterparty name, or the whole counterparty iban.
+	Counterparty string
+	// MinAmountCents and MaxAmountCents are the inclusive bounds of the debited amount, in the bank account currency.
+	MinAmountCents *Cents
+	MaxAmountCents *Cents
+
+	Sort  TransactionSort
+	After *TransactionCursor
+	Limit int
 }
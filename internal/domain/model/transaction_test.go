@@ -0,0 +1,110 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransaction_Reversible(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		transaction   model.Transaction
+		reversedCents model.Cents
+		want          model.Cents
+		err           error
+	}{
+		{
+			name:        "not reversed",
+			transaction: model.Transaction{ID: 1, TransactionState: model.TransactionState{DebitedCents: 1500}},
+			want:        1500,
+		},
+		{
+			name:          "partially reversed",
+			transaction:   model.Transaction{ID: 1, TransactionState: model.TransactionState{DebitedCents: 1500}},
+			reversedCents: 1000,
+			want:          500,
+		},
+		{
+			name:          "already reversed",
+			transaction:   model.Transaction{ID: 1, TransactionState: model.TransactionState{DebitedCents: 1500}},
+			reversedCents: 1500,
+			err:           model.ErrTransactionAlreadyReversed,
+		},
+		{
+			name: "reversal",
+			transaction: model.Transaction{ID: 2, TransactionState: model.TransactionState{
+				DebitedCents:          -1500,
+				ReversedTransactionID: 1,
+			}},
+			err: model.ErrTransactionNotReversible,
+		},
+		{
+			name:        "internal transfer",
+			transaction: model.Transaction{ID: 1, TransactionState: model.TransactionState{DebitedCents: 1500, Internal: true}},
+			err:         model.ErrTransactionNotReversible,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.transaction.Reversible(tc.reversedCents)
+
+			assert.ErrorIsf(t, err, tc.err, "Reversible() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestTransaction_Reversal(t *testing.T) {
+	t.Parallel()
+
+	transaction := model.Transaction{
+		ID: 1,
+		TransactionState: model.TransactionState{
+			CounterpartyName: "Bip Bip",
+			CounterpartyIban: "EE303680981021245685",
+			CounterpartyBic:  "CRLYFRPPTOU",
+			AmountCents:      1650,
+			AmountCurrency:   "USD",
+			BankAccountID:    1,
+			Description:      "Wonderland/4410",
+			TransferBulkID:   1,
+			DebitedCents:     1500,
+			DebitedCurrency:  "EUR",
+			ExchangeRate:     "0.909",
+		},
+	}
+
+	assert.Equal(t, model.TransactionState{
+		CounterpartyName:      "Bip Bip",
+		CounterpartyIban:      "EE303680981021245685",
+		CounterpartyBic:       "CRLYFRPPTOU",
+		AmountCents:           -500,
+		AmountCurrency:        "EUR",
+		BankAccountID:         1,
+		Description:           "Wonderland/4410",
+		DebitedCents:          -500,
+		DebitedCurrency:       "EUR",
+		ExchangeRate:          model.NoExchangeRate,
+		ReversedTransactionID: 1,
+		ReversalReason:        model.ReversalIncorrectAmount,
+	}, transaction.Reversal(500, model.ReversalIncorrectAmount))
+}
+
+func TestTransaction_ContraLedgerAccount(t *testing.T) {
+	t.Parallel()
+
+	transfer := model.Transaction{ID: 1, TransactionState: model.TransactionState{DebitedCents: 1500}}
+	fee := model.Transaction{ID: 2, TransactionState: model.TransactionState{DebitedCents: 50, ChargedTransactionID: 1}}
+
+	assert.Equal(t, model.LedgerAccountOutgoingTransfers, transfer.ContraLedgerAccount())
+	assert.Equal(t, model.LedgerAccountFeeIncome, fee.ContraLedgerAccount())
+}
@@ -0,0 +1,137 @@
+package model
+
+import "database/sql/driver"
+
+// TransferBulkID is the type of TransferBulk id.
+type TransferBulkID int64
+
+// TransferBulkMode is how the credit transfers of a transfer bulk are executed.
+type TransferBulkMode string
+
+const (
+	// TransferBulkAtomic executes either all the credit transfers or none of them, the default mode.
+	TransferBulkAtomic TransferBulkMode = "atomic"
+	// TransferBulkPartial executes the credit transfers in order, those the remaining balance does not cover are
+	// rejected and the following ones are still executed when covered.
+	TransferBulkPartial TransferBulkMode = "partial"
+)
+
+// TransferBulk represent a bulk of transfers requested at once.
+type TransferBulk struct {
+	ID TransferBulkID `db:"id"`
+
+	TransferBulkState
+}
+
+// TransferBulkState represents the TransferBulk internal state/data.
+type TransferBulkState struct {
+	IdempotencyKey string `db:"idempotency_key"`
+	RequestHash    string `db:"request_hash"`
+
+	// Outcome of the transfer bulk, set once the transfers are performed.
+	BankAccountID BankAccountID `db:"bank_account_id"`
+	DebitedCents  Cents         `db:"debited_cents"`
+	// FeesCents are the fees charged for the credit transfers, debited on top of DebitedCents.
+	FeesCents    Cents `db:"fees_cents"`
+	BalanceCents Cents `db:"balance_cents"`
+	// Currency is the bank account currency of the debited, fees and balance amounts.
+	Currency string `db:"currency"`
+	// Rejections are the credit transfers not executed in partial mode.
+	Rejections TransferBulkRejections `db:"rejections"`
+
+	// Approval of the transfer bulk, executed when it does not exceed the approval threshold.
+	Status TransferBulkStatus `db:"status"`
+	// Request is the JSON encoded request of the transfer bulk, performed on approval.
+	Request string `db:"request"`
+	// SubmittedBy is the user who submitted the transfer bulk, who may not approve it.
+	SubmittedBy string `db:"submitted_by"`
+	// ScreeningHits are the credit transfers matching the sanctions and block lists, the transfer bulk is held for
+	// review.
+	ScreeningHits ScreeningHits `db:"screening_hits"`
+}
+
+// TransferBulkRowStatus is the status of a credit transfer of a TransferBulk.
+type TransferBulkRowStatus string
+
+const (
+	// TransferBulkRowExecuted is the status of an executed credit transfer.
+	TransferBulkRowExecuted TransferBulkRowStatus = "executed"
+	// TransferBulkRowRejected is the status of a credit transfer not executed.
+	TransferBulkRowRejected TransferBulkRowStatus = "rejected"
+	// TransferBulkRowExecutable is the status of a credit transfer a validate-only transfer bulk would execute.
+	TransferBulkRowExecutable TransferBulkRowStatus = "executable"
+	// TransferBulkRowAwaitingApproval is the status of a credit transfer of a transfer bulk awaiting approval.
+	TransferBulkRowAwaitingApproval TransferBulkRowStatus = "awaiting_approval"
+	// TransferBulkRowHeldForReview is the status of a credit transfer which counterparty matches the sanctions and
+	// block lists.
+	TransferBulkRowHeldForReview TransferBulkRowStatus = "held_for_review"
+)
+
+// TransferBulkRow is the outcome of a credit transfer of a TransferBulk.
+type TransferBulkRow struct {
+	Status TransferBulkRowStatus
+	// TransactionID is the transaction of the executed credit transfer.
+	TransactionID TransactionID
+	// Reason is why the credit transfer was rejected.
+	Reason string
+}
+
+// TransferBulkRejection is a credit transfer of a TransferBulk not executed.
+type TransferBulkRejection struct {
+	// Row is the position of the credit transfer in the transfer bulk, starting at 0.
+	Row    int    `json:"row"`
+	Reason string `json:"reason"`
+}
+
+// TransferBulkRejections are the credit transfers of a TransferBulk not executed, stored as JSON.
+type TransferBulkRejections []TransferBulkRejection
+
+// Value returns the JSON encoded rejections.
+func (r TransferBulkRejections) Value() (driver.Value, error) {
+	if r == nil {
+		return "[]", nil
+	}
+
+	return jsonValue([]TransferBulkRejection(r))
+}
+
+// Scan decodes the JSON encoded rejections.
+func (r *TransferBulkRejections) Scan(src interface{}) error {
+	*r = nil
+
+	return jsonScan(src, (*[]TransferBulkRejection)(r))
+}
+
+// TransferBulkRows returns the outcome of each credit transfer, in order, from the transactions of the executed credit
+// transfers and the rejections.
+func TransferBulkRows(transactionIDs []TransactionID, rejections TransferBulkRejections) []TransferBulkRow {
+	rows := make([]TransferBulkRow, len(transactionIDs)+len(rejections))
+
+	for _, rejection := range rejections {
+		if rejection.Row < 0 || rejection.Row >= len(rows) {
+			continue
+		}
+
+		rows[rejection.Row] = TransferBulkRow{
+			Status: TransferBulkRowRejected,
+			Reason: rejection.Reason,
+		}
+	}
+
+	next := 0
+
+	for i := range rows {
+		if rows[i].Status == TransferBulkRowRejected || next >= len(transactionIDs) {
+			continue
+		}
+
+		rows[i] = TransferBulkRow{
+			Status:        TransferBulkRowExecuted,
+			TransactionID: transactionIDs[next],
+		}
+
+		next++
+	}
+
+	return rows
+}
@@ -0,0 +1,171 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrSelfApproval error represents when the transfer bulk is approved or rejected by the user who submitted it.
+var ErrSelfApproval = errors.New("transfer bulk must be decided by a user other than the submitter")
+
+// ErrUnknownSubmitter error represents when the transfer bulk requiring approval is submitted without user, no user
+// may tell apart from the submitter then.
+var ErrUnknownSubmitter = errors.New("transfer bulk requiring approval must be submitted by a known user")
+
+// reasonTransferBulkRejected is the reason of the credit transfers of a rejected transfer bulk.
+const reasonTransferBulkRejected = "transfer bulk rejected"
+
+// TransferBulkStatus is the approval status of a TransferBulk.
+//
+// A transfer bulk exceeding the approval threshold awaits approval, and one with counterparties matching the sanctions
+// and block lists is held for review. Either goes to executed once approved by a user other than the submitter, or to
+// rejected.
+type TransferBulkStatus string
+
+const (
+	// TransferBulkExecuted is the status of a transfer bulk which transfers were performed.
+	TransferBulkExecuted TransferBulkStatus = "executed"
+	// TransferBulkAwaitingApproval is the status of a transfer bulk stored without debit until approved.
+	TransferBulkAwaitingApproval TransferBulkStatus = "awaiting_approval"
+	// TransferBulkRejected is the status of a transfer bulk rejected instead of approved, its transfers are not
+	// performed.
+	TransferBulkRejected TransferBulkStatus = "rejected"
+	// TransferBulkHeldForReview is the status of a transfer bulk stored without debit, its counterparties matching the
+	// sanctions and block lists, until approved.
+	TransferBulkHeldForReview TransferBulkStatus = "held_for_review"
+)
+
+// Pending tells whether the transfer bulk is waiting for a decision, awaiting approval or held for review.
+func (s TransferBulkStatus) Pending() bool {
+	return s == TransferBulkAwaitingApproval || s == TransferBulkHeldForReview
+}
+
+// TransferBulkDecisionID is the type of TransferBulkDecision id.
+type TransferBulkDecisionID int64
+
+// TransferBulkDecisionKind is the kind of a decision of the approval trail of a TransferBulk.
+type TransferBulkDecisionKind string
+
+const (
+	// TransferBulkSubmitted is the decision of storing the transfer bulk awaiting approval.
+	TransferBulkSubmitted TransferBulkDecisionKind = "submitted"
+	// TransferBulkApproved is the decision of performing the transfer bulk awaiting approval.
+	TransferBulkApproved TransferBulkDecisionKind = "approved"
+	// TransferBulkDeclined is the decision of rejecting the transfer bulk awaiting approval.
+	TransferBulkDeclined TransferBulkDecisionKind = "rejected"
+)
+
+// TransferBulkDecision represents a decision of the approval trail of a TransferBulk.
+type TransferBulkDecision struct {
+	ID TransferBulkDecisionID `db:"id"`
+
+	TransferBulkDecisionState
+}
+
+// TransferBulkDecisionState represents the TransferBulkDecision internal state/data.
+type TransferBulkDecisionState struct {
+	TransferBulkID TransferBulkID           `db:"transfer_bulk_id"`
+	Decision       TransferBulkDecisionKind `db:"decision"`
+	// UserID is the user who decided.
+	UserID string `db:"user_id"`
+	// Reason is the comment of the user on the decision.
+	Reason    string    `db:"reason"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ApprovalThresholds are the amounts, in cents by bank account currency, a transfer bulk debiting more than awaits
+// approval. The transfer bulks from bank accounts in a currency without threshold are performed at once.
+type ApprovalThresholds map[string]Cents
+
+// ParseApprovalThresholds parses the decimal amounts by bank account currency, e.g. "EUR": "50000.00".
+func ParseApprovalThresholds(thresholds map[string]string) (ApprovalThresholds, error) {
+	parsed := make(ApprovalThresholds, len(thresholds))
+
+	for currency, amount := range thresholds {
+		// parsing the amount validates the currency too.
+		thresholdCents, err := ParseCents(amount, currency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid approval threshold %s: %w", currency, err)
+		}
+
+		if thresholdCents < 0 {
+			return nil, fmt.Errorf("invalid approval threshold %s: must not be negative", currency)
+		}
+
+		parsed[currency] = thresholdCents
+	}
+
+	return parsed, nil
+}
+
+// Exceeded tells whether debiting the amount, in cents of the currency, requires approval.
+func (t ApprovalThresholds) Exceeded(debitedCents Cents, currency string) bool {
+	threshold, ok := t[currency]
+
+	return ok && debitedCents > threshold
+}
+
+// CheckDecider checks the user may approve or reject the transfer bulk, neither the submitter may nor anyone when the
+// submitter is unknown.
+func (tb TransferBulk) CheckDecider(userID string) error {
+	if tb.SubmittedBy == "" {
+		return ErrUnknownSubmitter
+	}
+
+	if tb.SubmittedBy == userID {
+		return ErrSelfApproval
+	}
+
+	return nil
+}
+
+// Rows returns the outcome of each credit transfer given the number of credit transfers and the transactions of the
+// executed credit transfers.
+//
+// The credit transfers of a pending transfer bulk await approval as well, besides those rejected in partial mode and
+// those matching the sanctions and block lists, held for review. The credit transfers of a rejected transfer bulk are
+// all rejected.
+func (tb TransferBulk) Rows(count int, transactionIDs []TransactionID) []TransferBulkRow {
+	switch tb.Status {
+	case TransferBulkAwaitingApproval, TransferBulkHeldForReview:
+		rows := make([]TransferBulkRow, count)
+
+		for i := range rows {
+			rows[i].Status = TransferBulkRowAwaitingApproval
+		}
+
+		for _, rejection := range tb.Rejections {
+			if rejection.Row >= 0 && rejection.Row < count {
+				rows[rejection.Row] = TransferBulkRow{
+					Status: TransferBulkRowRejected,
+					Reason: rejection.Reason,
+				}
+			}
+		}
+
+		for _, hit := range tb.ScreeningHits {
+			if hit.Row >= 0 && hit.Row < count {
+				rows[hit.Row] = TransferBulkRow{
+					Status: TransferBulkRowHeldForReview,
+					Reason: hit.Reason(),
+				}
+			}
+		}
+
+		return rows
+	case TransferBulkRejected:
+		rows := make([]TransferBulkRow, count)
+
+		for i := range rows {
+			rows[i] = TransferBulkRow{
+				Status: TransferBulkRowRejected,
+				Reason: reasonTransferBulkRejected,
+			}
+		}
+
+		return rows
+	default:
+		return TransferBulkRows(transactionIDs, tb.Rejections)
+	}
+}
@@ -0,0 +1,121 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseApprovalThresholds(t *testing.T) {
+	t.Parallel()
+
+	thresholds, err := model.ParseApprovalThresholds(map[string]string{"EUR": "50000.00", "JPY": "1000000"})
+	require.NoError(t, err)
+
+	assert.Equal(t, model.ApprovalThresholds{"EUR": 5000000, "JPY": 1000000}, thresholds)
+
+	assert.False(t, thresholds.Exceeded(5000000, "EUR"), "Exceeded() at the threshold")
+	assert.True(t, thresholds.Exceeded(5000001, "EUR"), "Exceeded() above the threshold")
+	assert.False(t, thresholds.Exceeded(100000000, "USD"), "Exceeded() without threshold")
+
+	_, err = model.ParseApprovalThresholds(map[string]string{"EUR": "10.001"})
+	assert.Error(t, err)
+
+	_, err = model.ParseApprovalThresholds(map[string]string{"EUR": "-10"})
+	assert.Error(t, err)
+}
+
+func TestTransferBulk_CheckDecider(t *testing.T) {
+	t.Parallel()
+
+	tb := model.TransferBulk{TransferBulkState: model.TransferBulkState{SubmittedBy: "alice"}}
+
+	assert.ErrorIs(t, tb.CheckDecider("alice"), model.ErrSelfApproval)
+	assert.NoError(t, tb.CheckDecider("bob"))
+
+	// submitted without user, nobody may tell apart from the submitter.
+	assert.ErrorIs(t, model.TransferBulk{}.CheckDecider("alice"), model.ErrUnknownSubmitter)
+	assert.ErrorIs(t, model.TransferBulk{}.CheckDecider(""), model.ErrUnknownSubmitter)
+}
+
+func TestTransferBulkStatus_Pending(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, model.TransferBulkAwaitingApproval.Pending())
+	assert.True(t, model.TransferBulkHeldForReview.Pending())
+	assert.False(t, model.TransferBulkExecuted.Pending())
+	assert.False(t, model.TransferBulkRejected.Pending())
+}
+
+func TestTransferBulk_Rows(t *testing.T) {
+	t.Parallel()
+
+	rejections := model.TransferBulkRejections{{Row: 1, Reason: "not enough balance"}}
+
+	tests := []struct {
+		name           string
+		status         model.TransferBulkStatus
+		hits           model.ScreeningHits
+		transactionIDs []model.TransactionID
+		want           []model.TransferBulkRow
+	}{
+		{
+			name:           "executed",
+			status:         model.TransferBulkExecuted,
+			transactionIDs: []model.TransactionID{1, 2},
+			want: []model.TransferBulkRow{
+				{Status: model.TransferBulkRowExecuted, TransactionID: 1},
+				{Status: model.TransferBulkRowRejected, Reason: "not enough balance"},
+				{Status: model.TransferBulkRowExecuted, TransactionID: 2},
+			},
+		},
+		{
+			name:   "awaiting approval",
+			status: model.TransferBulkAwaitingApproval,
+			want: []model.TransferBulkRow{
+				{Status: model.TransferBulkRowAwaitingApproval},
+				{Status: model.TransferBulkRowRejected, Reason: "not enough balance"},
+				{Status: model.TransferBulkRowAwaitingApproval},
+			},
+		},
+		{
+			name:   "held for review",
+			status: model.TransferBulkHeldForReview,
+			hits:   model.ScreeningHits{{Row: 2, EntryID: "SDN-1", EntryName: "Evil Corp", MatchedOn: model.ScreeningName, Score: 0.91}},
+			want: []model.TransferBulkRow{
+				{Status: model.TransferBulkRowAwaitingApproval},
+				{Status: model.TransferBulkRowRejected, Reason: "not enough balance"},
+				{Status: model.TransferBulkRowHeldForReview, Reason: "screening match on name: SDN-1 Evil Corp, score 0.91"},
+			},
+		},
+		{
+			name:   "rejected",
+			status: model.TransferBulkRejected,
+			want: []model.TransferBulkRow{
+				{Status: model.TransferBulkRowRejected, Reason: "transfer bulk rejected"},
+				{Status: model.TransferBulkRowRejected, Reason: "transfer bulk rejected"},
+				{Status: model.TransferBulkRowRejected, Reason: "transfer bulk rejected"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tb := model.TransferBulk{
+				TransferBulkState: model.TransferBulkState{
+					Rejections:    rejections,
+					Status:        tc.status,
+					ScreeningHits: tc.hits,
+				},
+			}
+
+			assert.Equal(t, tc.want, tb.Rows(3, tc.transactionIDs))
+		})
+	}
+}
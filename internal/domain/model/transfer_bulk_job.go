@@ -0,0 +1,111 @@
+package model
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// TransferBulkJobID is the type of TransferBulkJob id.
+type TransferBulkJobID int64
+
+// TransferBulkJobStatus is the status of a TransferBulkJob.
+//
+// A job goes from pending to processing, and from processing to either completed or failed. A scheduled job goes to
+// processing on its execution date, or to cancelled when cancelled before.
+type TransferBulkJobStatus string
+
+const (
+	// TransferBulkJobPending is the status of a job accepted and waiting to be processed.
+	TransferBulkJobPending TransferBulkJobStatus = "pending"
+	// TransferBulkJobProcessing is the status of a job claimed by a worker.
+	TransferBulkJobProcessing TransferBulkJobStatus = "processing"
+	// TransferBulkJobCompleted is the status of a job which transfers were performed.
+	TransferBulkJobCompleted TransferBulkJobStatus = "completed"
+	// TransferBulkJobFailed is the status of a job which transfers were not performed.
+	TransferBulkJobFailed TransferBulkJobStatus = "failed"
+	// TransferBulkJobScheduled is the status of a job waiting for its execution date to be processed.
+	TransferBulkJobScheduled TransferBulkJobStatus = "scheduled"
+	// TransferBulkJobCancelled is the status of a scheduled job cancelled before its execution date.
+	TransferBulkJobCancelled TransferBulkJobStatus = "cancelled"
+)
+
+// TransferBulkJob represent a transfer bulk accepted to be processed asynchronously.
+type TransferBulkJob struct {
+	ID TransferBulkJobID `db:"id"`
+
+	TransferBulkJobState
+}
+
+// TransferBulkJobState represents the TransferBulkJob internal state/data.
+type TransferBulkJobState struct {
+	IdempotencyKey string                `db:"idempotency_key"`
+	RequestHash    string                `db:"request_hash"`
+	Status         TransferBulkJobStatus `db:"status"`
+	// Request is the JSON encoded transfer bulk request.
+	Request   string `db:"request"`
+	TotalRows int    `db:"total_rows"`
+	// OrganizationIban is the iban of the bank account debited by the job.
+	OrganizationIban string `db:"organization_iban"`
+	// ExecutionDate is the date the scheduled job is processed, nil when the job is processed as soon as possible.
+	ExecutionDate *time.Time `db:"execution_date"`
+	// SubmittedBy is the user who submitted the job, its transfer bulk is submitted on the user's behalf.
+	SubmittedBy string `db:"submitted_by"`
+
+	// Outcome of the job, set once the job is completed or failed.
+	ProcessedRows  int                 `db:"processed_rows"`
+	Rows           TransferBulkJobRows `db:"rows"`
+	TransferBulkID TransferBulkID      `db:"transfer_bulk_id"`
+	DebitedCents   Cents               `db:"debited_cents"`
+	BalanceCents   Cents               `db:"balance_cents"`
+	Currency       string              `db:"currency"`
+	// FeesCents are the fees charged for the credit transfers, debited on top of DebitedCents.
+	FeesCents Cents `db:"fees_cents"`
+	// Error is the reason the job failed.
+	Error string `db:"error"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// TransferBulkJobRowStatus is the status of a credit transfer of a TransferBulkJob.
+type TransferBulkJobRowStatus string
+
+const (
+	// TransferBulkJobRowCompleted is the status of a performed credit transfer.
+	TransferBulkJobRowCompleted TransferBulkJobRowStatus = "completed"
+	// TransferBulkJobRowFailed is the status of a credit transfer not performed.
+	TransferBulkJobRowFailed TransferBulkJobRowStatus = "failed"
+	// TransferBulkJobRowAwaitingApproval is the status of a credit transfer of a transfer bulk awaiting approval.
+	TransferBulkJobRowAwaitingApproval TransferBulkJobRowStatus = "awaiting_approval"
+	// TransferBulkJobRowHeldForReview is the status of a credit transfer which counterparty matches the sanctions and
+	// block lists.
+	TransferBulkJobRowHeldForReview TransferBulkJobRowStatus = "held_for_review"
+)
+
+// TransferBulkJobRow is the outcome of a credit transfer of a TransferBulkJob.
+type TransferBulkJobRow struct {
+	Status        TransferBulkJobRowStatus `json:"status"`
+	TransactionID TransactionID            `json:"transaction_id,omitempty"`
+	// Error is the reason the credit transfer was not performed, empty when the row itself is valid.
+	Error string `json:"error,omitempty"`
+}
+
+// TransferBulkJobRows are the outcomes of the credit transfers of a TransferBulkJob, in the same order as the credit
+// transfers. They are stored as JSON.
+type TransferBulkJobRows []TransferBulkJobRow
+
+// Value returns the JSON encoded rows.
+func (r TransferBulkJobRows) Value() (driver.Value, error) {
+	if r == nil {
+		return "[]", nil
+	}
+
+	return jsonValue([]TransferBulkJobRow(r))
+}
+
+// Scan decodes the JSON encoded rows.
+func (r *TransferBulkJobRows) Scan(src interface{}) error {
+	*r = nil
+
+	return jsonScan(src, (*[]TransferBulkJobRow)(r))
+}
@@ -0,0 +1,131 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTransferLimitExceeded error represents when the credit transfers breach a transfer limit.
+var ErrTransferLimitExceeded = errors.New("transfer limit exceeded")
+
+// TransferLimitName names the limit breached.
+type TransferLimitName string
+
+const (
+	// TransferLimitMaxTransfer is the maximum amount of a single credit transfer.
+	TransferLimitMaxTransfer TransferLimitName = "max_transfer_cents"
+	// TransferLimitMaxBatch is the maximum amount of the credit transfers of a transfer bulk.
+	TransferLimitMaxBatch TransferLimitName = "max_batch_cents"
+	// TransferLimitDaily is the maximum amount sent from the start of the day, in UTC.
+	TransferLimitDaily TransferLimitName = "daily_cents"
+	// TransferLimitMonthly is the maximum amount sent from the start of the month, in UTC.
+	TransferLimitMonthly TransferLimitName = "monthly_cents"
+	// TransferLimitMaxTransfers is the maximum number of credit transfers sent within the window.
+	TransferLimitMaxTransfers TransferLimitName = "max_transfers"
+)
+
+// TransferLimitID is the type of TransferLimit id.
+type TransferLimitID int64
+
+// TransferLimit represents the limits of the credit transfers sent by a bank account, or by all the bank accounts of
+// an organization.
+type TransferLimit struct {
+	ID TransferLimitID `db:"id"`
+
+	TransferLimitState
+}
+
+// TransferLimitState represents the TransferLimit internal state/data.
+//
+// The limits are in cents of the bank account currency, 0 for no limit.
+type TransferLimitState struct {
+	// BankAccountID is the bank account limited, 0 when the organization is limited.
+	BankAccountID BankAccountID `db:"bank_account_id"`
+	// OrganizationName is the organization which bank accounts are limited together, empty when a bank account is
+	// limited.
+	OrganizationName string `db:"organization_name"`
+
+	MaxTransferCents Cents `db:"max_transfer_cents"`
+	MaxBatchCents    Cents `db:"max_batch_cents"`
+	DailyCents       Cents `db:"daily_cents"`
+	MonthlyCents     Cents `db:"monthly_cents"`
+	// MaxTransfers is the number of credit transfers sent within WindowSeconds.
+	MaxTransfers  int64 `db:"max_transfers"`
+	WindowSeconds int64 `db:"window_seconds"`
+
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// Window returns the time window the number of credit transfers is limited in.
+func (s TransferLimitState) Window() time.Duration {
+	return time.Duration(s.WindowSeconds) * time.Second
+}
+
+// TransferUsage is the use of the limits by the credit transfers sent already.
+type TransferUsage struct {
+	// DailyCents and MonthlyCents are the amounts sent from the start of the day and of the month, in UTC.
+	DailyCents   Cents `db:"daily_cents"`
+	MonthlyCents Cents `db:"monthly_cents"`
+	// WindowTransfers is the number of credit transfers sent within the window.
+	WindowTransfers int64 `db:"window_transfers"`
+}
+
+// TransferLimitError is the error of the credit transfers breaching a transfer limit.
+type TransferLimitError struct {
+	Limit TransferLimitName
+	// Value is the limit breached, Requested what the credit transfers would reach.
+	Value     int64
+	Requested int64
+}
+
+// Error implements error.
+func (e *TransferLimitError) Error() string {
+	return fmt.Sprintf("%s of %d exceeded, %d requested", e.Limit, e.Value, e.Requested)
+}
+
+// Is matches ErrTransferLimitExceeded.
+func (e *TransferLimitError) Is(target error) bool {
+	return target == ErrTransferLimitExceeded
+}
+
+// Check checks the debited amounts of the credit transfers, in cents of the bank account currency, do not breach the
+// limits given the usage.
+//
+// Returns a *TransferLimitError of the first limit breached.
+func (s TransferLimitState) Check(debitedCents []Cents, usage TransferUsage) error {
+	var total Cents
+
+	for _, amountCents := range debitedCents {
+		if s.MaxTransferCents > 0 && amountCents > s.MaxTransferCents {
+			return &TransferLimitError{
+				Limit:     TransferLimitMaxTransfer,
+				Value:     int64(s.MaxTransferCents),
+				Requested: int64(amountCents),
+			}
+		}
+
+		total += amountCents
+	}
+
+	for _, limit := range []struct {
+		name      TransferLimitName
+		value     int64
+		requested int64
+	}{
+		{name: TransferLimitMaxBatch, value: int64(s.MaxBatchCents), requested: int64(total)},
+		{name: TransferLimitDaily, value: int64(s.DailyCents), requested: int64(usage.DailyCents + total)},
+		{name: TransferLimitMonthly, value: int64(s.MonthlyCents), requested: int64(usage.MonthlyCents + total)},
+		{name: TransferLimitMaxTransfers, value: s.MaxTransfers, requested: usage.WindowTransfers + int64(len(debitedCents))},
+	} {
+		if limit.value > 0 && limit.requested > limit.value {
+			return &TransferLimitError{
+				Limit:     limit.name,
+				Value:     limit.value,
+				Requested: limit.requested,
+			}
+		}
+	}
+
+	return nil
+}
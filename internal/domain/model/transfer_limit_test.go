@@ -0,0 +1,98 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferLimitState_Check(t *testing.T) {
+	t.Parallel()
+
+	limits := model.TransferLimitState{
+		MaxTransferCents: 1000,
+		MaxBatchCents:    1500,
+		DailyCents:       3000,
+		MonthlyCents:     10000,
+		MaxTransfers:     5,
+		WindowSeconds:    60,
+	}
+
+	tests := []struct {
+		name         string
+		limits       model.TransferLimitState
+		debitedCents []model.Cents
+		usage        model.TransferUsage
+		want         *model.TransferLimitError
+	}{
+		{
+			name:         "within the limits",
+			limits:       limits,
+			debitedCents: []model.Cents{1000, 500},
+			usage:        model.TransferUsage{DailyCents: 1500, MonthlyCents: 8500, WindowTransfers: 3},
+		},
+		{
+			name:         "no limits",
+			debitedCents: []model.Cents{1000000},
+			usage:        model.TransferUsage{DailyCents: 1000000, MonthlyCents: 1000000, WindowTransfers: 1000},
+		},
+		{
+			name:         "single transfer exceeded",
+			limits:       limits,
+			debitedCents: []model.Cents{100, 1001},
+			want:         &model.TransferLimitError{Limit: model.TransferLimitMaxTransfer, Value: 1000, Requested: 1001},
+		},
+		{
+			name:         "batch exceeded",
+			limits:       limits,
+			debitedCents: []model.Cents{1000, 501},
+			want:         &model.TransferLimitError{Limit: model.TransferLimitMaxBatch, Value: 1500, Requested: 1501},
+		},
+		{
+			name:         "daily total exceeded",
+			limits:       limits,
+			debitedCents: []model.Cents{1000},
+			usage:        model.TransferUsage{DailyCents: 2001},
+			want:         &model.TransferLimitError{Limit: model.TransferLimitDaily, Value: 3000, Requested: 3001},
+		},
+		{
+			name:         "monthly total exceeded",
+			limits:       limits,
+			debitedCents: []model.Cents{1000},
+			usage:        model.TransferUsage{MonthlyCents: 9001},
+			want:         &model.TransferLimitError{Limit: model.TransferLimitMonthly, Value: 10000, Requested: 10001},
+		},
+		{
+			name:         "transfers in the window exceeded",
+			limits:       limits,
+			debitedCents: []model.Cents{100, 100},
+			usage:        model.TransferUsage{WindowTransfers: 4},
+			want:         &model.TransferLimitError{Limit: model.TransferLimitMaxTransfers, Value: 5, Requested: 6},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.limits.Check(tc.debitedCents, tc.usage)
+
+			if tc.want == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, model.ErrTransferLimitExceeded)
+
+			var lerr *model.TransferLimitError
+
+			require.ErrorAs(t, err, &lerr)
+			assert.Equal(t, tc.want, lerr)
+		})
+	}
+}
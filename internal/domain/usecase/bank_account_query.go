@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+// BankAccountQuery defines the functionality of the use case BankAccountQuery used to read the bank account details.
+type BankAccountQuery interface {
+	// BankAccount returns the bank account looked up by id, or by iban and bic, with its balances.
+	BankAccount(ctx context.Context, input BankAccountInput) (*BankAccountOutput, error)
+}
+
+// BankAccountInput is the input of the use case BankAccountQuery.
+//
+// The bank account is looked up by ID when set, otherwise by Iban and Bic.
+type BankAccountInput struct {
+	ID   model.BankAccountID
+	Iban string
+	Bic  string
+}
+
+// BankAccountOutput is the output of the use case BankAccountQuery.
+type BankAccountOutput struct {
+	model.BankAccount
+
+	// AvailableBalanceCents is the balance minus the amounts held by pending operations, in cents of the account
+	// currency.
+	AvailableBalanceCents model.Cents
+	// OverdraftUsedCents is the part of the overdraft facility used, in cents of the account currency.
+	OverdraftUsedCents model.Cents
+}
+
+// BankAccountIDFinder is a storage interface that defines the functionality to find the bank account by id.
+type BankAccountIDFinder interface {
+	// FindByID finds the bank account of the id from a storage.
+	FindByID(ctx context.Context, id model.BankAccountID) (*model.BankAccount, error)
+}
+
+// BankAccountIbanBicFinder is a storage interface that defines the functionality to find the bank account by iban and
+// bic.
+type BankAccountIbanBicFinder interface {
+	// FindByIbanBic finds the bank account of the iban and bic from a storage.
+	FindByIbanBic(ctx context.Context, iban, bic string) (*model.BankAccount, error)
+}
+
+type bankAccountQuery struct {
+	idFinder      BankAccountIDFinder
+	ibanBicFinder BankAccountIbanBicFinder
+}
+
+var _ BankAccountQuery = new(bankAccountQuery)
+
+// NewBankAccountQuery creates an instance of BankAccountQuery use case.
+func NewBankAccountQuery(idFinder BankAccountIDFinder, ibanBicFinder BankAccountIbanBicFinder) BankAccountQuery {
+	return &bankAccountQuery{
+		idFinder:      idFinder,
+		ibanBicFinder: ibanBicFinder,
+	}
+}
+
+// BankAccount returns the bank account looked up by id, or by iban and bic, with its balances.
+func (baq *bankAccountQuery) BankAccount(ctx context.Context, input BankAccountInput) (*BankAccountOutput, error) {
+	ctx = ctxd.AddFields(ctx, "bank_account_id", input.ID, "iban", input.Iban, "bic", input.Bic)
+
+	var (
+		account *model.BankAccount
+		err     error
+	)
+
+	if input.ID != 0 {
+		account, err = baq.idFinder.FindByID(ctx, input.ID)
+	} else {
+		if err = validateBankAccountInput(input); err != nil {
+			return nil, ctxd.WrapError(ctx, err, "failed to validate input")
+		}
+
+		account, err = baq.ibanBicFinder.FindByIbanBic(ctx, input.Iban, input.Bic)
+	}
+
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to find account")
+	}
+
+	return bankAccountOutput(*account), nil
+}
+
+// bankAccountOutput returns the output of the bank account with its balances.
+func bankAccountOutput(account model.BankAccount) *BankAccountOutput {
+	return &BankAccountOutput{
+		BankAccount:           account,
+		AvailableBalanceCents: account.AvailableBalanceCents(),
+		OverdraftUsedCents:    account.OverdraftUsedCents(),
+	}
+}
+
+// validateBankAccountInput validates the iban and bic of the input looking up the bank account by iban and bic.
+//
+// Returns a *ValidationError with all the field violations when the input is not valid.
+func validateBankAccountInput(input BankAccountInput) error {
+	var verr ValidationError
+
+	if input.Iban == "" {
+		verr.add("iban", errors.New("must not be empty when id is not set"))
+	}
+
+	if input.Bic == "" {
+		verr.add("bic", errors.New("must not be empty when id is not set"))
+	}
+
+	if len(verr.Violations) > 0 {
+		return &verr
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type bankAccountFinderMock struct {
+	account *model.BankAccount
+}
+
+func (bafm *bankAccountFinderMock) FindByID(_ context.Context, id model.BankAccountID) (*model.BankAccount, error) {
+	if bafm.account == nil || bafm.account.ID != id {
+		return nil, storage.ErrNotFound
+	}
+
+	return bafm.account, nil
+}
+
+func (bafm *bankAccountFinderMock) FindByIbanBic(_ context.Context, iban, bic string) (*model.BankAccount, error) {
+	if bafm.account == nil || bafm.account.Iban != iban || bafm.account.Bic != bic {
+		return nil, storage.ErrNotFound
+	}
+
+	return bafm.account, nil
+}
+
+func Test_bankAccountQuery_BankAccount(t *testing.T) {
+	t.Parallel()
+
+	account := &model.BankAccount{
+		ID: 1,
+		BankAccountState: model.BankAccountState{
+			OrganizationName: "ACME Corp",
+			BalanceCents:     10000000,
+			Iban:             "FR10474608000002006107XXXXX",
+			Bic:              "OIVUSCLQXXX",
+			Currency:         "EUR",
+			HeldCents:        2500000,
+		},
+	}
+
+	want := &usecase.BankAccountOutput{
+		BankAccount:           *account,
+		AvailableBalanceCents: 7500000,
+	}
+
+	tests := []struct {
+		name  string
+		input usecase.BankAccountInput
+		want  *usecase.BankAccountOutput
+		err   error
+	}{
+		{
+			name:  "account found by id",
+			input: usecase.BankAccountInput{ID: 1},
+			want:  want,
+		},
+		{
+			name:  "account found by iban and bic",
+			input: usecase.BankAccountInput{Iban: "FR10474608000002006107XXXXX", Bic: "OIVUSCLQXXX"},
+			want:  want,
+		},
+		{
+			name:  "account not found",
+			input: usecase.BankAccountInput{ID: 2},
+			err:   storage.ErrNotFound,
+		},
+		{
+			name:  "invalid input, neither id nor iban and bic",
+			input: usecase.BankAccountInput{Iban: "FR10474608000002006107XXXXX"},
+			err:   usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			finder := &bankAccountFinderMock{account: account}
+			baq := usecase.NewBankAccountQuery(finder, finder)
+
+			got, err := baq.BankAccount(context.Background(), tc.input)
+
+			assert.ErrorIsf(t, err, tc.err, "BankAccount() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.want, got, "BankAccount() got = %v, want %v", got, tc.want)
+		})
+	}
+}
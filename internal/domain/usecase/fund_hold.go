@@ -0,0 +1,500 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/nhatthm/go-clock"
+)
+
+// DefaultHoldExpiry is the time a hold reserves its amount when the expiry is not set.
+const DefaultHoldExpiry = 7 * 24 * time.Hour
+
+// FundHold defines the functionality of the use case FundHold used to reserve amounts of the bank accounts before
+// debiting them, e.g. card authorizations.
+//
+// The held amounts are not part of the available balance, the transfers cannot spend them.
+type FundHold interface {
+	// Place reserves the amount on the bank account until the hold is captured, released or it expires.
+	Place(ctx context.Context, input PlaceHoldInput) (*HoldOutput, error)
+	// Capture debits the held amount, or part of it, from the bank account. The hold is closed, the amount not captured
+	// is released.
+	Capture(ctx context.Context, input CaptureHoldInput) (*HoldOutput, error)
+	// Release releases the held amount without debiting the bank account.
+	Release(ctx context.Context, input ReleaseHoldInput) (*HoldOutput, error)
+	// ExpireNext releases the active hold which expiry is the earliest, reached now.
+	//
+	// Returns false when there is no hold to expire.
+	ExpireNext(ctx context.Context) (bool, error)
+}
+
+// PlaceHoldInput is the input of the use case FundHold used to place a hold.
+type PlaceHoldInput struct {
+	Iban string
+	// AmountCents is the amount to hold, in the bank account currency.
+	AmountCents      model.Cents
+	CounterpartyName string
+	Description      string
+	// ExpiresAt is the RFC 3339 time the hold is released if not captured, DefaultHoldExpiry from now when empty.
+	ExpiresAt string
+}
+
+// CaptureHoldInput is the input of the use case FundHold used to capture a hold.
+type CaptureHoldInput struct {
+	Iban   string
+	HoldID model.HoldID
+	// AmountCents is the amount to debit, in the bank account currency. The whole held amount when 0.
+	AmountCents model.Cents
+}
+
+// ReleaseHoldInput is the input of the use case FundHold used to release a hold.
+type ReleaseHoldInput struct {
+	Iban   string
+	HoldID model.HoldID
+}
+
+// HoldOutput is the outcome of the use case FundHold.
+type HoldOutput struct {
+	Hold                  model.Hold
+	BalanceCents          model.Cents
+	AvailableBalanceCents model.Cents
+	// Currency is the bank account currency of the balance amounts.
+	Currency string
+}
+
+// HoldAdder is a storage interface that defines the functionality to add the hold.
+type HoldAdder interface {
+	// Add adds the hold into a storage.
+	Add(ctx context.Context, holdState model.HoldState) (*model.Hold, error)
+}
+
+// HoldFinder is a storage interface that defines the functionality to find the hold.
+type HoldFinder interface {
+	// Find finds the hold of the bank account from a storage, locked until the transaction ends.
+	Find(ctx context.Context, bankAccountID model.BankAccountID, id model.HoldID) (*model.Hold, error)
+}
+
+// HoldExpiredFinder is a storage interface that defines the functionality to find the expired holds.
+type HoldExpiredFinder interface {
+	// FindExpired finds the active hold which expiry is the earliest, reached at the time, from a storage.
+	//
+	// Returns nil when there is no expired hold.
+	FindExpired(ctx context.Context, now time.Time) (*model.Hold, error)
+}
+
+// HoldUpdater is a storage interface that defines the functionality to update the hold.
+type HoldUpdater interface {
+	// Update stores the status and the capture of the hold into a storage.
+	Update(ctx context.Context, hold model.Hold) error
+}
+
+// HeldUpdater is a storage interface that defines the functionality to update the amount held on the account.
+type HeldUpdater interface {
+	// HeldAdd adds the amount to the amount held on the account from a storage, a negative amount releases it.
+	HeldAdd(ctx context.Context, accountID model.BankAccountID, amount model.Cents) error
+}
+
+type fundHold struct {
+	logger           ctxd.Logger
+	storage          *sqluct.Storage
+	clock            clock.Clock
+	accountFinder    BankAccountIbanFinder
+	locker           AccountLocker
+	adder            HoldAdder
+	finder           HoldFinder
+	expiredFinder    HoldExpiredFinder
+	updater          HoldUpdater
+	heldUpdater      HeldUpdater
+	transactionAdder TransactionAdder
+	poster           JournalPoster
+	balanceUpdater   BalanceUpdater
+}
+
+var _ FundHold = new(fundHold)
+
+// NewFundHold creates an instance of FundHold use case.
+func NewFundHold(
+	logger ctxd.Logger,
+	storage *sqluct.Storage,
+	clock clock.Clock,
+	accountFinder BankAccountIbanFinder,
+	locker AccountLocker,
+	adder HoldAdder,
+	finder HoldFinder,
+	expiredFinder HoldExpiredFinder,
+	updater HoldUpdater,
+	heldUpdater HeldUpdater,
+	transactionAdder TransactionAdder,
+	poster JournalPoster,
+	balanceUpdater BalanceUpdater,
+) FundHold {
+	return &fundHold{
+		logger:           logger,
+		storage:          storage,
+		clock:            clock,
+		accountFinder:    accountFinder,
+		locker:           locker,
+		adder:            adder,
+		finder:           finder,
+		expiredFinder:    expiredFinder,
+		updater:          updater,
+		heldUpdater:      heldUpdater,
+		transactionAdder: transactionAdder,
+		poster:           poster,
+		balanceUpdater:   balanceUpdater,
+	}
+}
+
+// Place reserves the amount on the bank account until the hold is captured, released or it expires.
+//
+// The available balance of the bank account, plus its overdraft limit, must cover the amount.
+func (fh *fundHold) Place(ctx context.Context, input PlaceHoldInput) (*HoldOutput, error) {
+	ctx = ctxd.AddFields(ctx,
+		"iban", input.Iban,
+		"amount_cents", input.AmountCents,
+	)
+
+	now := fh.clock.Now()
+
+	expiresAt, err := validatePlaceHoldInput(input, now)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to validate input")
+	}
+
+	// the account is locked by id within the transaction, not to hold a share lock first.
+	account, err := fh.accountFinder.FindByIban(ctx, input.Iban)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to find account")
+	}
+
+	var output *HoldOutput
+
+	err = fh.storage.InTx(ctx, func(ctx context.Context) error {
+		account, err := fh.locker.Lock(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		if account.SpendableCents() < input.AmountCents {
+			return ctxd.WrapError(ctx, model.ErrNotEnoughAvailableBalance, "failed to place hold",
+				"available_balance_cents", account.AvailableBalanceCents(),
+				"overdraft_limit_cents", account.OverdraftLimitCents,
+			)
+		}
+
+		hold, err := fh.adder.Add(ctx, model.HoldState{
+			BankAccountID:    account.ID,
+			AmountCents:      input.AmountCents,
+			Currency:         account.Currency,
+			CounterpartyName: input.CounterpartyName,
+			Description:      input.Description,
+			Status:           model.HoldActive,
+			ExpiresAt:        expiresAt,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		})
+		if err != nil {
+			return err
+		}
+
+		account.HeldCents += hold.AmountCents
+
+		err = fh.heldUpdater.HeldAdd(ctx, account.ID, hold.AmountCents)
+		if err != nil {
+			return err
+		}
+
+		fh.logger.Debug(ctx, "hold placed", "hold_id", hold.ID)
+
+		output = holdOutput(*hold, account)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// Capture debits the held amount, or part of it, from the bank account.
+//
+// The captured amount is debited by a transaction posted into the ledger, and the whole held amount is released.
+func (fh *fundHold) Capture(ctx context.Context, input CaptureHoldInput) (*HoldOutput, error) {
+	ctx = ctxd.AddFields(ctx,
+		"iban", input.Iban,
+		"hold_id", input.HoldID,
+		"amount_cents", input.AmountCents,
+	)
+
+	var verr ValidationError
+
+	if input.Iban == "" {
+		verr.add("iban", errors.New("must not be empty"))
+	}
+
+	if input.AmountCents < 0 {
+		verr.add("amount_cents", errors.New("must not be negative"))
+	}
+
+	if len(verr.Violations) > 0 {
+		return nil, ctxd.WrapError(ctx, &verr, "failed to validate input")
+	}
+
+	account, err := fh.accountFinder.FindByIban(ctx, input.Iban)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to find account")
+	}
+
+	var output *HoldOutput
+
+	err = fh.storage.InTx(ctx, func(ctx context.Context) error {
+		account, err := fh.locker.Lock(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		hold, err := fh.finder.Find(ctx, account.ID, input.HoldID)
+		if err != nil {
+			return err
+		}
+
+		now := fh.clock.Now()
+
+		amountCents, err := hold.Capturable(input.AmountCents, now)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "failed to capture hold", "status", hold.Status)
+		}
+
+		capture := hold.Capture(amountCents)
+		capture.CreatedAt = now
+
+		ids, err := fh.transactionAdder.Add(ctx, []model.TransactionState{capture})
+		if err != nil {
+			return err
+		}
+
+		journalEntryID, err := fh.poster.Post(
+			ctx,
+			model.JournalEntryState{Description: "Hold capture"},
+			[]model.PostingState{
+				{
+					LedgerAccount: model.LedgerAccountBankAccount,
+					BankAccountID: account.ID,
+					AmountCents:   -amountCents,
+					Currency:      account.Currency,
+				},
+				{
+					LedgerAccount: model.LedgerAccountOutgoingTransfers,
+					TransactionID: ids[0],
+					AmountCents:   amountCents,
+					Currency:      account.Currency,
+				},
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		fh.logger.Debug(ctx, "journal entry posted", "journal_entry_id", journalEntryID)
+
+		err = fh.balanceUpdater.BalanceAdd(ctx, account.ID, -amountCents)
+		if err != nil {
+			return err
+		}
+
+		account.BalanceCents -= amountCents
+
+		hold.CapturedCents = amountCents
+		hold.TransactionID = ids[0]
+
+		output, err = fh.close(ctx, account, *hold, model.HoldCaptured, now)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// Release releases the held amount without debiting the bank account.
+func (fh *fundHold) Release(ctx context.Context, input ReleaseHoldInput) (*HoldOutput, error) {
+	ctx = ctxd.AddFields(ctx,
+		"iban", input.Iban,
+		"hold_id", input.HoldID,
+	)
+
+	if input.Iban == "" {
+		var verr ValidationError
+
+		verr.add("iban", errors.New("must not be empty"))
+
+		return nil, ctxd.WrapError(ctx, &verr, "failed to validate input")
+	}
+
+	account, err := fh.accountFinder.FindByIban(ctx, input.Iban)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to find account")
+	}
+
+	var output *HoldOutput
+
+	err = fh.storage.InTx(ctx, func(ctx context.Context) error {
+		account, err := fh.locker.Lock(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		hold, err := fh.finder.Find(ctx, account.ID, input.HoldID)
+		if err != nil {
+			return err
+		}
+
+		if hold.Status != model.HoldActive {
+			return ctxd.WrapError(ctx, model.ErrHoldNotActive, "failed to release hold", "status", hold.Status)
+		}
+
+		output, err = fh.close(ctx, account, *hold, model.HoldReleased, fh.clock.Now())
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ExpireNext releases the active hold which expiry is the earliest, reached now.
+//
+// The bank account is locked before the hold, as when the hold is captured or released, not to deadlock with them.
+func (fh *fundHold) ExpireNext(ctx context.Context) (bool, error) {
+	var processed bool
+
+	err := fh.storage.InTx(ctx, func(ctx context.Context) error {
+		now := fh.clock.Now()
+
+		expired, err := fh.expiredFinder.FindExpired(ctx, now)
+		if err != nil || expired == nil {
+			return err
+		}
+
+		processed = true
+
+		ctx = ctxd.AddFields(ctx, "hold_id", expired.ID)
+
+		account, err := fh.locker.Lock(ctx, expired.BankAccountID)
+		if err != nil {
+			return err
+		}
+
+		hold, err := fh.finder.Find(ctx, account.ID, expired.ID)
+		if err != nil {
+			return err
+		}
+
+		// the hold was captured or released concurrently.
+		if hold.Status != model.HoldActive {
+			return nil
+		}
+
+		_, err = fh.close(ctx, account, *hold, model.HoldExpired, now)
+
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return processed, nil
+}
+
+// close releases the held amount of the bank account, locked by the caller, and stores the hold in the status.
+func (fh *fundHold) close(
+	ctx context.Context,
+	account *model.BankAccount,
+	hold model.Hold,
+	status model.HoldStatus,
+	now time.Time,
+) (*HoldOutput, error) {
+	account.HeldCents -= hold.AmountCents
+
+	err := fh.heldUpdater.HeldAdd(ctx, account.ID, -hold.AmountCents)
+	if err != nil {
+		return nil, err
+	}
+
+	hold.Status = status
+	hold.UpdatedAt = now
+
+	err = fh.updater.Update(ctx, hold)
+	if err != nil {
+		return nil, err
+	}
+
+	fh.logger.Debug(ctx, "hold closed", "status", status, "captured_cents", hold.CapturedCents)
+
+	return holdOutput(hold, account), nil
+}
+
+// holdOutput returns the output of the hold and the balances of its bank account.
+func holdOutput(hold model.Hold, account *model.BankAccount) *HoldOutput {
+	return &HoldOutput{
+		Hold:                  hold,
+		BalanceCents:          account.BalanceCents,
+		AvailableBalanceCents: account.AvailableBalanceCents(),
+		Currency:              account.Currency,
+	}
+}
+
+// validatePlaceHoldInput validates the input placing a hold at the time and returns the expiry of the hold.
+//
+// Returns a *ValidationError with all the field violations when the input is not valid.
+func validatePlaceHoldInput(input PlaceHoldInput, now time.Time) (time.Time, error) {
+	var verr ValidationError
+
+	if input.Iban == "" {
+		verr.add("iban", errors.New("must not be empty"))
+	}
+
+	if input.AmountCents <= 0 {
+		verr.add("amount_cents", errors.New("must be positive"))
+	}
+
+	if input.CounterpartyName == "" {
+		verr.add("counterparty_name", errors.New("must not be empty"))
+	} else if utf8.RuneCountInString(input.CounterpartyName) > MaxCounterpartyNameLength {
+		verr.add("counterparty_name", fmt.Errorf("must not have more than %d characters", MaxCounterpartyNameLength))
+	}
+
+	if utf8.RuneCountInString(input.Description) > MaxDescriptionLength {
+		verr.add("description", fmt.Errorf("must not have more than %d characters", MaxDescriptionLength))
+	}
+
+	expiresAt := now.Add(DefaultHoldExpiry)
+
+	if input.ExpiresAt != "" {
+		var err error
+
+		expiresAt, err = time.Parse(time.RFC3339, input.ExpiresAt)
+		if err != nil {
+			verr.add("expires_at", errors.New("must be a RFC 3339 time"))
+		} else if !expiresAt.After(now) {
+			verr.add("expires_at", errors.New("must be in the future"))
+		}
+	}
+
+	if len(verr.Violations) > 0 {
+		return time.Time{}, &verr
+	}
+
+	return expiresAt, nil
+}
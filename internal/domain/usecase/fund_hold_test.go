@@ -0,0 +1,423 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// holdStorageMock mocks the storage of the holds of the account, hold is the stored hold, nil when there is none.
+type holdStorageMock struct {
+	account *model.BankAccount
+	hold    *model.Hold
+
+	added             *model.HoldState
+	updated           *model.Hold
+	heldAddedCents    *model.Cents
+	transactions      []model.TransactionState
+	postings          []model.PostingState
+	balanceAddedCents *model.Cents
+}
+
+func (hsm *holdStorageMock) FindByIban(_ context.Context, _ string) (*model.BankAccount, error) {
+	if hsm.account == nil {
+		return nil, storage.ErrNotFound
+	}
+
+	return hsm.account, nil
+}
+
+func (hsm *holdStorageMock) Lock(ctx context.Context, _ model.BankAccountID) (*model.BankAccount, error) {
+	account := *hsm.account
+
+	return &account, nil
+}
+
+func (hsm *holdStorageMock) Add(_ context.Context, holdState model.HoldState) (*model.Hold, error) {
+	hsm.added = &holdState
+
+	return &model.Hold{ID: 5, HoldState: holdState}, nil
+}
+
+func (hsm *holdStorageMock) Find(_ context.Context, _ model.BankAccountID, id model.HoldID) (*model.Hold, error) {
+	if hsm.hold == nil || hsm.hold.ID != id {
+		return nil, storage.ErrNotFound
+	}
+
+	hold := *hsm.hold
+
+	return &hold, nil
+}
+
+func (hsm *holdStorageMock) FindExpired(_ context.Context, now time.Time) (*model.Hold, error) {
+	if hsm.hold == nil || hsm.hold.ExpiresAt.After(now) {
+		return nil, nil
+	}
+
+	hold := *hsm.hold
+
+	return &hold, nil
+}
+
+func (hsm *holdStorageMock) Update(_ context.Context, hold model.Hold) error {
+	hsm.updated = &hold
+
+	return nil
+}
+
+func (hsm *holdStorageMock) HeldAdd(_ context.Context, _ model.BankAccountID, amount model.Cents) error {
+	hsm.heldAddedCents = &amount
+
+	return nil
+}
+
+func (hsm *holdStorageMock) BalanceAdd(_ context.Context, _ model.BankAccountID, amount model.Cents) error {
+	hsm.balanceAddedCents = &amount
+
+	return nil
+}
+
+func (hsm *holdStorageMock) Post(_ context.Context, _ model.JournalEntryState, postingStates []model.PostingState) (model.JournalEntryID, error) {
+	hsm.postings = postingStates
+
+	return 1, nil
+}
+
+// holdTransactionAdderMock records the transactions of the hold captures, Add is taken by the hold adder.
+type holdTransactionAdderMock struct {
+	*holdStorageMock
+}
+
+func (htam holdTransactionAdderMock) Add(_ context.Context, transactionStates []model.TransactionState) ([]model.TransactionID, error) {
+	htam.transactions = transactionStates
+
+	return []model.TransactionID{10}, nil
+}
+
+// testHoldAccount returns the test account holding the amount of the test hold.
+func testHoldAccount() *model.BankAccount {
+	account := testAccount()
+	account.HeldCents = 20000
+
+	return &account
+}
+
+func testHold(now time.Time) *model.Hold {
+	return &model.Hold{
+		ID: 5,
+		HoldState: model.HoldState{
+			BankAccountID:    1,
+			AmountCents:      20000,
+			Currency:         "EUR",
+			CounterpartyName: "Hotel California",
+			Description:      "Room 42",
+			Status:           model.HoldActive,
+			ExpiresAt:        now.Add(time.Hour),
+			CreatedAt:        now.Add(-time.Hour),
+			UpdatedAt:        now.Add(-time.Hour),
+		},
+	}
+}
+
+// newTestFundHold returns the use case backed by the storage mock, the sql transaction expected to end with err.
+func newTestFundHold(t *testing.T, hsm *holdStorageMock, now time.Time, err error) (usecase.FundHold, sqlmock.Sqlmock) {
+	t.Helper()
+
+	st, mock := newTestStorage(t, err == nil)
+
+	return usecase.NewFundHold(
+		ctxd.NoOpLogger{}, st, clock.Fix(now), hsm, hsm, hsm, hsm, hsm, hsm, hsm,
+		holdTransactionAdderMock{hsm}, hsm, hsm,
+	), mock
+}
+
+func Test_fundHold_Place(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 13, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     usecase.PlaceHoldInput
+		heldAdded model.Cents
+		want      *model.HoldState
+		err       error
+	}{
+		{
+			name: "hold placed with the default expiry",
+			input: usecase.PlaceHoldInput{
+				Iban:             "FR10474608000002006107XXXXX",
+				AmountCents:      80000,
+				CounterpartyName: "Hotel California",
+				Description:      "Room 42",
+			},
+			heldAdded: 80000,
+			want: &model.HoldState{
+				BankAccountID:    1,
+				AmountCents:      80000,
+				Currency:         "EUR",
+				CounterpartyName: "Hotel California",
+				Description:      "Room 42",
+				Status:           model.HoldActive,
+				ExpiresAt:        now.Add(usecase.DefaultHoldExpiry),
+				CreatedAt:        now,
+				UpdatedAt:        now,
+			},
+		},
+		{
+			name: "not enough available balance",
+			input: usecase.PlaceHoldInput{
+				Iban:             "FR10474608000002006107XXXXX",
+				AmountCents:      80001,
+				CounterpartyName: "Hotel California",
+			},
+			err: model.ErrNotEnoughAvailableBalance,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			hsm := &holdStorageMock{account: testHoldAccount()}
+			fh, mock := newTestFundHold(t, hsm, now, tc.err)
+
+			got, err := fh.Place(context.Background(), tc.input)
+
+			assert.ErrorIsf(t, err, tc.err, "Place() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.want, hsm.added)
+
+			if tc.want != nil {
+				assert.Equal(t, model.Hold{ID: 5, HoldState: *tc.want}, got.Hold)
+				assert.Equal(t, tc.heldAdded, *hsm.heldAddedCents)
+				assert.Equal(t, model.Cents(0), got.AvailableBalanceCents)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Place() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func Test_fundHold_Place_invalidInput(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 13, 9, 0, 0, 0, time.UTC)
+
+	fh := usecase.NewFundHold(ctxd.NoOpLogger{}, nil, clock.Fix(now), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	got, err := fh.Place(context.Background(), usecase.PlaceHoldInput{
+		Iban:      "FR10474608000002006107XXXXX",
+		ExpiresAt: "2021-12-13T09:00:00Z",
+	})
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, usecase.ErrInvalidInput)
+
+	var verr *usecase.ValidationError
+
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, []usecase.FieldViolation{
+		{Field: "amount_cents", Description: "must be positive"},
+		{Field: "counterparty_name", Description: "must not be empty"},
+		{Field: "expires_at", Description: "must be in the future"},
+	}, verr.Violations)
+}
+
+func Test_fundHold_Capture(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 13, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		amountCents   model.Cents
+		hold          func(hold *model.Hold)
+		capturedCents model.Cents
+		err           error
+	}{
+		{
+			name:          "whole hold captured",
+			capturedCents: 20000,
+		},
+		{
+			name:          "part of the hold captured, the rest released",
+			amountCents:   15000,
+			capturedCents: 15000,
+		},
+		{
+			name:        "capture exceeds the hold",
+			amountCents: 20001,
+			err:         model.ErrCaptureExceedsHold,
+		},
+		{
+			name: "hold expired",
+			hold: func(hold *model.Hold) {
+				hold.ExpiresAt = now
+			},
+			err: model.ErrHoldNotActive,
+		},
+		{
+			name: "hold already released",
+			hold: func(hold *model.Hold) {
+				hold.Status = model.HoldReleased
+			},
+			err: model.ErrHoldNotActive,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			hold := testHold(now)
+			if tc.hold != nil {
+				tc.hold(hold)
+			}
+
+			hsm := &holdStorageMock{account: testHoldAccount(), hold: hold}
+			fh, mock := newTestFundHold(t, hsm, now, tc.err)
+
+			got, err := fh.Capture(context.Background(), usecase.CaptureHoldInput{
+				Iban:        "FR10474608000002006107XXXXX",
+				HoldID:      5,
+				AmountCents: tc.amountCents,
+			})
+
+			assert.ErrorIsf(t, err, tc.err, "Capture() err got = %v, want %v", err, tc.err)
+
+			if tc.err != nil {
+				assert.Nil(t, hsm.transactions)
+				assert.Nil(t, hsm.updated)
+			} else {
+				assert.Equal(t, []model.TransactionState{{
+					CounterpartyName: "Hotel California",
+					AmountCents:      tc.capturedCents,
+					AmountCurrency:   "EUR",
+					BankAccountID:    1,
+					Description:      "Room 42",
+					DebitedCents:     tc.capturedCents,
+					DebitedCurrency:  "EUR",
+					ExchangeRate:     model.NoExchangeRate,
+					CreatedAt:        now,
+				}}, hsm.transactions)
+				assert.Equal(t, []model.PostingState{
+					{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 1, AmountCents: -tc.capturedCents, Currency: "EUR"},
+					{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 10, AmountCents: tc.capturedCents, Currency: "EUR"},
+				}, hsm.postings)
+				assert.Equal(t, -tc.capturedCents, *hsm.balanceAddedCents)
+				assert.Equal(t, model.Cents(-20000), *hsm.heldAddedCents)
+				assert.Equal(t, model.HoldCaptured, hsm.updated.Status)
+				assert.Equal(t, tc.capturedCents, hsm.updated.CapturedCents)
+				assert.Equal(t, model.TransactionID(10), hsm.updated.TransactionID)
+				assert.Equal(t, 100000-tc.capturedCents, got.AvailableBalanceCents)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Capture() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func Test_fundHold_Release(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 13, 9, 0, 0, 0, time.UTC)
+
+	hsm := &holdStorageMock{account: testHoldAccount(), hold: testHold(now)}
+	fh, mock := newTestFundHold(t, hsm, now, nil)
+
+	got, err := fh.Release(context.Background(), usecase.ReleaseHoldInput{
+		Iban:   "FR10474608000002006107XXXXX",
+		HoldID: 5,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, model.HoldReleased, got.Hold.Status)
+	assert.Equal(t, now, got.Hold.UpdatedAt)
+	assert.Equal(t, model.Cents(100000), got.AvailableBalanceCents)
+	assert.Equal(t, model.Cents(-20000), *hsm.heldAddedCents)
+	assert.Nil(t, hsm.balanceAddedCents)
+	assert.Nil(t, hsm.transactions)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func Test_fundHold_ExpireNext(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 13, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		hold      func(hold *model.Hold)
+		processed bool
+		status    model.HoldStatus
+	}{
+		{
+			name: "hold not expired yet",
+		},
+		{
+			name: "expired hold released",
+			hold: func(hold *model.Hold) {
+				hold.ExpiresAt = now
+			},
+			processed: true,
+			status:    model.HoldExpired,
+		},
+		{
+			name: "expired hold captured concurrently",
+			hold: func(hold *model.Hold) {
+				hold.ExpiresAt = now
+				hold.Status = model.HoldCaptured
+			},
+			processed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			hold := testHold(now)
+			if tc.hold != nil {
+				tc.hold(hold)
+			}
+
+			hsm := &holdStorageMock{account: testHoldAccount(), hold: hold}
+			fh, mock := newTestFundHold(t, hsm, now, nil)
+
+			processed, err := fh.ExpireNext(context.Background())
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.processed, processed)
+
+			if tc.status != "" {
+				assert.Equal(t, tc.status, hsm.updated.Status)
+				assert.Equal(t, model.Cents(-20000), *hsm.heldAddedCents)
+			} else {
+				assert.Nil(t, hsm.updated)
+				assert.Nil(t, hsm.heldAddedCents)
+			}
+
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/nhatthm/go-clock"
+)
+
+// MaxExternalReferenceLength is the maximum number of characters of an external reference, as the SEPA end to end id.
+const MaxExternalReferenceLength = 35
+
+// InboundCredit defines the functionality of the use case InboundCredit used to book the credit transfers received
+// from counterparties.
+type InboundCredit interface {
+	// Credit books the credit transfer received by the bank account.
+	//
+	// The credit transfer is booked once per external reference, crediting again the same reference returns the
+	// transaction already booked.
+	Credit(ctx context.Context, input InboundCreditInput) (*InboundCreditOutput, error)
+}
+
+// InboundCreditInput is the input of the use case InboundCredit.
+type InboundCreditInput struct {
+	// Iban identifies the credited bank account.
+	Iban string
+	// Amount is the decimal amount of the credit transfer in its currency, e.g. "14.50".
+	Amount   string
+	Currency string
+	// CounterpartyName, CounterpartyIban and CounterpartyBic identify the debtor of the credit transfer.
+	CounterpartyName string
+	CounterpartyIban string
+	CounterpartyBic  string
+	Description      string
+	// ExternalReference identifies the credit transfer at the sender bank, e.g. the SEPA end to end id.
+	ExternalReference string
+}
+
+// InboundCreditOutput is the outcome of the use case InboundCredit.
+type InboundCreditOutput struct {
+	Transaction  model.Transaction
+	BalanceCents model.Cents
+	// Currency is the bank account currency of the balance amount.
+	Currency string
+}
+
+// TransactionReferenceFinder is a storage interface that defines the functionality to find the inbound credit.
+type TransactionReferenceFinder interface {
+	// FindByExternalReference finds the inbound credit of the bank account from a storage.
+	//
+	// Returns nil when the bank account has no inbound credit of the reference.
+	FindByExternalReference(ctx context.Context, bankAccountID model.BankAccountID, reference string) (*model.Transaction, error)
+}
+
+type inboundCredit struct {
+	logger          ctxd.Logger
+	storage         *sqluct.Storage
+	clock           clock.Clock
+	accountFinder   BankAccountIbanFinder
+	locker          AccountLocker
+	referenceFinder TransactionReferenceFinder
+	adder           TransactionAdder
+	poster          JournalPoster
+	updater         BalanceUpdater
+	rates           RateProvider
+}
+
+var _ InboundCredit = new(inboundCredit)
+
+// NewInboundCredit creates an instance of InboundCredit use case.
+func NewInboundCredit(
+	logger ctxd.Logger,
+	storage *sqluct.Storage,
+	clock clock.Clock,
+	accountFinder BankAccountIbanFinder,
+	locker AccountLocker,
+	referenceFinder TransactionReferenceFinder,
+	adder TransactionAdder,
+	poster JournalPoster,
+	updater BalanceUpdater,
+	rates RateProvider,
+) InboundCredit {
+	return &inboundCredit{
+		logger:          logger,
+		storage:         storage,
+		clock:           clock,
+		accountFinder:   accountFinder,
+		locker:          locker,
+		referenceFinder: referenceFinder,
+		adder:           adder,
+		poster:          poster,
+		updater:         updater,
+		rates:           rates,
+	}
+}
+
+// Credit books the credit transfer received by the bank account.
+//
+// The amount is converted into the bank account currency. The bank account is credited, and the credit posted into
+// the ledger, in the transaction the bank account is locked.
+func (ic *inboundCredit) Credit(ctx context.Context, input InboundCreditInput) (*InboundCreditOutput, error) {
+	ctx = ctxd.AddFields(ctx,
+		"iban", input.Iban,
+		"external_reference", input.ExternalReference,
+	)
+
+	amountCents, err := validateInboundCredit(input)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to validate input")
+	}
+
+	// the account is locked by id within the transaction, not to hold a share lock first.
+	account, err := ic.accountFinder.FindByIban(ctx, input.Iban)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to find account")
+	}
+
+	var output InboundCreditOutput
+
+	err = ic.storage.InTx(ctx, func(ctx context.Context) error {
+		account, err := ic.locker.Lock(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		stored, err := ic.referenceFinder.FindByExternalReference(ctx, account.ID, input.ExternalReference)
+		if err != nil {
+			return err
+		}
+
+		if stored != nil {
+			if stored.AmountCents != -amountCents ||
+				stored.AmountCurrency != input.Currency ||
+				stored.CounterpartyIban != input.CounterpartyIban {
+				return ctxd.WrapError(ctx, ErrIdempotencyKeyMismatch, "failed to credit account",
+					"transaction_id", stored.ID,
+				)
+			}
+
+			ic.logger.Debug(ctx, "inbound credit already booked", "transaction_id", stored.ID)
+
+			output = InboundCreditOutput{
+				Transaction:  *stored,
+				BalanceCents: account.BalanceCents,
+				Currency:     account.Currency,
+			}
+
+			return nil
+		}
+
+		creditedCents, rate, err := ic.creditedAmount(ctx, account.Currency, input.Currency, amountCents)
+		if err != nil {
+			return err
+		}
+
+		transactionState := model.TransactionState{
+			CounterpartyName:  input.CounterpartyName,
+			CounterpartyIban:  input.CounterpartyIban,
+			CounterpartyBic:   input.CounterpartyBic,
+			AmountCents:       -amountCents,
+			AmountCurrency:    input.Currency,
+			BankAccountID:     account.ID,
+			Description:       input.Description,
+			DebitedCents:      -creditedCents,
+			DebitedCurrency:   account.Currency,
+			ExchangeRate:      rate,
+			ExternalReference: input.ExternalReference,
+			CreatedAt:         ic.clock.Now(),
+		}
+
+		ids, err := ic.adder.Add(ctx, []model.TransactionState{transactionState})
+		if err != nil {
+			return err
+		}
+
+		journalEntryID, err := ic.poster.Post(
+			ctx,
+			model.JournalEntryState{Description: "Inbound credit"},
+			[]model.PostingState{
+				{
+					LedgerAccount: model.LedgerAccountBankAccount,
+					BankAccountID: account.ID,
+					AmountCents:   creditedCents,
+					Currency:      account.Currency,
+				},
+				{
+					LedgerAccount: model.LedgerAccountIncomingTransfers,
+					TransactionID: ids[0],
+					AmountCents:   -creditedCents,
+					Currency:      account.Currency,
+				},
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		ic.logger.Debug(ctx, "journal entry posted", "journal_entry_id", journalEntryID)
+
+		err = ic.updater.BalanceAdd(ctx, account.ID, creditedCents)
+		if err != nil {
+			return err
+		}
+
+		balanceCents := account.BalanceCents + creditedCents
+
+		output = InboundCreditOutput{
+			Transaction: model.Transaction{
+				ID:               ids[0],
+				TransactionState: transactionState,
+			},
+			BalanceCents: balanceCents,
+			Currency:     account.Currency,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// creditedAmount converts the amount into the bank account currency.
+//
+// Returns the credited amount and the exchange rate used. A currency without exchange rate into the bank account
+// currency is reported as a field violation.
+func (ic *inboundCredit) creditedAmount(
+	ctx context.Context,
+	accountCurrency, currency string,
+	amountCents model.Cents,
+) (model.Cents, string, error) {
+	if currency == accountCurrency {
+		return amountCents, model.NoExchangeRate, nil
+	}
+
+	rate, err := ic.rates.Rate(ctx, currency, accountCurrency)
+	if err != nil {
+		if errors.Is(err, ErrRateNotFound) {
+			var verr ValidationError
+
+			verr.add("currency", err)
+
+			return 0, "", &verr
+		}
+
+		return 0, "", ctxd.WrapError(ctx, err, "failed to get exchange rate",
+			"from", currency,
+			"to", accountCurrency,
+		)
+	}
+
+	creditedCents, err := model.ConvertCents(amountCents, currency, accountCurrency, rate)
+	if err != nil {
+		return 0, "", ctxd.WrapError(ctx, err, "failed to convert credit amount")
+	}
+
+	return creditedCents, rate, nil
+}
+
+// validateInboundCredit validates the input and returns the amount of the credit transfer in cents, in its currency.
+//
+// Returns a *ValidationError with all the field violations when the input is not valid.
+func validateInboundCredit(input InboundCreditInput) (model.Cents, error) {
+	var verr ValidationError
+
+	if input.Iban == "" {
+		verr.add("iban", errors.New("must not be empty"))
+	}
+
+	amountCents := validateTransfer(&verr, "", TransactionBulkTransferInput{
+		Amount:           input.Amount,
+		Currency:         input.Currency,
+		CounterpartyName: input.CounterpartyName,
+		CounterpartyBic:  input.CounterpartyBic,
+		CounterpartyIban: input.CounterpartyIban,
+		Description:      input.Description,
+	})
+
+	if input.CounterpartyIban != "" && input.CounterpartyIban == input.Iban {
+		verr.add("counterparty_iban", errors.New("must not be the credited account iban"))
+	}
+
+	if input.ExternalReference == "" {
+		verr.add("external_reference", errors.New("must not be empty"))
+	} else if utf8.RuneCountInString(input.ExternalReference) > MaxExternalReferenceLength {
+		verr.add("external_reference", fmt.Errorf("must not have more than %d characters", MaxExternalReferenceLength))
+	}
+
+	if len(verr.Violations) > 0 {
+		return 0, &verr
+	}
+
+	return amountCents, nil
+}
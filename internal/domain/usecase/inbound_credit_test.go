@@ -0,0 +1,211 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/jmoiron/sqlx"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inboundCreditStorageMock mocks the storage of the inbound credits, stored is the inbound credit of the external
+// reference, nil when not booked yet.
+type inboundCreditStorageMock struct {
+	reversalStorageMock
+
+	stored *model.Transaction
+}
+
+func (icsm *inboundCreditStorageMock) FindByExternalReference(_ context.Context, _ model.BankAccountID, _ string) (*model.Transaction, error) {
+	return icsm.stored, nil
+}
+
+func validInboundCreditInput() usecase.InboundCreditInput {
+	return usecase.InboundCreditInput{
+		Iban:              "FR10474608000002006107XXXXX",
+		Amount:            "150",
+		Currency:          "EUR",
+		CounterpartyName:  "Road Runner",
+		CounterpartyIban:  "FR1420041010050500013M02606",
+		CounterpartyBic:   "CRLYFRPPTOU",
+		Description:       "Invoice 42",
+		ExternalReference: "E2E-2021-12-11-001",
+	}
+}
+
+func Test_inboundCredit_Credit(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 11, 9, 0, 0, 0, time.UTC)
+
+	credit := model.TransactionState{
+		CounterpartyName:  "Road Runner",
+		CounterpartyIban:  "FR1420041010050500013M02606",
+		CounterpartyBic:   "CRLYFRPPTOU",
+		AmountCents:       -15000,
+		AmountCurrency:    "EUR",
+		BankAccountID:     1,
+		Description:       "Invoice 42",
+		DebitedCents:      -15000,
+		DebitedCurrency:   "EUR",
+		ExchangeRate:      model.NoExchangeRate,
+		ExternalReference: "E2E-2021-12-11-001",
+		CreatedAt:         now,
+	}
+
+	tests := []struct {
+		name     string
+		input    func() usecase.InboundCreditInput
+		stored   *model.Transaction
+		added    []model.TransactionState
+		postings []model.PostingState
+		want     *usecase.InboundCreditOutput
+		err      error
+	}{
+		{
+			name:  "credit booked",
+			input: validInboundCreditInput,
+			added: []model.TransactionState{credit},
+			postings: []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 1, AmountCents: 15000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountIncomingTransfers, TransactionID: 10, AmountCents: -15000, Currency: "EUR"},
+			},
+			want: &usecase.InboundCreditOutput{
+				Transaction:  model.Transaction{ID: 10, TransactionState: credit},
+				BalanceCents: 115000,
+				Currency:     "EUR",
+			},
+		},
+		{
+			name: "credit in another currency booked",
+			input: func() usecase.InboundCreditInput {
+				input := validInboundCreditInput()
+				input.Currency = "USD"
+
+				return input
+			},
+			added: []model.TransactionState{func() model.TransactionState {
+				c := credit
+				c.AmountCurrency = "USD"
+				c.DebitedCents = -13275
+				c.ExchangeRate = "0.885"
+
+				return c
+			}()},
+			postings: []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 1, AmountCents: 13275, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountIncomingTransfers, TransactionID: 10, AmountCents: -13275, Currency: "EUR"},
+			},
+		},
+		{
+			name:   "credit already booked",
+			input:  validInboundCreditInput,
+			stored: &model.Transaction{ID: 4, TransactionState: credit},
+			want: &usecase.InboundCreditOutput{
+				Transaction:  model.Transaction{ID: 4, TransactionState: credit},
+				BalanceCents: 100000,
+				Currency:     "EUR",
+			},
+		},
+		{
+			name: "external reference already used with a different credit",
+			input: func() usecase.InboundCreditInput {
+				input := validInboundCreditInput()
+				input.Amount = "151"
+
+				return input
+			},
+			stored: &model.Transaction{ID: 4, TransactionState: credit},
+			err:    usecase.ErrIdempotencyKeyMismatch,
+		},
+		{
+			name: "currency without exchange rate",
+			input: func() usecase.InboundCreditInput {
+				input := validInboundCreditInput()
+				input.Currency = "JPY"
+
+				return input
+			},
+			err: usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			mock.ExpectBegin()
+
+			if tc.err != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+			account := testAccount()
+
+			ics := &inboundCreditStorageMock{
+				reversalStorageMock: reversalStorageMock{account: &account},
+				stored:              tc.stored,
+			}
+
+			ic := usecase.NewInboundCredit(
+				ctxd.NoOpLogger{}, st, clock.Fix(now), ics, ics, ics, ics, ics, ics,
+				&rateProviderMock{rates: map[string]string{"USD/EUR": "0.885"}},
+			)
+
+			got, err := ic.Credit(context.Background(), tc.input())
+
+			assert.ErrorIsf(t, err, tc.err, "Credit() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.added, ics.added)
+			assert.Equal(t, tc.postings, ics.postings)
+
+			if tc.want != nil {
+				assert.Equal(t, tc.want, got)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Credit() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func Test_inboundCredit_Credit_invalidInput(t *testing.T) {
+	t.Parallel()
+
+	ic := usecase.NewInboundCredit(ctxd.NoOpLogger{}, nil, clock.Fix(time.Now()), nil, nil, nil, nil, nil, nil, nil)
+
+	input := validInboundCreditInput()
+	input.Amount = "-10"
+	input.Iban = input.CounterpartyIban
+	input.ExternalReference = ""
+
+	got, err := ic.Credit(context.Background(), input)
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, usecase.ErrInvalidInput)
+
+	var verr *usecase.ValidationError
+
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, []usecase.FieldViolation{
+		{Field: "amount", Description: "invalid amount: must be positive"},
+		{Field: "counterparty_iban", Description: "must not be the credited account iban"},
+		{Field: "external_reference", Description: "must not be empty"},
+	}, verr.Violations)
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+// LedgerCheck defines the functionality of the use case LedgerCheck used to check the ledger invariants.
+type LedgerCheck interface {
+	// LedgerCheck use case functionality to check the ledger invariants.
+	LedgerCheck(ctx context.Context) (*LedgerCheckOutput, error)
+}
+
+// LedgerCheckOutput contains the outcome of executing LedgerCheck use case.
+type LedgerCheckOutput struct {
+	// BalanceDiscrepancies are the bank accounts which balance differs from the sum of their postings.
+	BalanceDiscrepancies []model.BalanceDiscrepancy
+	// UnbalancedJournalEntries are the journal entries which postings do not balance.
+	UnbalancedJournalEntries []model.JournalEntryID
+}
+
+// Consistent returns whether the ledger invariants hold.
+func (o LedgerCheckOutput) Consistent() bool {
+	return len(o.BalanceDiscrepancies) == 0 && len(o.UnbalancedJournalEntries) == 0
+}
+
+// LedgerInvariantFinder is a storage interface that defines the functionality to find the ledger invariant violations.
+type LedgerInvariantFinder interface {
+	// BalanceDiscrepancies finds the bank accounts which balance differs from the sum of their postings from a storage.
+	BalanceDiscrepancies(ctx context.Context) ([]model.BalanceDiscrepancy, error)
+	// UnbalancedJournalEntries finds the journal entries which postings do not balance from a storage.
+	UnbalancedJournalEntries(ctx context.Context) ([]model.JournalEntryID, error)
+}
+
+type ledgerCheck struct {
+	logger ctxd.Logger
+	finder LedgerInvariantFinder
+}
+
+var _ LedgerCheck = new(ledgerCheck)
+
+// NewLedgerCheck creates an instance of LedgerCheck use case.
+func NewLedgerCheck(logger ctxd.Logger, finder LedgerInvariantFinder) LedgerCheck {
+	return &ledgerCheck{
+		logger: logger,
+		finder: finder,
+	}
+}
+
+// LedgerCheck use case functionality to check the ledger invariants.
+func (lc *ledgerCheck) LedgerCheck(ctx context.Context) (*LedgerCheckOutput, error) {
+	discrepancies, err := lc.finder.BalanceDiscrepancies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unbalanced, err := lc.finder.UnbalancedJournalEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := LedgerCheckOutput{
+		BalanceDiscrepancies:     discrepancies,
+		UnbalancedJournalEntries: unbalanced,
+	}
+
+	if !output.Consistent() {
+		lc.logger.Error(ctx, "ledger invariants violated",
+			"balance_discrepancies", discrepancies,
+			"unbalanced_journal_entries", unbalanced,
+		)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+type ledgerInvariantFinderMock struct {
+	discrepancies []model.BalanceDiscrepancy
+	unbalanced    []model.JournalEntryID
+	err           error
+}
+
+func (lifm *ledgerInvariantFinderMock) BalanceDiscrepancies(_ context.Context) ([]model.BalanceDiscrepancy, error) {
+	return lifm.discrepancies, lifm.err
+}
+
+func (lifm *ledgerInvariantFinderMock) UnbalancedJournalEntries(_ context.Context) ([]model.JournalEntryID, error) {
+	return lifm.unbalanced, lifm.err
+}
+
+func Test_ledgerCheck_LedgerCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		finder     *ledgerInvariantFinderMock
+		want       *usecase.LedgerCheckOutput
+		consistent bool
+		err        error
+	}{
+		{
+			name:       "ledger consistent",
+			finder:     &ledgerInvariantFinderMock{},
+			want:       &usecase.LedgerCheckOutput{},
+			consistent: true,
+		},
+		{
+			name: "ledger inconsistent",
+			finder: &ledgerInvariantFinderMock{
+				discrepancies: []model.BalanceDiscrepancy{{BankAccountID: 1, BalanceCents: 1000, PostingsCents: 900}},
+				unbalanced:    []model.JournalEntryID{2},
+			},
+			want: &usecase.LedgerCheckOutput{
+				BalanceDiscrepancies:     []model.BalanceDiscrepancy{{BankAccountID: 1, BalanceCents: 1000, PostingsCents: 900}},
+				UnbalancedJournalEntries: []model.JournalEntryID{2},
+			},
+			consistent: false,
+		},
+		{
+			name:   "storage error",
+			finder: &ledgerInvariantFinderMock{err: sql.ErrConnDone},
+			want:   nil,
+			err:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := usecase.NewLedgerCheck(ctxd.NoOpLogger{}, tc.finder).LedgerCheck(context.Background())
+
+			assert.Equal(t, tc.want, got, "LedgerCheck() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "LedgerCheck() err got = %v, want %v", err, tc.err)
+
+			if got != nil {
+				assert.Equal(t, tc.consistent, got.Consistent())
+			}
+		})
+	}
+}
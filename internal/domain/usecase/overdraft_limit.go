@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+// OverdraftLimit defines the functionality of the use case OverdraftLimit used by the administrators to manage the
+// overdraft facilities of the bank accounts.
+type OverdraftLimit interface {
+	// SetLimit sets the overdraft limit of the bank account, 0 removes the overdraft facility.
+	SetLimit(ctx context.Context, input OverdraftLimitInput) (*BankAccountOutput, error)
+}
+
+// OverdraftLimitInput is the input of the use case OverdraftLimit.
+type OverdraftLimitInput struct {
+	Iban string
+	// LimitCents is the agreed overdraft, in cents of the account currency.
+	LimitCents model.Cents
+}
+
+// OverdraftLimitUpdater is a storage interface that defines the functionality to update the overdraft limit.
+type OverdraftLimitUpdater interface {
+	// OverdraftLimitUpdate updates the overdraft limit of the account from a storage.
+	OverdraftLimitUpdate(ctx context.Context, accountID model.BankAccountID, limit model.Cents) error
+}
+
+type overdraftLimit struct {
+	logger        ctxd.Logger
+	storage       *sqluct.Storage
+	accountFinder BankAccountIbanFinder
+	locker        AccountLocker
+	updater       OverdraftLimitUpdater
+}
+
+var _ OverdraftLimit = new(overdraftLimit)
+
+// NewOverdraftLimit creates an instance of OverdraftLimit use case.
+func NewOverdraftLimit(
+	logger ctxd.Logger,
+	storage *sqluct.Storage,
+	accountFinder BankAccountIbanFinder,
+	locker AccountLocker,
+	updater OverdraftLimitUpdater,
+) OverdraftLimit {
+	return &overdraftLimit{
+		logger:        logger,
+		storage:       storage,
+		accountFinder: accountFinder,
+		locker:        locker,
+		updater:       updater,
+	}
+}
+
+// SetLimit sets the overdraft limit of the bank account, 0 removes the overdraft facility.
+//
+// The limit is set while the bank account is locked, not to change under a balance check. A limit lower than the
+// overdraft used is set, the bank account cannot spend until its balance is back within the limit.
+func (ol *overdraftLimit) SetLimit(ctx context.Context, input OverdraftLimitInput) (*BankAccountOutput, error) {
+	ctx = ctxd.AddFields(ctx,
+		"iban", input.Iban,
+		"overdraft_limit_cents", input.LimitCents,
+	)
+
+	var verr ValidationError
+
+	if input.Iban == "" {
+		verr.add("iban", errors.New("must not be empty"))
+	}
+
+	if input.LimitCents < 0 {
+		verr.add("limit_cents", errors.New("must not be negative"))
+	}
+
+	if len(verr.Violations) > 0 {
+		return nil, ctxd.WrapError(ctx, &verr, "failed to validate input")
+	}
+
+	// the account is locked by id within the transaction, not to hold a share lock first.
+	account, err := ol.accountFinder.FindByIban(ctx, input.Iban)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to find account")
+	}
+
+	var output *BankAccountOutput
+
+	err = ol.storage.InTx(ctx, func(ctx context.Context) error {
+		account, err := ol.locker.Lock(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		err = ol.updater.OverdraftLimitUpdate(ctx, account.ID, input.LimitCents)
+		if err != nil {
+			return err
+		}
+
+		ol.logger.Info(ctx, "overdraft limit set", "previous_overdraft_limit_cents", account.OverdraftLimitCents)
+
+		account.OverdraftLimitCents = input.LimitCents
+		output = bankAccountOutput(*account)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
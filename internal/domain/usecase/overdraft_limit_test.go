@@ -0,0 +1,137 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// overdraftLimitStorageMock mocks the storage of the overdraft limits, limitCents is the limit stored, nil when not
+// updated.
+type overdraftLimitStorageMock struct {
+	account *model.BankAccount
+
+	limitCents *model.Cents
+}
+
+func (olsm *overdraftLimitStorageMock) FindByIban(_ context.Context, _ string) (*model.BankAccount, error) {
+	if olsm.account == nil {
+		return nil, storage.ErrNotFound
+	}
+
+	return olsm.account, nil
+}
+
+func (olsm *overdraftLimitStorageMock) Lock(_ context.Context, _ model.BankAccountID) (*model.BankAccount, error) {
+	account := *olsm.account
+
+	return &account, nil
+}
+
+func (olsm *overdraftLimitStorageMock) OverdraftLimitUpdate(_ context.Context, _ model.BankAccountID, limit model.Cents) error {
+	olsm.limitCents = &limit
+
+	return nil
+}
+
+func Test_overdraftLimit_SetLimit(t *testing.T) {
+	t.Parallel()
+
+	account := model.BankAccount{
+		ID: 1,
+		BankAccountState: model.BankAccountState{
+			OrganizationName:    "ACME Corp",
+			BalanceCents:        -3000,
+			Iban:                "FR10474608000002006107XXXXX",
+			Bic:                 "OIVUSCLQXXX",
+			Currency:            "EUR",
+			OverdraftLimitCents: 5000,
+		},
+	}
+
+	tests := []struct {
+		name  string
+		input usecase.OverdraftLimitInput
+		want  *usecase.BankAccountOutput
+		err   error
+	}{
+		{
+			name:  "overdraft limit raised",
+			input: usecase.OverdraftLimitInput{Iban: "FR10474608000002006107XXXXX", LimitCents: 10000},
+			want: &usecase.BankAccountOutput{
+				BankAccount: func() model.BankAccount {
+					a := account
+					a.OverdraftLimitCents = 10000
+
+					return a
+				}(),
+				AvailableBalanceCents: -3000,
+				OverdraftUsedCents:    3000,
+			},
+		},
+		{
+			name:  "overdraft limit lowered below the overdraft used",
+			input: usecase.OverdraftLimitInput{Iban: "FR10474608000002006107XXXXX", LimitCents: 1000},
+			want: &usecase.BankAccountOutput{
+				BankAccount: func() model.BankAccount {
+					a := account
+					a.OverdraftLimitCents = 1000
+
+					return a
+				}(),
+				AvailableBalanceCents: -3000,
+				OverdraftUsedCents:    3000,
+			},
+		},
+		{
+			name:  "negative overdraft limit",
+			input: usecase.OverdraftLimitInput{Iban: "FR10474608000002006107XXXXX", LimitCents: -1},
+			err:   usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			if tc.want != nil {
+				mock.ExpectBegin()
+				mock.ExpectCommit()
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			olsm := &overdraftLimitStorageMock{account: &account}
+			ol := usecase.NewOverdraftLimit(ctxd.NoOpLogger{}, st, olsm, olsm, olsm)
+
+			got, err := ol.SetLimit(context.Background(), tc.input)
+
+			assert.ErrorIsf(t, err, tc.err, "SetLimit() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.want, got)
+
+			if tc.want != nil {
+				assert.Equal(t, tc.input.LimitCents, *olsm.limitCents)
+			} else {
+				assert.Nil(t, olsm.limitCents)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("SetLimit() expectations were not met = %v", err)
+			}
+		})
+	}
+}
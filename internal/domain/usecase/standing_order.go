@@ -0,0 +1,510 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/nhatthm/go-clock"
+)
+
+// StandingOrder defines the functionality of the use case StandingOrder used to execute a credit transfer repeatedly.
+type StandingOrder interface {
+	// Create validates the input and creates the standing order, executed from its start date on.
+	Create(ctx context.Context, input StandingOrderInput) (*model.StandingOrder, error)
+	// StandingOrder returns the standing order.
+	StandingOrder(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error)
+	// List lists the standing orders of the bank account, but the cancelled ones.
+	List(ctx context.Context, organizationIban string) ([]model.StandingOrder, error)
+	// Update validates the input and replaces the credit transfer and the end of the recurrence of the standing order.
+	Update(ctx context.Context, input StandingOrderUpdateInput) (*model.StandingOrder, error)
+	// Cancel cancels the standing order, it is no longer executed. Its executions are kept.
+	Cancel(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error)
+	// Pause pauses the standing order until resumed.
+	Pause(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error)
+	// Resume resumes the paused standing order, the execution dates missed while paused are not executed.
+	Resume(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error)
+	// Skip skips the next execution of the standing order, the skipped execution is kept in its executions.
+	Skip(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error)
+	// Executions lists the executions of the standing order by execution date, with the outcome of their credit
+	// transfer.
+	Executions(ctx context.Context, id model.StandingOrderID) ([]model.StandingOrderExecutionOutcome, error)
+	// ProcessNext executes the active standing order which next execution date is the earliest, not after today.
+	//
+	// Returns false when there is no standing order to execute.
+	ProcessNext(ctx context.Context) (bool, error)
+}
+
+// StandingOrderAdder is a storage interface that defines the functionality to add the standing order.
+type StandingOrderAdder interface {
+	// Add adds the standing order into a storage.
+	Add(ctx context.Context, orderState model.StandingOrderState) (*model.StandingOrder, error)
+}
+
+// StandingOrderFinder is a storage interface that defines the functionality to find the standing order.
+type StandingOrderFinder interface {
+	// Find finds the standing order from a storage, locked until the transaction ends.
+	Find(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error)
+}
+
+// StandingOrderLister is a storage interface that defines the functionality to list the standing orders.
+type StandingOrderLister interface {
+	// List lists the standing orders of the bank account from a storage, but the cancelled ones.
+	List(ctx context.Context, organizationIban string) ([]model.StandingOrder, error)
+}
+
+// StandingOrderUpdater is a storage interface that defines the functionality to update the standing order.
+type StandingOrderUpdater interface {
+	// Update stores the credit transfer, the end of the recurrence and the schedule of the standing order into a storage.
+	Update(ctx context.Context, order model.StandingOrder) error
+}
+
+// StandingOrderClaimer is a storage interface that defines the functionality to claim the standing order to execute.
+type StandingOrderClaimer interface {
+	// ClaimDue finds the active standing order which next execution date is the earliest, not after today, locked
+	// until the transaction ends.
+	//
+	// Returns nil when there is no standing order to execute.
+	ClaimDue(ctx context.Context, today time.Time) (*model.StandingOrder, error)
+}
+
+// StandingOrderExecutionAdder is a storage interface that defines the functionality to add the standing order execution.
+type StandingOrderExecutionAdder interface {
+	// AddExecution adds the execution of the standing order into a storage.
+	AddExecution(ctx context.Context, executionState model.StandingOrderExecutionState) error
+}
+
+// StandingOrderExecutionLister is a storage interface that defines the functionality to list the standing order
+// executions.
+type StandingOrderExecutionLister interface {
+	// ListExecutions lists the executions of the standing order from a storage, by execution date.
+	ListExecutions(ctx context.Context, id model.StandingOrderID) ([]model.StandingOrderExecutionOutcome, error)
+}
+
+// StandingOrderInput contains all the inputs required to create a standing order.
+type StandingOrderInput struct {
+	OrganizationName string
+	OrganizationIban string
+	OrganizationBic  string
+	Transfer         TransactionBulkTransferInput
+	Frequency        model.StandingOrderFrequency
+	// DayOfMonth is the day of the monthly executions, from 1 to 31. It must be 0 for the other frequencies.
+	DayOfMonth int
+	// StartDate is the first date, formatted as YYYY-MM-DD, the standing order can be executed on.
+	StartDate string
+	// EndDate is the last date, formatted as YYYY-MM-DD, the standing order can be executed on. No end date when empty.
+	EndDate string
+	// Count is the number of executions of the standing order, unlimited when 0.
+	Count int
+	// CreatedBy is the user creating the standing order, the executions are submitted on the user's behalf.
+	CreatedBy string
+}
+
+// StandingOrderUpdateInput contains all the inputs required to update a standing order.
+type StandingOrderUpdateInput struct {
+	ID       model.StandingOrderID
+	Transfer TransactionBulkTransferInput
+	// EndDate is the last date, formatted as YYYY-MM-DD, the standing order can be executed on. No end date when empty.
+	EndDate string
+	// Count is the number of executions of the standing order, unlimited when 0.
+	Count int
+}
+
+type standingOrder struct {
+	logger             ctxd.Logger
+	storage            *sqluct.Storage
+	clock              clock.Clock
+	transactionBulkJob TransactionBulkJob
+	adder              StandingOrderAdder
+	finder             StandingOrderFinder
+	lister             StandingOrderLister
+	updater            StandingOrderUpdater
+	claimer            StandingOrderClaimer
+	executionAdder     StandingOrderExecutionAdder
+	executionLister    StandingOrderExecutionLister
+}
+
+var _ StandingOrder = new(standingOrder)
+
+// NewStandingOrder creates an instance of StandingOrder use case.
+//
+// The standing orders are executed by enqueuing a transfer bulk job with their credit transfer, on their execution
+// dates, the date of the clock in UTC. The execution dates missed, e.g. because the service was stopped, are executed
+// one by one once the service is back.
+func NewStandingOrder(
+	logger ctxd.Logger,
+	storage *sqluct.Storage,
+	clock clock.Clock,
+	transactionBulkJob TransactionBulkJob,
+	adder StandingOrderAdder,
+	finder StandingOrderFinder,
+	lister StandingOrderLister,
+	updater StandingOrderUpdater,
+	claimer StandingOrderClaimer,
+	executionAdder StandingOrderExecutionAdder,
+	executionLister StandingOrderExecutionLister,
+) StandingOrder {
+	return &standingOrder{
+		logger:             logger,
+		storage:            storage,
+		clock:              clock,
+		transactionBulkJob: transactionBulkJob,
+		adder:              adder,
+		finder:             finder,
+		lister:             lister,
+		updater:            updater,
+		claimer:            claimer,
+		executionAdder:     executionAdder,
+		executionLister:    executionLister,
+	}
+}
+
+// Create validates the input and creates the standing order, executed from its start date on.
+func (so *standingOrder) Create(ctx context.Context, input StandingOrderInput) (*model.StandingOrder, error) {
+	ctx = ctxd.AddFields(
+		ctx,
+		"organization_name", input.OrganizationName,
+		"organization_bic", input.OrganizationBic,
+		"organization_iban", input.OrganizationIban,
+	)
+
+	now := so.clock.Now()
+
+	var verr ValidationError
+
+	transfer := validateStandingOrderTransfer(&verr, input.OrganizationName, input.OrganizationIban, input.OrganizationBic, input.Transfer)
+
+	switch input.Frequency {
+	case model.StandingOrderMonthly:
+		if input.DayOfMonth < 1 || input.DayOfMonth > 31 {
+			verr.add("day_of_month", errors.New("must be between 1 and 31"))
+		}
+	case model.StandingOrderDaily, model.StandingOrderWeekly:
+		if input.DayOfMonth != 0 {
+			verr.add("day_of_month", errors.New("must be empty unless the frequency is monthly"))
+		}
+	default:
+		verr.add("frequency", fmt.Errorf("must be one of %s, %s or %s",
+			model.StandingOrderDaily, model.StandingOrderWeekly, model.StandingOrderMonthly))
+	}
+
+	startDate, err := parseExecutionDate(input.StartDate, now)
+
+	switch {
+	case err != nil:
+		verr.add("start_date", err)
+	case startDate == nil:
+		verr.add("start_date", errors.New("must not be empty"))
+	}
+
+	endDate := validateStandingOrderEnd(&verr, input.EndDate, input.Count)
+
+	if startDate != nil && endDate != nil && endDate.Before(*startDate) {
+		verr.add("end_date", errors.New("must not be before the start date"))
+	}
+
+	if len(verr.Violations) > 0 {
+		return nil, ctxd.WrapError(ctx, &verr, "failed to validate input")
+	}
+
+	orderState := model.StandingOrderState{
+		OrganizationName:      input.OrganizationName,
+		OrganizationIban:      input.OrganizationIban,
+		OrganizationBic:       input.OrganizationBic,
+		StandingOrderTransfer: transfer,
+		StandingOrderRecurrence: model.StandingOrderRecurrence{
+			Frequency:  input.Frequency,
+			DayOfMonth: input.DayOfMonth,
+			StartDate:  *startDate,
+			EndDate:    endDate,
+			Count:      input.Count,
+		},
+		Status:    model.StandingOrderActive,
+		CreatedBy: input.CreatedBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	orderState.Schedule(*startDate)
+
+	if orderState.Status == model.StandingOrderCompleted {
+		verr.add("end_date", errors.New("must not be before the first execution date"))
+
+		return nil, ctxd.WrapError(ctx, &verr, "failed to validate input")
+	}
+
+	order, err := so.adder.Add(ctx, orderState)
+	if err != nil {
+		return nil, err
+	}
+
+	so.logger.Debug(ctx, "standing order created",
+		"standing_order_id", order.ID,
+		"next_execution_date", order.NextExecutionDate,
+	)
+
+	return order, nil
+}
+
+// StandingOrder returns the standing order.
+func (so *standingOrder) StandingOrder(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error) {
+	return so.finder.Find(ctx, id)
+}
+
+// List lists the standing orders of the bank account, but the cancelled ones.
+func (so *standingOrder) List(ctx context.Context, organizationIban string) ([]model.StandingOrder, error) {
+	return so.lister.List(ctx, organizationIban)
+}
+
+// Update validates the input and replaces the credit transfer and the end of the recurrence of the standing order.
+//
+// The standing order is completed when the new end of the recurrence is already reached.
+func (so *standingOrder) Update(ctx context.Context, input StandingOrderUpdateInput) (*model.StandingOrder, error) {
+	return so.transition(ctx, input.ID, "updated", func(ctx context.Context, order *model.StandingOrder) error {
+		var verr ValidationError
+
+		transfer := validateStandingOrderTransfer(&verr, order.OrganizationName, order.OrganizationIban, order.OrganizationBic, input.Transfer)
+		endDate := validateStandingOrderEnd(&verr, input.EndDate, input.Count)
+
+		if endDate != nil && endDate.Before(order.StartDate) {
+			verr.add("end_date", errors.New("must not be before the start date"))
+		}
+
+		if len(verr.Violations) > 0 {
+			return ctxd.WrapError(ctx, &verr, "failed to validate input")
+		}
+
+		return order.Update(transfer, endDate, input.Count)
+	})
+}
+
+// Cancel cancels the standing order, it is no longer executed. Its executions are kept.
+func (so *standingOrder) Cancel(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error) {
+	return so.transition(ctx, id, "cancelled", func(_ context.Context, order *model.StandingOrder) error {
+		return order.Cancel()
+	})
+}
+
+// Pause pauses the standing order until resumed.
+func (so *standingOrder) Pause(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error) {
+	return so.transition(ctx, id, "paused", func(_ context.Context, order *model.StandingOrder) error {
+		return order.Pause()
+	})
+}
+
+// Resume resumes the paused standing order, the execution dates missed while paused are not executed.
+func (so *standingOrder) Resume(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error) {
+	date := today(so.clock.Now())
+
+	return so.transition(ctx, id, "resumed", func(_ context.Context, order *model.StandingOrder) error {
+		return order.Resume(date)
+	})
+}
+
+// Skip skips the next execution of the standing order, the skipped execution is kept in its executions.
+func (so *standingOrder) Skip(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error) {
+	return so.transition(ctx, id, "skipped", func(ctx context.Context, order *model.StandingOrder) error {
+		skipped, err := order.Skip()
+		if err != nil {
+			return err
+		}
+
+		return so.executionAdder.AddExecution(ctx, model.StandingOrderExecutionState{
+			StandingOrderID: order.ID,
+			ExecutionDate:   skipped,
+			Status:          model.StandingOrderExecutionSkipped,
+			CreatedAt:       so.clock.Now(),
+		})
+	})
+}
+
+// Executions lists the executions of the standing order by execution date, with the outcome of their credit transfer.
+func (so *standingOrder) Executions(ctx context.Context, id model.StandingOrderID) ([]model.StandingOrderExecutionOutcome, error) {
+	if _, err := so.finder.Find(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return so.executionLister.ListExecutions(ctx, id)
+}
+
+// ProcessNext executes the active standing order which next execution date is the earliest, not after today.
+//
+// The credit transfer is enqueued as a transfer bulk job, along with the execution of the standing order and its next
+// execution date, in a single transaction. The job is enqueued with an idempotency key of the standing order and the
+// execution date, executing a date twice does not enqueue its credit transfer twice.
+func (so *standingOrder) ProcessNext(ctx context.Context) (bool, error) {
+	var processed bool
+
+	err := so.storage.InTx(ctx, func(ctx context.Context) error {
+		now := so.clock.Now()
+
+		order, err := so.claimer.ClaimDue(ctx, today(now))
+		if err != nil || order == nil {
+			return err
+		}
+
+		processed = true
+		executionDate := *order.NextExecutionDate
+
+		ctx = ctxd.AddFields(ctx,
+			"standing_order_id", order.ID,
+			"execution_date", executionDate.Format(executionDateLayout),
+		)
+
+		job, err := so.transactionBulkJob.Enqueue(ctx, TransactionBulkInput{
+			OrganizationName: order.OrganizationName,
+			OrganizationIban: order.OrganizationIban,
+			OrganizationBic:  order.OrganizationBic,
+			CreditTransfers: []TransactionBulkTransferInput{{
+				Amount:           model.FormatCents(order.AmountCents, order.Currency),
+				Currency:         order.Currency,
+				CounterpartyName: order.CounterpartyName,
+				CounterpartyBic:  order.CounterpartyBic,
+				CounterpartyIban: order.CounterpartyIban,
+				Description:      order.Description,
+			}},
+			IdempotencyKey: standingOrderIdempotencyKey(order.ID, executionDate),
+			SubmittedBy:    order.CreatedBy,
+		})
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "failed to enqueue standing order execution")
+		}
+
+		err = so.executionAdder.AddExecution(ctx, model.StandingOrderExecutionState{
+			StandingOrderID:   order.ID,
+			ExecutionDate:     executionDate,
+			Status:            model.StandingOrderExecutionEnqueued,
+			TransferBulkJobID: job.ID,
+			CreatedAt:         now,
+		})
+		if err != nil {
+			return err
+		}
+
+		order.Executed()
+		order.UpdatedAt = now
+
+		if err := so.updater.Update(ctx, *order); err != nil {
+			return err
+		}
+
+		so.logger.Debug(ctx, "standing order executed",
+			"transfer_bulk_job_id", job.ID,
+			"status", order.Status,
+			"next_execution_date", order.NextExecutionDate,
+		)
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return processed, nil
+}
+
+// transition applies the change to the standing order, locked until the change is stored.
+func (so *standingOrder) transition(
+	ctx context.Context,
+	id model.StandingOrderID,
+	action string,
+	change func(ctx context.Context, order *model.StandingOrder) error,
+) (*model.StandingOrder, error) {
+	ctx = ctxd.AddFields(ctx, "standing_order_id", id)
+
+	var order *model.StandingOrder
+
+	err := so.storage.InTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		order, err = so.finder.Find(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := change(ctx, order); err != nil {
+			return ctxd.WrapError(ctx, err, "failed to change standing order", "status", order.Status)
+		}
+
+		order.UpdatedAt = so.clock.Now()
+
+		return so.updater.Update(ctx, *order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	so.logger.Debug(ctx, "standing order "+action, "status", order.Status)
+
+	return order, nil
+}
+
+// validateStandingOrderTransfer validates the credit transfer of the standing order as a transfer bulk of a single
+// credit transfer, the violations are added to verr.
+func validateStandingOrderTransfer(
+	verr *ValidationError,
+	organizationName, organizationIban, organizationBic string,
+	input TransactionBulkTransferInput,
+) model.StandingOrderTransfer {
+	amountsCents, err := ValidateTransactionBulkInput(TransactionBulkInput{
+		OrganizationName: organizationName,
+		OrganizationIban: organizationIban,
+		OrganizationBic:  organizationBic,
+		CreditTransfers:  []TransactionBulkTransferInput{input},
+	})
+
+	var bulkErr *ValidationError
+	if errors.As(err, &bulkErr) {
+		for _, v := range bulkErr.Violations {
+			v.Field = strings.Replace(v.Field, "credit_transfers[0].", "transfer.", 1)
+			verr.Violations = append(verr.Violations, v)
+		}
+
+		return model.StandingOrderTransfer{}
+	}
+
+	return model.StandingOrderTransfer{
+		AmountCents:      amountsCents[0],
+		Currency:         input.Currency,
+		CounterpartyName: input.CounterpartyName,
+		CounterpartyIban: input.CounterpartyIban,
+		CounterpartyBic:  input.CounterpartyBic,
+		Description:      input.Description,
+	}
+}
+
+// validateStandingOrderEnd validates the end of the recurrence and returns the end date, nil when empty. The
+// violations are added to verr.
+func validateStandingOrderEnd(verr *ValidationError, date string, count int) *time.Time {
+	if count < 0 {
+		verr.add("count", errors.New("must not be negative"))
+	}
+
+	if date == "" {
+		return nil
+	}
+
+	endDate, err := time.Parse(executionDateLayout, date)
+	if err != nil {
+		verr.add("end_date", errors.New("must be a date formatted as YYYY-MM-DD"))
+
+		return nil
+	}
+
+	return &endDate
+}
+
+// standingOrderIdempotencyKey returns the idempotency key used to enqueue the execution of the standing order.
+func standingOrderIdempotencyKey(id model.StandingOrderID, executionDate time.Time) string {
+	return fmt.Sprintf("standing-order/%d/%s", id, executionDate.Format(executionDateLayout))
+}
+
+// today returns the UTC date of now, at midnight.
+func today(now time.Time) time.Time {
+	return now.UTC().Truncate(24 * time.Hour)
+}
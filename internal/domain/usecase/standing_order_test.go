@@ -0,0 +1,445 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// standingOrderStorageMock mocks the standing order storage, the updated standing order and the added executions are
+// kept to be asserted.
+type standingOrderStorageMock struct {
+	stored     *model.StandingOrder
+	updated    *model.StandingOrder
+	executions []model.StandingOrderExecutionState
+	err        error
+}
+
+func (sosm *standingOrderStorageMock) Add(_ context.Context, orderState model.StandingOrderState) (*model.StandingOrder, error) {
+	if sosm.err != nil {
+		return nil, sosm.err
+	}
+
+	return &model.StandingOrder{ID: 1, StandingOrderState: orderState}, nil
+}
+
+func (sosm *standingOrderStorageMock) Find(_ context.Context, _ model.StandingOrderID) (*model.StandingOrder, error) {
+	if sosm.stored == nil {
+		return nil, sosm.err
+	}
+
+	// the stored standing order is shared by the test cases.
+	order := *sosm.stored
+
+	return &order, nil
+}
+
+func (sosm *standingOrderStorageMock) List(_ context.Context, _ string) ([]model.StandingOrder, error) {
+	if sosm.stored == nil {
+		return nil, sosm.err
+	}
+
+	return []model.StandingOrder{*sosm.stored}, nil
+}
+
+func (sosm *standingOrderStorageMock) Update(_ context.Context, order model.StandingOrder) error {
+	sosm.updated = &order
+
+	return nil
+}
+
+func (sosm *standingOrderStorageMock) ClaimDue(ctx context.Context, _ time.Time) (*model.StandingOrder, error) {
+	return sosm.Find(ctx, 0)
+}
+
+func (sosm *standingOrderStorageMock) AddExecution(_ context.Context, executionState model.StandingOrderExecutionState) error {
+	sosm.executions = append(sosm.executions, executionState)
+
+	return nil
+}
+
+func (sosm *standingOrderStorageMock) ListExecutions(_ context.Context, _ model.StandingOrderID) ([]model.StandingOrderExecutionOutcome, error) {
+	return nil, sosm.err
+}
+
+type transactionBulkJobMock struct {
+	usecase.TransactionBulkJob
+
+	input *usecase.TransactionBulkInput
+	err   error
+}
+
+func (tbjm *transactionBulkJobMock) Enqueue(_ context.Context, input usecase.TransactionBulkInput) (*model.TransferBulkJob, error) {
+	if tbjm.err != nil {
+		return nil, tbjm.err
+	}
+
+	tbjm.input = &input
+
+	return &model.TransferBulkJob{ID: 3}, nil
+}
+
+func validStandingOrderInput() usecase.StandingOrderInput {
+	return usecase.StandingOrderInput{
+		OrganizationName: "ACME Corp",
+		OrganizationIban: "FR10474608000002006107XXXXX",
+		OrganizationBic:  "OIVUSCLQXXX",
+		Transfer: usecase.TransactionBulkTransferInput{
+			Amount:           "1200",
+			Currency:         "EUR",
+			CounterpartyName: "Landlord",
+			CounterpartyBic:  "CRLYFRPPTOU",
+			CounterpartyIban: "FR1420041010050500013M02606",
+			Description:      "Rent",
+		},
+		Frequency:  model.StandingOrderMonthly,
+		DayOfMonth: 5,
+		StartDate:  "2021-12-05",
+		CreatedBy:  "alice",
+	}
+}
+
+func testStandingOrder(status model.StandingOrderStatus) *model.StandingOrder {
+	return &model.StandingOrder{
+		ID: 1,
+		StandingOrderState: model.StandingOrderState{
+			OrganizationName: "ACME Corp",
+			OrganizationIban: "FR10474608000002006107XXXXX",
+			OrganizationBic:  "OIVUSCLQXXX",
+			StandingOrderTransfer: model.StandingOrderTransfer{
+				AmountCents:      120000,
+				Currency:         "EUR",
+				CounterpartyName: "Landlord",
+				CounterpartyIban: "FR1420041010050500013M02606",
+				CounterpartyBic:  "CRLYFRPPTOU",
+				Description:      "Rent",
+			},
+			StandingOrderRecurrence: model.StandingOrderRecurrence{
+				Frequency:  model.StandingOrderMonthly,
+				DayOfMonth: 5,
+				StartDate:  time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC),
+				Count:      2,
+			},
+			Status:            status,
+			NextExecutionDate: timePtr(time.Date(2021, 12, 5, 0, 0, 0, 0, time.UTC)),
+			ExecutionCount:    1,
+			CreatedBy:         "alice",
+		},
+	}
+}
+
+func Test_standingOrder_Create(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 5, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		input      func(input *usecase.StandingOrderInput)
+		storageErr error
+		next       *time.Time
+		violations []string
+		err        error
+	}{
+		{
+			name:  "standing order created",
+			input: func(input *usecase.StandingOrderInput) {},
+			next:  timePtr(time.Date(2021, 12, 5, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "weekly standing order created",
+			input: func(input *usecase.StandingOrderInput) {
+				input.Frequency = model.StandingOrderWeekly
+				input.DayOfMonth = 0
+				input.StartDate = "2021-12-08"
+				input.Count = 4
+			},
+			next: timePtr(time.Date(2021, 12, 8, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "invalid transfer and recurrence",
+			input: func(input *usecase.StandingOrderInput) {
+				input.Transfer.Amount = "-1"
+				input.Frequency = "yearly"
+				input.StartDate = "2021-12-04"
+				input.EndDate = "31/12/2021"
+				input.Count = -1
+			},
+			violations: []string{"transfer.amount", "frequency", "start_date", "count", "end_date"},
+			err:        usecase.ErrInvalidInput,
+		},
+		{
+			name: "invalid day of month",
+			input: func(input *usecase.StandingOrderInput) {
+				input.DayOfMonth = 32
+				input.StartDate = ""
+			},
+			violations: []string{"day_of_month", "start_date"},
+			err:        usecase.ErrInvalidInput,
+		},
+		{
+			name: "end date before the first execution date",
+			input: func(input *usecase.StandingOrderInput) {
+				input.StartDate = "2021-12-06"
+				input.EndDate = "2021-12-31"
+			},
+			violations: []string{"end_date"},
+			err:        usecase.ErrInvalidInput,
+		},
+		{
+			name:       "storage error",
+			input:      func(input *usecase.StandingOrderInput) {},
+			storageErr: sql.ErrConnDone,
+			err:        sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := validStandingOrderInput()
+			tc.input(&input)
+
+			st := &standingOrderStorageMock{err: tc.storageErr}
+
+			so := usecase.NewStandingOrder(ctxd.NoOpLogger{}, nil, clock.Fix(now), nil, st, st, st, st, st, st, st)
+
+			got, err := so.Create(context.Background(), input)
+
+			assert.ErrorIsf(t, err, tc.err, "Create() err got = %v, want %v", err, tc.err)
+
+			if tc.violations != nil {
+				var verr *usecase.ValidationError
+
+				require.ErrorAs(t, err, &verr)
+
+				fields := make([]string, len(verr.Violations))
+				for i, v := range verr.Violations {
+					fields[i] = v.Field
+				}
+
+				assert.Equal(t, tc.violations, fields)
+			}
+
+			if tc.err != nil {
+				assert.Nil(t, got)
+
+				return
+			}
+
+			require.NotNil(t, got)
+			assert.Equal(t, model.StandingOrderActive, got.Status)
+			assert.Equal(t, model.Cents(120000), got.AmountCents)
+			assert.Equal(t, tc.next, got.NextExecutionDate)
+			assert.Equal(t, "alice", got.CreatedBy)
+			assert.Equal(t, now, got.CreatedAt)
+		})
+	}
+}
+
+func Test_standingOrder_Skip(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		storage    *standingOrderStorageMock
+		status     model.StandingOrderStatus
+		executions []model.StandingOrderExecutionState
+		err        error
+	}{
+		{
+			name:    "next execution skipped",
+			storage: &standingOrderStorageMock{stored: testStandingOrder(model.StandingOrderActive)},
+			status:  model.StandingOrderActive,
+			executions: []model.StandingOrderExecutionState{
+				{
+					StandingOrderID: 1,
+					ExecutionDate:   time.Date(2021, 12, 5, 0, 0, 0, 0, time.UTC),
+					Status:          model.StandingOrderExecutionSkipped,
+					CreatedAt:       now,
+				},
+			},
+		},
+		{
+			name:    "cancelled standing order",
+			storage: &standingOrderStorageMock{stored: testStandingOrder(model.StandingOrderCancelled)},
+			err:     model.ErrStandingOrderStatus,
+		},
+		{
+			name:    "standing order not found",
+			storage: &standingOrderStorageMock{err: storage.ErrNotFound},
+			err:     storage.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			mock.ExpectBegin()
+
+			if tc.err != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			so := usecase.NewStandingOrder(
+				ctxd.NoOpLogger{}, st, clock.Fix(now), nil,
+				tc.storage, tc.storage, tc.storage, tc.storage, tc.storage, tc.storage, tc.storage,
+			)
+
+			got, err := so.Skip(context.Background(), 1)
+
+			assert.ErrorIsf(t, err, tc.err, "Skip() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.executions, tc.storage.executions)
+
+			if tc.err != nil {
+				assert.Nil(t, got)
+				assert.Nil(t, tc.storage.updated)
+
+				return
+			}
+
+			require.NotNil(t, got)
+			assert.Equal(t, tc.status, got.Status)
+			// the skipped execution is not counted, the count is reached one month later.
+			assert.Equal(t, timePtr(time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC)), got.NextExecutionDate)
+			assert.Equal(t, 1, got.ExecutionCount)
+			assert.Equal(t, now, got.UpdatedAt)
+			assert.Equal(t, got, tc.storage.updated)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Skip() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func Test_standingOrder_ProcessNext(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 5, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		storage            *standingOrderStorageMock
+		transactionBulkJob *transactionBulkJobMock
+		processed          bool
+		err                error
+	}{
+		{
+			name:               "standing order executed",
+			storage:            &standingOrderStorageMock{stored: testStandingOrder(model.StandingOrderActive)},
+			transactionBulkJob: &transactionBulkJobMock{},
+			processed:          true,
+		},
+		{
+			name:               "no standing order to execute",
+			storage:            &standingOrderStorageMock{},
+			transactionBulkJob: &transactionBulkJobMock{},
+		},
+		{
+			name:               "enqueue error",
+			storage:            &standingOrderStorageMock{stored: testStandingOrder(model.StandingOrderActive)},
+			transactionBulkJob: &transactionBulkJobMock{err: sql.ErrConnDone},
+			err:                sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			mock.ExpectBegin()
+
+			if tc.err != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			so := usecase.NewStandingOrder(
+				ctxd.NoOpLogger{}, st, clock.Fix(now), tc.transactionBulkJob,
+				tc.storage, tc.storage, tc.storage, tc.storage, tc.storage, tc.storage, tc.storage,
+			)
+
+			processed, err := so.ProcessNext(context.Background())
+
+			assert.Equal(t, tc.processed, processed, "ProcessNext() got = %v, want %v", processed, tc.processed)
+			assert.ErrorIsf(t, err, tc.err, "ProcessNext() err got = %v, want %v", err, tc.err)
+
+			if !tc.processed {
+				assert.Nil(t, tc.storage.updated)
+				assert.Empty(t, tc.storage.executions)
+
+				return
+			}
+
+			require.NotNil(t, tc.transactionBulkJob.input)
+			assert.Equal(t, "standing-order/1/2021-12-05", tc.transactionBulkJob.input.IdempotencyKey)
+			assert.Equal(t, "alice", tc.transactionBulkJob.input.SubmittedBy, "executed on behalf of the creator")
+			assert.Equal(t, []usecase.TransactionBulkTransferInput{
+				{
+					Amount:           "1200.00",
+					Currency:         "EUR",
+					CounterpartyName: "Landlord",
+					CounterpartyBic:  "CRLYFRPPTOU",
+					CounterpartyIban: "FR1420041010050500013M02606",
+					Description:      "Rent",
+				},
+			}, tc.transactionBulkJob.input.CreditTransfers)
+
+			assert.Equal(t, []model.StandingOrderExecutionState{
+				{
+					StandingOrderID:   1,
+					ExecutionDate:     time.Date(2021, 12, 5, 0, 0, 0, 0, time.UTC),
+					Status:            model.StandingOrderExecutionEnqueued,
+					TransferBulkJobID: 3,
+					CreatedAt:         now,
+				},
+			}, tc.storage.executions)
+
+			// the second execution reaches the count.
+			require.NotNil(t, tc.storage.updated)
+			assert.Equal(t, model.StandingOrderCompleted, tc.storage.updated.Status)
+			assert.Equal(t, 2, tc.storage.updated.ExecutionCount)
+			assert.Nil(t, tc.storage.updated.NextExecutionDate)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("ProcessNext() expectations were not met = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/nhatthm/go-clock"
+)
+
+// StatementDateLayout is the layout of the statement period dates.
+const StatementDateLayout = "2006-01-02"
+
+// StatementExport defines the functionality of the use case StatementExport used to build the statement of a bank
+// account.
+type StatementExport interface {
+	// Statement builds the statement of the bank account over the input period.
+	Statement(ctx context.Context, input StatementInput) (*model.Statement, error)
+}
+
+// StatementInput is the input of the use case StatementExport.
+type StatementInput struct {
+	Iban string
+	// From and To are the first and the last day of the period, as StatementDateLayout dates in UTC.
+	From string
+	To   string
+}
+
+// TransactionDebitSummer is a storage interface that defines the functionality to sum the debited amounts.
+type TransactionDebitSummer interface {
+	// SumDebited sums the amounts debited from the bank account by the transactions created at or after since.
+	SumDebited(ctx context.Context, bankAccountID model.BankAccountID, since time.Time) (model.Cents, error)
+}
+
+type statementExport struct {
+	storage       *sqluct.Storage
+	clock         clock.Clock
+	accountFinder BankAccountIbanFinder
+	lister        TransactionLister
+	summer        TransactionDebitSummer
+}
+
+var _ StatementExport = new(statementExport)
+
+// NewStatementExport creates an instance of StatementExport use case.
+func NewStatementExport(
+	storage *sqluct.Storage,
+	clock clock.Clock,
+	accountFinder BankAccountIbanFinder,
+	lister TransactionLister,
+	summer TransactionDebitSummer,
+) StatementExport {
+	return &statementExport{
+		storage:       storage,
+		clock:         clock,
+		accountFinder: accountFinder,
+		lister:        lister,
+		summer:        summer,
+	}
+}
+
+// Statement builds the statement of the bank account over the input period.
+//
+// The balances are computed back from the current balance, the account is locked while the statement is built so
+// that no transfer is performed in between.
+func (se *statementExport) Statement(ctx context.Context, input StatementInput) (*model.Statement, error) {
+	ctx = ctxd.AddFields(ctx, "iban", input.Iban, "from", input.From, "to", input.To)
+
+	from, to, err := validateStatementInput(input)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to validate input")
+	}
+
+	statement := model.Statement{
+		From:      from,
+		To:        to,
+		CreatedAt: se.clock.Now(),
+	}
+
+	err = se.storage.InTx(ctx, func(ctx context.Context) error {
+		account, err := se.accountFinder.FindByIban(ctx, input.Iban)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "failed to find account")
+		}
+
+		statement.BankAccount = *account
+
+		debitedAfter, err := se.summer.SumDebited(ctx, account.ID, to)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "failed to sum debited amounts")
+		}
+
+		statement.Transactions, err = se.lister.List(ctx, model.TransactionFilter{
+			BankAccountID: account.ID,
+			From:          from,
+			To:            to,
+			Sort:          model.TransactionSortOldest,
+		})
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "failed to list transactions")
+		}
+
+		statement.ClosingBalanceCents = account.BalanceCents + debitedAfter
+		statement.OpeningBalanceCents = statement.ClosingBalanceCents
+
+		for _, transaction := range statement.Transactions {
+			statement.OpeningBalanceCents += transaction.DebitedCents
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &statement, nil
+}
+
+// validateStatementInput validates the input and returns the period, To being exclusive.
+//
+// Returns a *ValidationError with all the field violations when the input is not valid.
+func validateStatementInput(input StatementInput) (time.Time, time.Time, error) {
+	var (
+		verr     ValidationError
+		from, to time.Time
+		err      error
+	)
+
+	if input.Iban == "" {
+		verr.add("iban", errors.New("must not be empty"))
+	}
+
+	if from, err = time.Parse(StatementDateLayout, input.From); err != nil {
+		verr.add("from", errors.New("must be a YYYY-MM-DD date"))
+	}
+
+	if to, err = time.Parse(StatementDateLayout, input.To); err != nil {
+		verr.add("to", errors.New("must be a YYYY-MM-DD date"))
+	}
+
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		verr.add("to", errors.New("must not be before from"))
+	}
+
+	if len(verr.Violations) > 0 {
+		return time.Time{}, time.Time{}, &verr
+	}
+
+	return from, to.AddDate(0, 0, 1), nil
+}
@@ -0,0 +1,154 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type transactionDebitSummerMock struct {
+	t     *testing.T
+	since time.Time
+	sum   model.Cents
+	err   error
+}
+
+func (tdsm *transactionDebitSummerMock) SumDebited(_ context.Context, _ model.BankAccountID, since time.Time) (model.Cents, error) {
+	assert.Equal(tdsm.t, tdsm.since, since, "SumDebited() got since arg = %v, expected %v", since, tdsm.since)
+
+	return tdsm.sum, tdsm.err
+}
+
+func Test_statementExport_Statement(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 3, 9, 0, 0, 0, time.UTC)
+	from := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	account := &model.BankAccount{
+		ID: 1,
+		BankAccountState: model.BankAccountState{
+			OrganizationName: "ACME Corp",
+			BalanceCents:     3774850,
+			Iban:             "FR10474608000002006107XXXXX",
+			Bic:              "OIVUSCLQXXX",
+			Currency:         "EUR",
+		},
+	}
+
+	transactions := queryTransactions(2)
+
+	tests := []struct {
+		name   string
+		input  usecase.StatementInput
+		summer *transactionDebitSummerMock
+		want   *model.Statement
+		tx     bool
+		err    error
+	}{
+		{
+			name:   "statement built, balances computed back from the current balance",
+			input:  usecase.StatementInput{Iban: account.Iban, From: "2021-12-01", To: "2021-12-31"},
+			summer: &transactionDebitSummerMock{since: to, sum: 1000},
+			want: &model.Statement{
+				BankAccount:         *account,
+				From:                from,
+				To:                  to,
+				OpeningBalanceCents: 3774850 + 1000 + 2*1450,
+				ClosingBalanceCents: 3774850 + 1000,
+				Transactions:        transactions,
+				CreatedAt:           now,
+			},
+			tx: true,
+		},
+		{
+			name:   "account not found",
+			input:  usecase.StatementInput{Iban: "FR1420041010050500013M02606", From: "2021-12-01", To: "2021-12-31"},
+			summer: &transactionDebitSummerMock{},
+			tx:     true,
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:   "storage error when summing debited amounts",
+			input:  usecase.StatementInput{Iban: account.Iban, From: "2021-12-01", To: "2021-12-31"},
+			summer: &transactionDebitSummerMock{since: to, err: sql.ErrConnDone},
+			tx:     true,
+			err:    sql.ErrConnDone,
+		},
+		{
+			name:   "invalid input, period ends before it starts",
+			input:  usecase.StatementInput{Iban: account.Iban, From: "2021-12-31", To: "2021-12-01"},
+			summer: &transactionDebitSummerMock{},
+			err:    usecase.ErrInvalidInput,
+		},
+		{
+			name:   "invalid input, not a date",
+			input:  usecase.StatementInput{Iban: account.Iban, From: "December", To: "2021-12-31"},
+			summer: &transactionDebitSummerMock{},
+			err:    usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			if tc.tx {
+				mock.ExpectBegin()
+
+				if tc.err != nil {
+					mock.ExpectRollback()
+				} else {
+					mock.ExpectCommit()
+				}
+			}
+
+			tc.summer.t = t
+
+			st := &transactionStorageMock{account: account, transactions: transactions}
+
+			se := usecase.NewStatementExport(
+				sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")),
+				clock.Fix(now),
+				st,
+				st,
+				tc.summer,
+			)
+
+			got, err := se.Statement(context.Background(), tc.input)
+
+			assert.ErrorIsf(t, err, tc.err, "Statement() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.want, got, "Statement() got = %v, want %v", got, tc.want)
+
+			if tc.want != nil {
+				assert.Equal(t, &model.TransactionFilter{
+					BankAccountID: account.ID,
+					From:          from,
+					To:            to,
+					Sort:          model.TransactionSortOldest,
+				}, st.filter)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Statement() expectations were not met = %v", err)
+			}
+		})
+	}
+}
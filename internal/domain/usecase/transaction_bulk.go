@@ -2,16 +2,43 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/bool64/ctxd"
 	"github.com/bool64/sqluct"
 	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/nhatthm/go-clock"
+)
+
+var (
+	// ErrIdempotencyKeyMismatch error represents when the idempotency key was already used with a different request.
+	ErrIdempotencyKeyMismatch = errors.New("idempotency key already used with a different request")
+	// ErrRateNotFound error represents when there is no exchange rate for the currency pair.
+	ErrRateNotFound = errors.New("exchange rate not found")
+
+	// errQuoteRollback rolls back the transaction of a validate-only transfer bulk.
+	errQuoteRollback = errors.New("validate only, rolled back")
+)
+
+const (
+	// reasonNotEnoughBalance is the reason a credit transfer is rejected in partial mode, when the account has no
+	// overdraft facility to use.
+	reasonNotEnoughBalance = "not enough balance"
+	// reasonOverdraftExceeded is the reason a credit transfer is rejected in partial mode, when the available balance
+	// plus the overdraft limit does not cover it.
+	reasonOverdraftExceeded = "overdraft limit exceeded"
 )
 
 // TransactionBulk defines the functionality of the use case TransactionBulk used to process a transaction in bulk.
 type TransactionBulk interface {
 	// TransactionBulk use case functionality to process a transaction in bulk.
-	TransactionBulk(ctx context.Context, input TransactionBulkInput) error
+	TransactionBulk(ctx context.Context, input TransactionBulkInput) (*TransactionBulkOutput, error)
 }
 
 // TransactionBulkInput contains all the inputs require executing TransactionBulk use case.
@@ -20,16 +47,59 @@ type TransactionBulkInput struct {
 	OrganizationIban string
 	OrganizationBic  string
 	CreditTransfers  []TransactionBulkTransferInput
+	// IdempotencyKey identifies the request, retries with the same key are not processed twice.
+	IdempotencyKey string
+	// Mode is how the credit transfers are executed, atomic when empty.
+	Mode model.TransferBulkMode `json:",omitempty"`
+	// ValidateOnly only checks the credit transfers would be executed, the output has the totals but neither
+	// transfer bulk nor transactions.
+	ValidateOnly bool `json:"-"`
+	// RequestedExecutionDate is the date, formatted as YYYY-MM-DD, the credit transfers are executed on. The transfers
+	// are executed as soon as possible when empty.
+	RequestedExecutionDate string `json:",omitempty"`
+	// SubmittedBy is the user submitting the transfer bulk, who may not approve it when it exceeds the approval
+	// threshold. The transfer bulk requiring approval is refused when empty.
+	SubmittedBy string `json:"-"`
+
+	// approved is the transfer bulk awaiting approval performed on approval, reserved already.
+	approved *model.TransferBulk
 }
 
 // TransactionBulkTransferInput contains all the inputs transfer require executing TransactionBulk use case.
 type TransactionBulkTransferInput struct {
-	Amount           float64
+	// Amount is the decimal amount of the transfer in its currency, e.g. "14.50".
+	Amount           string
 	Currency         string
 	CounterpartyName string
 	CounterpartyBic  string
 	CounterpartyIban string
 	Description      string
+	// Instant requests the credit transfer as a SEPA instant credit transfer, charged the instant fee.
+	Instant bool `json:",omitempty"`
+}
+
+// TransactionBulkOutput contains the outcome of executing TransactionBulk use case.
+type TransactionBulkOutput struct {
+	TransferBulkID model.TransferBulkID
+	// TransactionIDs are the created transaction ids, in the same order as the executed credit transfers.
+	TransactionIDs []model.TransactionID
+	// Rows are the outcome of each credit transfer, in the same order as the credit transfers.
+	Rows         []model.TransferBulkRow
+	DebitedCents model.Cents
+	// FeesCents are the fees charged for the credit transfers, debited on top of DebitedCents.
+	FeesCents    model.Cents
+	BalanceCents model.Cents
+	// Currency is the bank account currency of the debited, fees and balance amounts.
+	Currency string
+	// Status is the approval status of the transfer bulk, empty when validate-only. The amounts of a transfer bulk
+	// awaiting approval are those debited once approved, the balance is not debited yet.
+	Status model.TransferBulkStatus
+}
+
+// AccountFinder is a storage interface that defines the functionality to find the account.
+type AccountFinder interface {
+	// Find finds the bank account from a storage.
+	Find(ctx context.Context, accountState model.BankAccountState) (*model.BankAccount, error)
 }
 
 // AccountBalanceChecker is a storage interface that defines the functionality to check the account balance.
@@ -42,121 +112,1191 @@ type AccountBalanceChecker interface {
 
 // BalanceUpdater is a storage interface that defines the functionality to update the account balance.
 type BalanceUpdater interface {
-	// BalanceUpdate updates the account balance from a storage.
-	BalanceUpdate(ctx context.Context, accountID model.BankAccountID, amount model.Cents) error
+	// BalanceAdd adds the amount to the account balance from a storage, a negative amount debits the account.
+	BalanceAdd(ctx context.Context, accountID model.BankAccountID, amount model.Cents) error
 }
 
 // TransactionAdder is a storage interface that defines the functionality to add the transaction.
 type TransactionAdder interface {
 	// Add adds a transaction into a storage.
-	Add(ctx context.Context, transactionStates []model.TransactionState) error
+	//
+	// Returns the ids of the added transactions, in the same order as the given transaction states.
+	Add(ctx context.Context, transactionStates []model.TransactionState) ([]model.TransactionID, error)
+}
+
+// BankAccountsIbanFinder is a storage interface that defines the functionality to find the accounts of ibans.
+type BankAccountsIbanFinder interface {
+	// FindByIbans finds the bank accounts of the ibans from a storage, the ibans without bank account are left out.
+	FindByIbans(ctx context.Context, ibans []string) ([]model.BankAccount, error)
+}
+
+// TransactionIDsFinder is a storage interface that defines the functionality to find the transactions of a transfer bulk.
+type TransactionIDsFinder interface {
+	// FindIDsByTransferBulk finds the ids of the transactions added by the transfer bulk from a storage.
+	FindIDsByTransferBulk(ctx context.Context, transferBulkID model.TransferBulkID) ([]model.TransactionID, error)
+}
+
+// TransferBulkReserver is a storage interface that defines the functionality to reserve the transfer bulk.
+type TransferBulkReserver interface {
+	// Reserve adds the transfer bulk into a storage.
+	//
+	// Returns the stored transfer bulk and false when the idempotency key was already used.
+	Reserve(ctx context.Context, transferBulkState model.TransferBulkState) (*model.TransferBulk, bool, error)
+}
+
+// TransferBulkCompleter is a storage interface that defines the functionality to store the transfer bulk outcome.
+type TransferBulkCompleter interface {
+	// Complete stores the outcome of the performed transfer bulk into a storage.
+	Complete(ctx context.Context, transferBulk model.TransferBulk) error
+}
+
+// JournalPoster is a storage interface that defines the functionality to post journal entries into the ledger.
+type JournalPoster interface {
+	// Post adds the journal entry with its postings into a storage.
+	//
+	// Returns model.ErrUnbalancedJournal when the postings do not balance.
+	Post(ctx context.Context, journalEntryState model.JournalEntryState, postingStates []model.PostingState) (model.JournalEntryID, error)
+}
+
+// RateProvider is an interface that defines the functionality to provide exchange rates.
+type RateProvider interface {
+	// Rate returns the decimal amount of the currency to for one unit of the currency from.
+	//
+	// Returns ErrRateNotFound when the currency pair is not supported.
+	Rate(ctx context.Context, from, to string) (string, error)
+}
+
+// TransferLimitFinder is a storage interface that defines the functionality to find the transfer limits of an account.
+type TransferLimitFinder interface {
+	// FindForAccount finds the limits of the bank account, and of the organization of the bank account.
+	FindForAccount(ctx context.Context, account model.BankAccount) ([]model.TransferLimit, error)
+}
+
+// TransferUsageFinder is a storage interface that defines the functionality to find the use of a transfer limit.
+type TransferUsageFinder interface {
+	// LockOrganizationUsage locks the usage of the limits of the organization until the transaction ends.
+	LockOrganizationUsage(ctx context.Context, organizationName string) error
+	// OutgoingUsage finds the credit transfers sent already under the limit, at the time now.
+	OutgoingUsage(ctx context.Context, limit model.TransferLimit, now time.Time) (*model.TransferUsage, error)
+}
+
+// FeeCalculator is an interface that defines the functionality to calculate the fees of the credit transfers.
+type FeeCalculator interface {
+	// Fee returns the fee charged for the credit transfer, in cents of the bank account currency, 0 for no fee.
+	Fee(ctx context.Context, input FeeInput) (model.Cents, error)
+}
+
+// FeeInput is the credit transfer a fee is calculated for.
+type FeeInput struct {
+	BankAccount model.BankAccount
+	Kind        model.TransferKind
+	// AmountCents is the amount of the credit transfer in its Currency, DebitedCents in the bank account currency.
+	AmountCents  model.Cents
+	Currency     string
+	DebitedCents model.Cents
+}
+
+// TransferBulkDecisionAdder is a storage interface that defines the functionality to add the decisions of the
+// approval trail of the transfer bulks.
+type TransferBulkDecisionAdder interface {
+	// Add adds the decision on the transfer bulk into a storage.
+	Add(ctx context.Context, decisionState model.TransferBulkDecisionState) (*model.TransferBulkDecision, error)
+}
+
+// CounterpartyScreener is an interface that defines the functionality to screen the counterparties against the
+// sanctions and block lists.
+type CounterpartyScreener interface {
+	// Screen returns the list entry the counterparty matches best, nil when none matches.
+	Screen(ctx context.Context, input ScreeningInput) (*model.ScreeningHit, error)
+}
+
+// ScreeningInput is the counterparty of a credit transfer screened.
+type ScreeningInput struct {
+	Name string
+	Iban string
+	Bic  string
 }
 
 type transactionBulk struct {
-	logger  ctxd.Logger
-	storage *sqluct.Storage
-	checker AccountBalanceChecker
-	updater BalanceUpdater
-	adder   TransactionAdder
+	logger        ctxd.Logger
+	storage       *sqluct.Storage
+	reserver      TransferBulkReserver
+	completer     TransferBulkCompleter
+	accountFinder AccountFinder
+	checker       AccountBalanceChecker
+	updater       BalanceUpdater
+	adder         TransactionAdder
+	finder        TransactionIDsFinder
+	poster        JournalPoster
+	rates         RateProvider
+	ibansFinder   BankAccountsIbanFinder
+	locker        AccountLocker
+	clock         clock.Clock
+	limitFinder   TransferLimitFinder
+	usageFinder   TransferUsageFinder
+	fees          FeeCalculator
+	thresholds    model.ApprovalThresholds
+	decisions     TransferBulkDecisionAdder
+	screener      CounterpartyScreener
 }
 
 var _ TransactionBulk = new(transactionBulk)
 
 // NewTransactionBulk creates an instance of TransactionBulk use case.
+//
+// The transfer bulks debiting more than the approval threshold of the bank account currency are stored awaiting
+// approval, and those with counterparties matching the sanctions and block lists are held for review. They are
+// performed once approved through TransferBulkApproval.
 func NewTransactionBulk(
 	logger ctxd.Logger,
 	storage *sqluct.Storage,
+	reserver TransferBulkReserver,
+	completer TransferBulkCompleter,
+	accountFinder AccountFinder,
 	checker AccountBalanceChecker,
 	didacticer BalanceUpdater,
 	adder TransactionAdder,
+	finder TransactionIDsFinder,
+	poster JournalPoster,
+	rates RateProvider,
+	ibansFinder BankAccountsIbanFinder,
+	locker AccountLocker,
+	clock clock.Clock,
+	limitFinder TransferLimitFinder,
+	usageFinder TransferUsageFinder,
+	fees FeeCalculator,
+	thresholds model.ApprovalThresholds,
+	decisions TransferBulkDecisionAdder,
+	screener CounterpartyScreener,
 ) TransactionBulk {
 	return &transactionBulk{
-		logger:  logger,
-		storage: storage,
-		checker: checker,
-		updater: didacticer,
-		adder:   adder,
+		logger:        logger,
+		storage:       storage,
+		reserver:      reserver,
+		completer:     completer,
+		accountFinder: accountFinder,
+		checker:       checker,
+		updater:       didacticer,
+		adder:         adder,
+		finder:        finder,
+		poster:        poster,
+		rates:         rates,
+		ibansFinder:   ibansFinder,
+		locker:        locker,
+		clock:         clock,
+		limitFinder:   limitFinder,
+		usageFinder:   usageFinder,
+		fees:          fees,
+		thresholds:    thresholds,
+		decisions:     decisions,
+		screener:      screener,
 	}
 }
 
 // TransactionBulk use case functionality to process a transaction in bulk.
-func (tb *transactionBulk) TransactionBulk(ctx context.Context, input TransactionBulkInput) error {
+func (tb *transactionBulk) TransactionBulk(ctx context.Context, input TransactionBulkInput) (*TransactionBulkOutput, error) {
 	ctx = ctxd.AddFields(
 		ctx,
 		"organization_name", input.OrganizationName,
 		"organization_bic", input.OrganizationBic,
 		"organization_iban", input.OrganizationIban,
+		"idempotency_key", input.IdempotencyKey,
 	)
 
-	var creditsTransferAmount model.Cents
+	amountsCents, err := ValidateTransactionBulkInput(input)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to validate input")
+	}
 
-	for _, transfer := range input.CreditTransfers {
-		// convert transfer amount into cents
-		creditsTransferAmount += model.ToCents(transfer.Amount)
+	if input.ValidateOnly {
+		return tb.quote(ctx, input, amountsCents)
 	}
 
-	ctx = ctxd.AddFields(ctx, "credit_transfers_total", creditsTransferAmount)
+	requestHash, err := hashRequest(input)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to hash request")
+	}
 
-	err := tb.storage.InTx(ctx, func(ctx context.Context) error {
-		accountState := model.BankAccountState{
-			OrganizationName: input.OrganizationName,
-			Iban:             input.OrganizationIban,
-			Bic:              input.OrganizationBic,
-		}
+	var output TransactionBulkOutput
 
-		tb.logger.Debug(ctx, "checking account has enough balance")
+	err = tb.storage.InTx(ctx, func(ctx context.Context) error {
+		// the transfer bulk approved is reserved already, it is performed as reserved now.
+		transferBulk := input.approved
 
-		account, err := tb.checker.BalanceCheck(ctx, accountState, creditsTransferAmount)
+		if transferBulk == nil {
+			var (
+				reserved bool
+				err      error
+			)
+
+			transferBulk, reserved, err = tb.reserver.Reserve(ctx, model.TransferBulkState{
+				IdempotencyKey: input.IdempotencyKey,
+				RequestHash:    requestHash,
+			})
+			if err != nil {
+				return err
+			}
+
+			if !reserved {
+				output, err = tb.replay(ctx, *transferBulk, requestHash, len(input.CreditTransfers))
+
+				return err
+			}
+		}
+
+		plan, err := tb.plan(ctx, input, amountsCents)
 		if err != nil {
 			return err
 		}
 
-		tb.logger.Debug(ctx, "account has enough balance")
+		// the transfer bulk approved is screened already, an approval clears the screening hits.
+		if input.approved == nil {
+			hits, err := tb.screen(ctx, input, plan)
+			if err != nil {
+				return err
+			}
+
+			if len(hits) > 0 || tb.thresholds.Exceeded(plan.total, plan.account.Currency) {
+				output, err = tb.awaitApproval(ctx, transferBulk, input, plan, hits)
+
+				return err
+			}
+		}
+
+		var transactionIDs []model.TransactionID
+
+		account := plan.account
+
+		ctx = ctxd.AddFields(ctx, "credit_transfers_total", plan.total)
+
+		newAmount := account.BalanceCents - plan.total - plan.fees
+
+		// in partial mode, none of the credit transfers may be covered by the balance.
+		if plan.total > 0 {
+			var TransactionStates []model.TransactionState
+
+			for i, transfer := range input.CreditTransfers {
+				if !plan.executed[i] {
+					continue
+				}
+
+				amountCents := amountsCents[i]
+
+				tb.logger.Debug(ctx, "adding transfer",
+					"bankAccount_id", account.ID,
+					"transfer_amount", account.ID,
+					"counterparty_iban", transfer.CounterpartyIban,
+					"counterparty_bic", transfer.CounterpartyBic,
+					"amount_cents", amountCents,
+					"debited_cents", plan.debitedCents[i],
+				)
+
+				TransactionStates = append(TransactionStates, model.TransactionState{
+					CounterpartyName: transfer.CounterpartyName,
+					CounterpartyIban: transfer.CounterpartyIban,
+					CounterpartyBic:  transfer.CounterpartyBic,
+					AmountCents:      amountCents,
+					AmountCurrency:   transfer.Currency,
+					BankAccountID:    account.ID,
+					Description:      transfer.Description,
+					TransferBulkID:   transferBulk.ID,
+					DebitedCents:     plan.debitedCents[i],
+					DebitedCurrency:  account.Currency,
+					ExchangeRate:     plan.rates[i],
+					Internal:         plan.receivers[i] != nil,
+				})
+			}
+
+			credits := plan.internalCredits(input, amountsCents)
+
+			// the credits of the internal transfers are added after the debits, their ids are not part of the output.
+			ids, err := tb.adder.Add(ctx, append(TransactionStates, credits...))
+			if err != nil {
+				return err
+			}
+
+			transactionIDs = ids[:len(TransactionStates)]
 
-		var TransactionStates []model.TransactionState
+			tb.logger.Debug(ctx, "all transfer added")
 
-		for _, transfer := range input.CreditTransfers {
-			amountCents := model.ToCents(transfer.Amount)
+			postings := transferBulkPostings(account, transactionIDs, executedAmounts(plan.debitedCents, plan.executed), plan.total)
 
-			tb.logger.Debug(ctx, "adding transfer",
-				"bankAccount_id", account.ID,
-				"transfer_amount", account.ID,
-				"counterparty_iban", transfer.CounterpartyIban,
-				"counterparty_bic", transfer.CounterpartyBic,
-				"amount_cents", amountCents,
+			if plan.fees > 0 {
+				fees := plan.feeTransactions(transactionIDs, TransactionStates)
+
+				feeIDs, err := tb.adder.Add(ctx, fees)
+				if err != nil {
+					return err
+				}
+
+				tb.logger.Debug(ctx, "fees added", "fees_cents", plan.fees)
+
+				postings = append(postings, feePostings(account, fees, feeIDs)...)
+			}
+
+			journalEntryID, err := tb.poster.Post(
+				ctx,
+				model.JournalEntryState{
+					TransferBulkID: transferBulk.ID,
+					Description:    "Transfer bulk",
+				},
+				append(postings, internalCreditPostings(credits, ids[len(TransactionStates):])...),
 			)
+			if err != nil {
+				return err
+			}
 
-			TransactionStates = append(TransactionStates, model.TransactionState{
-				CounterpartyName: transfer.CounterpartyName,
-				CounterpartyIban: transfer.CounterpartyIban,
-				CounterpartyBic:  transfer.CounterpartyBic,
-				AmountCents:      amountCents,
-				AmountCurrency:   transfer.Currency,
-				BankAccountID:    account.ID,
-				Description:      transfer.Description,
-			})
+			tb.logger.Debug(ctx, "journal entry posted", "journal_entry_id", journalEntryID)
+
+			tb.logger.Debug(ctx, "didactic balance from account")
+
+			err = tb.updater.BalanceAdd(ctx, account.ID, -(plan.total + plan.fees))
+			if err != nil {
+				return err
+			}
+
+			tb.logger.Debug(ctx, "balance from account didactic")
+
+			err = tb.creditReceivers(ctx, plan.receivers, credits)
+			if err != nil {
+				return err
+			}
 		}
 
-		err = tb.adder.Add(ctx, TransactionStates)
+		transferBulk.BankAccountID = account.ID
+		transferBulk.DebitedCents = plan.total
+		transferBulk.FeesCents = plan.fees
+		transferBulk.BalanceCents = newAmount
+		transferBulk.Currency = account.Currency
+		transferBulk.Rejections = plan.rejections
+		transferBulk.Status = model.TransferBulkExecuted
+
+		err = tb.completer.Complete(ctx, *transferBulk)
 		if err != nil {
 			return err
 		}
 
-		tb.logger.Debug(ctx, "all transfer added")
+		output = TransactionBulkOutput{
+			TransferBulkID: transferBulk.ID,
+			TransactionIDs: transactionIDs,
+			Rows:           model.TransferBulkRows(transactionIDs, plan.rejections),
+			DebitedCents:   plan.total,
+			FeesCents:      plan.fees,
+			BalanceCents:   newAmount,
+			Currency:       account.Currency,
+			Status:         model.TransferBulkExecuted,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		tb.logger.Debug(ctx, "didactic balance from account")
+	return &output, nil
+}
 
-		newAmount := account.BalanceCents - creditsTransferAmount
+// replay returns the outcome of the transfer bulk processed already with the same idempotency key, given the number
+// of credit transfers of the request.
+func (tb *transactionBulk) replay(
+	ctx context.Context,
+	transferBulk model.TransferBulk,
+	requestHash string,
+	count int,
+) (TransactionBulkOutput, error) {
+	if transferBulk.RequestHash != requestHash {
+		return TransactionBulkOutput{}, ctxd.WrapError(ctx, ErrIdempotencyKeyMismatch, "failed to reserve transfer bulk",
+			"transfer_bulk_id", transferBulk.ID,
+		)
+	}
+
+	tb.logger.Debug(ctx, "transfer bulk already processed", "transfer_bulk_id", transferBulk.ID)
+
+	transactionIDs, err := tb.finder.FindIDsByTransferBulk(ctx, transferBulk.ID)
+	if err != nil {
+		return TransactionBulkOutput{}, err
+	}
+
+	return TransactionBulkOutput{
+		TransferBulkID: transferBulk.ID,
+		TransactionIDs: transactionIDs,
+		Rows:           transferBulk.Rows(count, transactionIDs),
+		DebitedCents:   transferBulk.DebitedCents,
+		FeesCents:      transferBulk.FeesCents,
+		BalanceCents:   transferBulk.BalanceCents,
+		Currency:       transferBulk.Currency,
+		Status:         transferBulk.Status,
+	}, nil
+}
 
-		err = tb.updater.BalanceUpdate(ctx, account.ID, newAmount)
+// screen screens the counterparties of the credit transfers to execute against the sanctions and block lists.
+//
+// Returns the credit transfers matching an entry of the lists.
+func (tb *transactionBulk) screen(ctx context.Context, input TransactionBulkInput, plan *transferBulkPlan) (model.ScreeningHits, error) {
+	var hits model.ScreeningHits
+
+	for i, transfer := range input.CreditTransfers {
+		if !plan.executed[i] {
+			continue
+		}
+
+		hit, err := tb.screener.Screen(ctx, ScreeningInput{
+			Name: transfer.CounterpartyName,
+			Iban: transfer.CounterpartyIban,
+			Bic:  transfer.CounterpartyBic,
+		})
+		if err != nil {
+			return nil, ctxd.WrapError(ctx, err, "failed to screen counterparty", "row", i)
+		}
+
+		if hit != nil {
+			hit.Row = i
+			hits = append(hits, *hit)
+		}
+	}
+
+	return hits, nil
+}
+
+// awaitApproval stores the transfer bulk awaiting approval, or held for review when there are screening hits, with
+// the request to perform on approval and the amounts planned. Nothing is debited, the balance is checked again on
+// approval. The submitter must be known, the transfer bulk being approved by another user.
+func (tb *transactionBulk) awaitApproval(
+	ctx context.Context,
+	transferBulk *model.TransferBulk,
+	input TransactionBulkInput,
+	plan *transferBulkPlan,
+	hits model.ScreeningHits,
+) (TransactionBulkOutput, error) {
+	if input.SubmittedBy == "" {
+		return TransactionBulkOutput{}, ctxd.WrapError(ctx, model.ErrUnknownSubmitter,
+			"failed to submit transfer bulk for approval", "debited_cents", plan.total, "screening_hits", len(hits))
+	}
+
+	request, err := json.Marshal(input)
+	if err != nil {
+		return TransactionBulkOutput{}, ctxd.WrapError(ctx, err, "failed to encode request")
+	}
+
+	transferBulk.BankAccountID = plan.account.ID
+	transferBulk.DebitedCents = plan.total
+	transferBulk.FeesCents = plan.fees
+	transferBulk.BalanceCents = plan.account.BalanceCents
+	transferBulk.Currency = plan.account.Currency
+	transferBulk.Rejections = plan.rejections
+	transferBulk.Status = model.TransferBulkAwaitingApproval
+	transferBulk.Request = string(request)
+	transferBulk.SubmittedBy = input.SubmittedBy
+	transferBulk.ScreeningHits = hits
+
+	if len(hits) > 0 {
+		transferBulk.Status = model.TransferBulkHeldForReview
+	}
+
+	if err := tb.completer.Complete(ctx, *transferBulk); err != nil {
+		return TransactionBulkOutput{}, err
+	}
+
+	_, err = tb.decisions.Add(ctx, model.TransferBulkDecisionState{
+		TransferBulkID: transferBulk.ID,
+		Decision:       model.TransferBulkSubmitted,
+		UserID:         input.SubmittedBy,
+		CreatedAt:      tb.clock.Now(),
+	})
+	if err != nil {
+		return TransactionBulkOutput{}, err
+	}
+
+	if len(hits) > 0 {
+		tb.logger.Warn(ctx, "transfer bulk held for review", "transfer_bulk_id", transferBulk.ID, "screening_hits", hits)
+	} else {
+		tb.logger.Debug(ctx, "transfer bulk awaiting approval", "transfer_bulk_id", transferBulk.ID)
+	}
+
+	return TransactionBulkOutput{
+		TransferBulkID: transferBulk.ID,
+		Rows:           transferBulk.Rows(len(input.CreditTransfers), nil),
+		DebitedCents:   transferBulk.DebitedCents,
+		FeesCents:      transferBulk.FeesCents,
+		BalanceCents:   transferBulk.BalanceCents,
+		Currency:       transferBulk.Currency,
+		Status:         transferBulk.Status,
+	}, nil
+}
+
+// transferBulkPlan is the outcome the credit transfers have given the account balance.
+type transferBulkPlan struct {
+	// account is the bank account, locked until the transaction ends.
+	account *model.BankAccount
+	// debitedCents and rates are the amounts in the account currency and the exchange rates, by credit transfer.
+	debitedCents []model.Cents
+	rates        []string
+	executed     []bool
+	rejections   model.TransferBulkRejections
+	// total is the amount debited by the executed credit transfers.
+	total model.Cents
+
+	// feesCents and kinds are the fees charged and the kinds of the credit transfers, by credit transfer. fees is the
+	// total of the fees charged for the executed credit transfers.
+	feesCents []model.Cents
+	kinds     []model.TransferKind
+	fees      model.Cents
+
+	// receivers are the bank accounts of the service credited by the internal credit transfers, by credit transfer, nil
+	// for the credit transfers to other banks. They are locked until the transaction ends.
+	receivers []*model.BankAccount
+	// creditedCents and creditRates are the amounts in the receiver currency and the exchange rates, by credit transfer.
+	creditedCents []model.Cents
+	creditRates   []string
+}
+
+// internalCredits returns the transactions crediting the receivers of the executed internal credit transfers.
+func (p *transferBulkPlan) internalCredits(input TransactionBulkInput, amountsCents []model.Cents) []model.TransactionState {
+	var credits []model.TransactionState
+
+	for i, transfer := range input.CreditTransfers {
+		receiver := p.receivers[i]
+
+		if !p.executed[i] || receiver == nil {
+			continue
+		}
+
+		credits = append(credits, model.TransactionState{
+			CounterpartyName: input.OrganizationName,
+			CounterpartyIban: input.OrganizationIban,
+			CounterpartyBic:  input.OrganizationBic,
+			AmountCents:      -amountsCents[i],
+			AmountCurrency:   transfer.Currency,
+			BankAccountID:    receiver.ID,
+			Description:      transfer.Description,
+			DebitedCents:     -p.creditedCents[i],
+			DebitedCurrency:  receiver.Currency,
+			ExchangeRate:     p.creditRates[i],
+			Internal:         true,
+		})
+	}
+
+	return credits
+}
+
+// feeTransactions returns the transactions debiting the fees of the executed credit transfers, given the transactions
+// of the executed credit transfers and their ids.
+func (p *transferBulkPlan) feeTransactions(
+	transactionIDs []model.TransactionID,
+	transactionStates []model.TransactionState,
+) []model.TransactionState {
+	var fees []model.TransactionState
+
+	k := 0
+
+	for i, ok := range p.executed {
+		if !ok {
+			continue
+		}
+
+		if p.feesCents[i] > 0 {
+			transaction := model.Transaction{ID: transactionIDs[k], TransactionState: transactionStates[k]}
+
+			fees = append(fees, transaction.Fee(p.feesCents[i], p.kinds[i]))
+		}
+
+		k++
+	}
+
+	return fees
+}
+
+// plan finds the account, converts the amounts into the account currency and checks the balance covers them.
+func (tb *transactionBulk) plan(ctx context.Context, input TransactionBulkInput, amountsCents []model.Cents) (*transferBulkPlan, error) {
+	accountState := model.BankAccountState{
+		OrganizationName: input.OrganizationName,
+		Iban:             input.OrganizationIban,
+		Bic:              input.OrganizationBic,
+	}
+
+	account, err := tb.accountFinder.Find(ctx, accountState)
+	if err != nil {
+		return nil, err
+	}
+
+	var p transferBulkPlan
+
+	p.receivers, err = tb.lockReceivers(ctx, account, input.CreditTransfers)
+	if err != nil {
+		return nil, err
+	}
+
+	p.debitedCents, p.rates, err = tb.debitedAmounts(ctx, account.Currency, input.CreditTransfers, amountsCents)
+	if err != nil {
+		return nil, err
+	}
+
+	p.creditedCents, p.creditRates, err = tb.creditedAmounts(ctx, p.receivers, input.CreditTransfers, amountsCents)
+	if err != nil {
+		return nil, err
+	}
+
+	p.feesCents, p.kinds, err = tb.transferFees(ctx, *account, input.CreditTransfers, amountsCents, p.debitedCents, p.receivers)
+	if err != nil {
+		return nil, err
+	}
+
+	// the balance covers the credit transfers together with their fees.
+	chargedCents := make([]model.Cents, len(p.debitedCents))
+
+	for i, debitedCents := range p.debitedCents {
+		chargedCents[i] = debitedCents + p.feesCents[i]
+	}
+
+	p.account, p.executed, p.rejections, err = tb.executableTransfers(ctx, input.Mode, accountState, chargedCents)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, ok := range p.executed {
+		if ok {
+			p.total += p.debitedCents[i]
+			p.fees += p.feesCents[i]
+		}
+	}
+
+	if p.total > 0 {
+		err = tb.checkLimits(ctx, *p.account, executedAmounts(p.debitedCents, p.executed))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &p, nil
+}
+
+// checkLimits checks the credit transfers executed do not breach the limits of the account, nor of its organization.
+//
+// The account is locked by the caller, the usage of its limits does not change until the transaction ends. The usage
+// of the organization limits sums the debits of the other accounts of the organization too, it is locked before it is
+// read so that the transfer bulks of the sibling accounts are checked one at a time. Returns a
+// *model.TransferLimitError of the first limit breached.
+func (tb *transactionBulk) checkLimits(ctx context.Context, account model.BankAccount, debitedCents []model.Cents) error {
+	limits, err := tb.limitFinder.FindForAccount(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	now := tb.clock.Now()
+	organizationLocked := false
+
+	for _, limit := range limits {
+		if limit.BankAccountID == 0 && !organizationLocked {
+			if err := tb.usageFinder.LockOrganizationUsage(ctx, limit.OrganizationName); err != nil {
+				return err
+			}
+
+			organizationLocked = true
+		}
+
+		usage, err := tb.usageFinder.OutgoingUsage(ctx, limit, now)
 		if err != nil {
 			return err
 		}
 
-		tb.logger.Debug(ctx, "balance from account didactic")
+		err = limit.Check(debitedCents, *usage)
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "failed to check transfer limits", "transfer_limit_id", limit.ID)
+		}
+	}
 
-		return nil
+	return nil
+}
+
+// transferFees calculates the fees of the credit transfers, in the bank account currency.
+//
+// Returns the fees and the kinds of the credit transfers, in the same order as the credit transfers.
+func (tb *transactionBulk) transferFees(
+	ctx context.Context,
+	account model.BankAccount,
+	transfers []TransactionBulkTransferInput,
+	amountsCents []model.Cents,
+	debitedCents []model.Cents,
+	receivers []*model.BankAccount,
+) ([]model.Cents, []model.TransferKind, error) {
+	feesCents := make([]model.Cents, len(transfers))
+	kinds := make([]model.TransferKind, len(transfers))
+
+	for i, transfer := range transfers {
+		kinds[i] = model.CreditTransferKind(transfer.CounterpartyIban, transfer.Currency, receivers[i] != nil, transfer.Instant)
+
+		feeCents, err := tb.fees.Fee(ctx, FeeInput{
+			BankAccount:  account,
+			Kind:         kinds[i],
+			AmountCents:  amountsCents[i],
+			Currency:     transfer.Currency,
+			DebitedCents: debitedCents[i],
+		})
+		if err != nil {
+			return nil, nil, ctxd.WrapError(ctx, err, "failed to calculate transfer fee", "credit_transfer", i)
+		}
+
+		feesCents[i] = feeCents
+	}
+
+	return feesCents, kinds, nil
+}
+
+// lockReceivers finds the bank accounts of the service the credit transfers are sent to, and locks them together with
+// the debited account.
+//
+// The accounts are locked in the order of their ids, so that transfer bulks between the same accounts in opposite
+// directions do not deadlock. Returns the receiver by credit transfer, nil for the credit transfers to other banks.
+func (tb *transactionBulk) lockReceivers(
+	ctx context.Context,
+	account *model.BankAccount,
+	transfers []TransactionBulkTransferInput,
+) ([]*model.BankAccount, error) {
+	receivers := make([]*model.BankAccount, len(transfers))
+
+	ibans := make([]string, 0, len(transfers))
+	found := make(map[string]bool)
+
+	for _, transfer := range transfers {
+		// a credit transfer to the debited account itself is sent as to another bank.
+		if transfer.CounterpartyIban == account.Iban || found[transfer.CounterpartyIban] {
+			continue
+		}
+
+		found[transfer.CounterpartyIban] = true
+
+		ibans = append(ibans, transfer.CounterpartyIban)
+	}
+
+	if len(ibans) == 0 {
+		return receivers, nil
+	}
+
+	accounts, err := tb.ibansFinder.FindByIbans(ctx, ibans)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accounts) == 0 {
+		return receivers, nil
+	}
+
+	ids := make([]model.BankAccountID, 0, len(accounts)+1)
+	ids = append(ids, account.ID)
+
+	for _, a := range accounts {
+		ids = append(ids, a.ID)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	locked := make(map[model.BankAccountID]*model.BankAccount, len(ids))
+
+	for _, id := range ids {
+		a, err := tb.locker.Lock(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		locked[id] = a
+	}
+
+	byIban := make(map[string]*model.BankAccount, len(accounts))
+
+	for _, a := range accounts {
+		byIban[a.Iban] = locked[a.ID]
+	}
+
+	for i, transfer := range transfers {
+		if transfer.CounterpartyIban != account.Iban {
+			receivers[i] = byIban[transfer.CounterpartyIban]
+		}
+	}
+
+	tb.logger.Debug(ctx, "internal credit transfers receivers locked", "receivers", len(accounts))
+
+	return receivers, nil
+}
+
+// creditReceivers credits the receivers the internal credit transfers, locked by the caller.
+func (tb *transactionBulk) creditReceivers(
+	ctx context.Context,
+	receivers []*model.BankAccount,
+	credits []model.TransactionState,
+) error {
+	creditedCents := make(map[model.BankAccountID]model.Cents)
+
+	for _, credit := range credits {
+		creditedCents[credit.BankAccountID] -= credit.DebitedCents
+	}
+
+	for _, receiver := range receivers {
+		if receiver == nil {
+			continue
+		}
+
+		amountCents, ok := creditedCents[receiver.ID]
+		if !ok {
+			continue
+		}
+
+		// the receiver is credited once, whatever the number of credit transfers it receives.
+		delete(creditedCents, receiver.ID)
+
+		err := tb.updater.BalanceAdd(ctx, receiver.ID, amountCents)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// quote runs the account lookup and the balance check in a transaction always rolled back, the transfer bulk is not
+// stored and the transfers are not performed.
+func (tb *transactionBulk) quote(ctx context.Context, input TransactionBulkInput, amountsCents []model.Cents) (*TransactionBulkOutput, error) {
+	var output TransactionBulkOutput
+
+	err := tb.storage.InTx(ctx, func(ctx context.Context) error {
+		plan, err := tb.plan(ctx, input, amountsCents)
+		if err != nil {
+			return err
+		}
+
+		rows := make([]model.TransferBulkRow, len(plan.executed))
+
+		for i, ok := range plan.executed {
+			if ok {
+				rows[i].Status = model.TransferBulkRowExecutable
+			}
+		}
+
+		for _, rejection := range plan.rejections {
+			rows[rejection.Row] = model.TransferBulkRow{
+				Status: model.TransferBulkRowRejected,
+				Reason: rejection.Reason,
+			}
+		}
+
+		output = TransactionBulkOutput{
+			Rows:         rows,
+			DebitedCents: plan.total,
+			FeesCents:    plan.fees,
+			BalanceCents: plan.account.BalanceCents - plan.total - plan.fees,
+			Currency:     plan.account.Currency,
+		}
+
+		tb.logger.Debug(ctx, "transfer bulk quoted", "credit_transfers_total", plan.total, "fees_total", plan.fees)
+
+		return errQuoteRollback
 	})
+	if err != nil && !errors.Is(err, errQuoteRollback) {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// debitedAmounts converts the amounts of the credit transfers into the bank account currency.
+//
+// Returns the debited amounts and the exchange rates used, in the same order as the credit transfers. A credit transfer
+// in a currency without exchange rate into the bank account currency is reported as a field violation.
+func (tb *transactionBulk) debitedAmounts(
+	ctx context.Context,
+	currency string,
+	transfers []TransactionBulkTransferInput,
+	amountsCents []model.Cents,
+) ([]model.Cents, []string, error) {
+	var verr ValidationError
+
+	debitedCents := make([]model.Cents, len(transfers))
+	rates := make([]string, len(transfers))
+
+	// the same rate is used for all the credit transfers in the same currency.
+	rateByCurrency := make(map[string]string)
+
+	for i, transfer := range transfers {
+		if transfer.Currency == currency {
+			debitedCents[i] = amountsCents[i]
+			rates[i] = model.NoExchangeRate
+
+			continue
+		}
+
+		rate, ok := rateByCurrency[transfer.Currency]
+		if !ok {
+			var err error
+
+			rate, err = tb.rates.Rate(ctx, transfer.Currency, currency)
+			if err != nil {
+				if errors.Is(err, ErrRateNotFound) {
+					verr.add(fmt.Sprintf("credit_transfers[%d].currency", i), err)
+
+					continue
+				}
+
+				return nil, nil, ctxd.WrapError(ctx, err, "failed to get exchange rate",
+					"from", transfer.Currency,
+					"to", currency,
+				)
+			}
+
+			rateByCurrency[transfer.Currency] = rate
+		}
+
+		amountCents, err := model.ConvertCents(amountsCents[i], transfer.Currency, currency, rate)
+		if err != nil {
+			return nil, nil, ctxd.WrapError(ctx, err, "failed to convert transfer amount", "credit_transfer", i)
+		}
+
+		debitedCents[i] = amountCents
+		rates[i] = rate
+	}
+
+	if len(verr.Violations) > 0 {
+		return nil, nil, &verr
+	}
+
+	return debitedCents, rates, nil
+}
+
+// creditedAmounts converts the amounts of the internal credit transfers into the receiver currency.
+//
+// Returns the credited amounts and the exchange rates used, by credit transfer, zero values for the credit transfers to
+// other banks. A credit transfer in a currency without exchange rate into the receiver currency is reported as a
+// field violation.
+func (tb *transactionBulk) creditedAmounts(
+	ctx context.Context,
+	receivers []*model.BankAccount,
+	transfers []TransactionBulkTransferInput,
+	amountsCents []model.Cents,
+) ([]model.Cents, []string, error) {
+	var verr ValidationError
+
+	creditedCents := make([]model.Cents, len(transfers))
+	rates := make([]string, len(transfers))
+
+	for i, transfer := range transfers {
+		receiver := receivers[i]
+
+		if receiver == nil {
+			continue
+		}
+
+		if transfer.Currency == receiver.Currency {
+			creditedCents[i] = amountsCents[i]
+			rates[i] = model.NoExchangeRate
+
+			continue
+		}
+
+		rate, err := tb.rates.Rate(ctx, transfer.Currency, receiver.Currency)
+		if err != nil {
+			if errors.Is(err, ErrRateNotFound) {
+				verr.add(fmt.Sprintf("credit_transfers[%d].currency", i), err)
+
+				continue
+			}
+
+			return nil, nil, ctxd.WrapError(ctx, err, "failed to get exchange rate",
+				"from", transfer.Currency,
+				"to", receiver.Currency,
+			)
+		}
+
+		amountCents, err := model.ConvertCents(amountsCents[i], transfer.Currency, receiver.Currency, rate)
+		if err != nil {
+			return nil, nil, ctxd.WrapError(ctx, err, "failed to convert transfer amount", "credit_transfer", i)
+		}
+
+		creditedCents[i] = amountCents
+		rates[i] = rate
+	}
+
+	if len(verr.Violations) > 0 {
+		return nil, nil, &verr
+	}
+
+	return creditedCents, rates, nil
+}
+
+// executableTransfers checks the account balance covers the credit transfers and locks the account.
+//
+// In atomic mode, all the credit transfers are executed or the account does not have enough balance. In partial mode,
+// the credit transfers are executed in order while the remaining balance covers them, the others are rejected.
+func (tb *transactionBulk) executableTransfers(
+	ctx context.Context,
+	mode model.TransferBulkMode,
+	accountState model.BankAccountState,
+	debitedCents []model.Cents,
+) (*model.BankAccount, []bool, model.TransferBulkRejections, error) {
+	executed := make([]bool, len(debitedCents))
+
+	if mode != model.TransferBulkPartial {
+		var total model.Cents
+
+		for i, amountCents := range debitedCents {
+			total += amountCents
+			executed[i] = true
+		}
+
+		tb.logger.Debug(ctx, "checking account has enough balance", "amount_cents", total)
+
+		account, err := tb.checker.BalanceCheck(ctx, accountState, total)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		tb.logger.Debug(ctx, "account has enough balance")
+
+		return account, executed, nil, nil
+	}
+
+	// the account is locked until the transfers are performed, any balance covers no amount.
+	account, err := tb.checker.BalanceCheck(ctx, accountState, 0)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var rejections model.TransferBulkRejections
+
+	// remaining is the account once debited the credit transfers executed so far.
+	remaining := account.BankAccountState
+
+	for i, amountCents := range debitedCents {
+		switch remaining.Shortfall(amountCents) {
+		case model.BalanceExceeded:
+			rejections = append(rejections, model.TransferBulkRejection{Row: i, Reason: reasonNotEnoughBalance})
+
+			continue
+		case model.OverdraftExceeded:
+			rejections = append(rejections, model.TransferBulkRejection{Row: i, Reason: reasonOverdraftExceeded})
+
+			continue
+		case model.NoShortfall:
+		}
+
+		remaining.BalanceCents -= amountCents
+		executed[i] = true
+	}
+
+	tb.logger.Debug(ctx, "account balance covers part of the credit transfers", "rejected", len(rejections))
+
+	return account, executed, rejections, nil
+}
+
+// executedAmounts returns the amounts of the executed credit transfers.
+func executedAmounts(amountsCents []model.Cents, executed []bool) []model.Cents {
+	amounts := make([]model.Cents, 0, len(amountsCents))
+
+	for i, amountCents := range amountsCents {
+		if executed[i] {
+			amounts = append(amounts, amountCents)
+		}
+	}
+
+	return amounts
+}
+
+// transferBulkPostings returns the postings of the transfer bulk, the bank account is debited the total amount and
+// each transfer is credited to the outgoing transfers.
+func transferBulkPostings(
+	account *model.BankAccount,
+	transactionIDs []model.TransactionID,
+	debitedCents []model.Cents,
+	total model.Cents,
+) []model.PostingState {
+	postings := make([]model.PostingState, 0, len(transactionIDs)+1)
+
+	postings = append(postings, model.PostingState{
+		LedgerAccount: model.LedgerAccountBankAccount,
+		BankAccountID: account.ID,
+		AmountCents:   -total,
+		Currency:      account.Currency,
+	})
+
+	for i, id := range transactionIDs {
+		postings = append(postings, model.PostingState{
+			LedgerAccount: model.LedgerAccountOutgoingTransfers,
+			TransactionID: id,
+			AmountCents:   debitedCents[i],
+			Currency:      account.Currency,
+		})
+	}
+
+	return postings
+}
+
+// feePostings returns the postings of the fees, the bank account is debited the total of the fees and each fee is
+// credited to the fee income.
+func feePostings(account *model.BankAccount, fees []model.TransactionState, transactionIDs []model.TransactionID) []model.PostingState {
+	postings := make([]model.PostingState, 0, len(fees)+1)
+
+	var total model.Cents
+
+	for _, fee := range fees {
+		total += fee.DebitedCents
+	}
+
+	postings = append(postings, model.PostingState{
+		LedgerAccount: model.LedgerAccountBankAccount,
+		BankAccountID: account.ID,
+		AmountCents:   -total,
+		Currency:      account.Currency,
+	})
+
+	for i, fee := range fees {
+		postings = append(postings, model.PostingState{
+			LedgerAccount: model.LedgerAccountFeeIncome,
+			TransactionID: transactionIDs[i],
+			AmountCents:   fee.DebitedCents,
+			Currency:      account.Currency,
+		})
+	}
+
+	return postings
+}
+
+// internalCreditPostings returns the postings of the internal credit transfers, each receiver bank account is credited
+// from the incoming transfers.
+func internalCreditPostings(credits []model.TransactionState, transactionIDs []model.TransactionID) []model.PostingState {
+	postings := make([]model.PostingState, 0, 2*len(credits))
+
+	for i, credit := range credits {
+		postings = append(postings,
+			model.PostingState{
+				LedgerAccount: model.LedgerAccountBankAccount,
+				BankAccountID: credit.BankAccountID,
+				AmountCents:   -credit.DebitedCents,
+				Currency:      credit.DebitedCurrency,
+			},
+			model.PostingState{
+				LedgerAccount: model.LedgerAccountIncomingTransfers,
+				TransactionID: transactionIDs[i],
+				AmountCents:   credit.DebitedCents,
+				Currency:      credit.DebitedCurrency,
+			},
+		)
+	}
+
+	return postings
+}
+
+// hashRequest fingerprints the request to detect an idempotency key reused with a different request.
+func hashRequest(input TransactionBulkInput) (string, error) {
+	input.IdempotencyKey = ""
+
+	// atomic is the default mode, the hash of the requests stored before the modes were introduced does not change.
+	if input.Mode == model.TransferBulkAtomic {
+		input.Mode = ""
+	}
+
+	b, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
 
-	return err
+	return hex.EncodeToString(sum[:]), nil
 }
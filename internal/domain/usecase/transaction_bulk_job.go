@@ -0,0 +1,460 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/nhatthm/go-clock"
+)
+
+// ErrTransferBulkJobNotScheduled error represents when the transfer bulk job to cancel is no longer scheduled.
+var ErrTransferBulkJobNotScheduled = errors.New("transfer bulk job is not scheduled")
+
+// executionDateLayout is the layout of the requested execution date.
+const executionDateLayout = "2006-01-02"
+
+// TransactionBulkJob defines the functionality of the use case TransactionBulkJob used to process a transaction in
+// bulk asynchronously.
+type TransactionBulkJob interface {
+	// Enqueue validates the input and accepts it as a job processed asynchronously.
+	//
+	// The job is scheduled when the input has a requested execution date, it is processed on that date.
+	//
+	// Replaying the input with the same idempotency key returns the job accepted the first time.
+	Enqueue(ctx context.Context, input TransactionBulkInput) (*model.TransferBulkJob, error)
+	// Job returns the job with its status and outcome.
+	Job(ctx context.Context, id model.TransferBulkJobID) (*model.TransferBulkJob, error)
+	// ListScheduled lists the scheduled jobs of the bank account, all of them when the iban is empty.
+	ListScheduled(ctx context.Context, organizationIban string) ([]model.TransferBulkJob, error)
+	// Cancel cancels the scheduled job, its transfers are not performed.
+	//
+	// Cancelling a cancelled job returns the job as is.
+	Cancel(ctx context.Context, id model.TransferBulkJobID) (*model.TransferBulkJob, error)
+	// ProcessNext processes the oldest pending job.
+	//
+	// Returns false when there is no job to process.
+	ProcessNext(ctx context.Context) (bool, error)
+}
+
+// TransferBulkJobEnqueuer is a storage interface that defines the functionality to enqueue the transfer bulk job.
+type TransferBulkJobEnqueuer interface {
+	// Enqueue adds the transfer bulk job into a storage.
+	//
+	// Returns the stored transfer bulk job and false when the idempotency key was already used.
+	Enqueue(ctx context.Context, jobState model.TransferBulkJobState) (*model.TransferBulkJob, bool, error)
+}
+
+// TransferBulkJobFinder is a storage interface that defines the functionality to find the transfer bulk job.
+type TransferBulkJobFinder interface {
+	// Find finds the transfer bulk job from a storage.
+	Find(ctx context.Context, id model.TransferBulkJobID) (*model.TransferBulkJob, error)
+}
+
+// TransferBulkJobLister is a storage interface that defines the functionality to list the scheduled transfer bulk jobs.
+type TransferBulkJobLister interface {
+	// ListScheduled lists the scheduled transfer bulk jobs of the bank account from a storage, by execution date.
+	ListScheduled(ctx context.Context, organizationIban string) ([]model.TransferBulkJob, error)
+}
+
+// TransferBulkJobCanceller is a storage interface that defines the functionality to cancel the scheduled transfer bulk
+// job.
+type TransferBulkJobCanceller interface {
+	// Cancel marks the scheduled transfer bulk job as cancelled in a storage.
+	//
+	// Returns false when the job is not scheduled.
+	Cancel(ctx context.Context, id model.TransferBulkJobID, now time.Time) (bool, error)
+}
+
+// TransferBulkJobClaimer is a storage interface that defines the functionality to claim the transfer bulk job to process.
+type TransferBulkJobClaimer interface {
+	// Claim marks the oldest pending job, scheduled job which execution date is reached, or processing job not updated
+	// since staleBefore, as processing in a storage.
+	//
+	// Returns nil when there is no job to process.
+	Claim(ctx context.Context, now, staleBefore time.Time) (*model.TransferBulkJob, error)
+}
+
+// TransferBulkJobFinisher is a storage interface that defines the functionality to store the transfer bulk job outcome.
+type TransferBulkJobFinisher interface {
+	// Finish stores the status and the outcome of the processed job into a storage.
+	Finish(ctx context.Context, job model.TransferBulkJob) error
+}
+
+type transactionBulkJob struct {
+	logger          ctxd.Logger
+	storage         *sqluct.Storage
+	clock           clock.Clock
+	timeout         time.Duration
+	transactionBulk TransactionBulk
+	enqueuer        TransferBulkJobEnqueuer
+	finder          TransferBulkJobFinder
+	claimer         TransferBulkJobClaimer
+	finisher        TransferBulkJobFinisher
+	lister          TransferBulkJobLister
+	canceller       TransferBulkJobCanceller
+}
+
+var _ TransactionBulkJob = new(transactionBulkJob)
+
+// NewTransactionBulkJob creates an instance of TransactionBulkJob use case.
+//
+// A job still processing after the timeout, e.g. because the worker stopped, is claimed again. Jobs perform their
+// transfers with an idempotency key of their own, processing a job twice does not perform its transfers twice.
+//
+// Scheduled jobs are processed from their execution date on, the date of the clock in UTC.
+func NewTransactionBulkJob(
+	logger ctxd.Logger,
+	storage *sqluct.Storage,
+	clock clock.Clock,
+	timeout time.Duration,
+	transactionBulk TransactionBulk,
+	enqueuer TransferBulkJobEnqueuer,
+	finder TransferBulkJobFinder,
+	claimer TransferBulkJobClaimer,
+	finisher TransferBulkJobFinisher,
+	lister TransferBulkJobLister,
+	canceller TransferBulkJobCanceller,
+) TransactionBulkJob {
+	return &transactionBulkJob{
+		logger:          logger,
+		storage:         storage,
+		clock:           clock,
+		timeout:         timeout,
+		transactionBulk: transactionBulk,
+		enqueuer:        enqueuer,
+		finder:          finder,
+		claimer:         claimer,
+		finisher:        finisher,
+		lister:          lister,
+		canceller:       canceller,
+	}
+}
+
+// Enqueue validates the input and accepts it as a job processed asynchronously.
+func (tbj *transactionBulkJob) Enqueue(ctx context.Context, input TransactionBulkInput) (*model.TransferBulkJob, error) {
+	ctx = ctxd.AddFields(
+		ctx,
+		"organization_name", input.OrganizationName,
+		"organization_bic", input.OrganizationBic,
+		"organization_iban", input.OrganizationIban,
+		"idempotency_key", input.IdempotencyKey,
+	)
+
+	now := tbj.clock.Now()
+
+	executionDate, dateErr := parseExecutionDate(input.RequestedExecutionDate, now)
+
+	if _, err := ValidateTransactionBulkInput(input); err != nil || dateErr != nil {
+		verr := &ValidationError{}
+		errors.As(err, &verr)
+
+		if dateErr != nil {
+			verr.add("requested_execution_date", dateErr)
+		}
+
+		return nil, ctxd.WrapError(ctx, verr, "failed to validate input")
+	}
+
+	requestHash, err := hashRequest(input)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to hash request")
+	}
+
+	request, err := json.Marshal(input)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to encode request")
+	}
+
+	status := model.TransferBulkJobPending
+	if executionDate != nil {
+		status = model.TransferBulkJobScheduled
+	}
+
+	job, enqueued, err := tbj.enqueuer.Enqueue(ctx, model.TransferBulkJobState{
+		IdempotencyKey:   input.IdempotencyKey,
+		RequestHash:      requestHash,
+		Status:           status,
+		Request:          string(request),
+		TotalRows:        len(input.CreditTransfers),
+		OrganizationIban: input.OrganizationIban,
+		ExecutionDate:    executionDate,
+		SubmittedBy:      input.SubmittedBy,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !enqueued {
+		if job.RequestHash != requestHash {
+			return nil, ctxd.WrapError(ctx, ErrIdempotencyKeyMismatch, "failed to enqueue transfer bulk job",
+				"transfer_bulk_job_id", job.ID,
+			)
+		}
+
+		tbj.logger.Debug(ctx, "transfer bulk job already enqueued", "transfer_bulk_job_id", job.ID)
+
+		return job, nil
+	}
+
+	tbj.logger.Debug(ctx, "transfer bulk job enqueued", "transfer_bulk_job_id", job.ID, "status", job.Status)
+
+	return job, nil
+}
+
+// Job returns the job with its status and outcome.
+func (tbj *transactionBulkJob) Job(ctx context.Context, id model.TransferBulkJobID) (*model.TransferBulkJob, error) {
+	return tbj.finder.Find(ctx, id)
+}
+
+// ListScheduled lists the scheduled jobs of the bank account, all of them when the iban is empty.
+func (tbj *transactionBulkJob) ListScheduled(ctx context.Context, organizationIban string) ([]model.TransferBulkJob, error) {
+	return tbj.lister.ListScheduled(ctx, organizationIban)
+}
+
+// Cancel cancels the scheduled job, its transfers are not performed.
+//
+// Returns ErrTransferBulkJobNotScheduled when the job is already claimed to be processed.
+func (tbj *transactionBulkJob) Cancel(ctx context.Context, id model.TransferBulkJobID) (*model.TransferBulkJob, error) {
+	ctx = ctxd.AddFields(ctx, "transfer_bulk_job_id", id)
+
+	cancelled, err := tbj.canceller.Cancel(ctx, id, tbj.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := tbj.finder.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cancelled && job.Status != model.TransferBulkJobCancelled {
+		return nil, ctxd.WrapError(ctx, ErrTransferBulkJobNotScheduled, "failed to cancel transfer bulk job",
+			"status", job.Status,
+		)
+	}
+
+	tbj.logger.Debug(ctx, "transfer bulk job cancelled")
+
+	return job, nil
+}
+
+// ProcessNext processes the oldest pending job.
+//
+// The job fails when its transfers are not valid or are refused, e.g. the balance does not cover them, the reason is
+// stored with the job. An error is returned when the transfers could not be performed for a transient failure, e.g. a
+// lost connection, a serialization failure or a deadlock, or when the job outcome can not be stored, the job is then
+// left processing and processed again once timed out, its transfers not performed twice.
+func (tbj *transactionBulkJob) ProcessNext(ctx context.Context) (bool, error) {
+	var job *model.TransferBulkJob
+
+	err := tbj.storage.InTx(ctx, func(ctx context.Context) error {
+		now := tbj.clock.Now()
+
+		var err error
+
+		job, err = tbj.claimer.Claim(ctx, now, now.Add(-tbj.timeout))
+
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if job == nil {
+		return false, nil
+	}
+
+	ctx = ctxd.AddFields(ctx, "transfer_bulk_job_id", job.ID)
+
+	tbj.logger.Debug(ctx, "processing transfer bulk job")
+
+	var input TransactionBulkInput
+
+	if err := json.Unmarshal([]byte(job.Request), &input); err != nil {
+		tbj.fail(job, err)
+	} else {
+		input.IdempotencyKey = jobIdempotencyKey(job.ID)
+		// the submitter is not part of the request, the transfer bulk is submitted on behalf of the job submitter.
+		input.SubmittedBy = job.SubmittedBy
+
+		output, err := tbj.transactionBulk.TransactionBulk(ctx, input)
+
+		switch {
+		case err == nil:
+			tbj.complete(job, output)
+		case transientError(err):
+			return true, ctxd.WrapError(ctx, err, "failed to process transfer bulk job, processed again once timed out")
+		default:
+			tbj.fail(job, err)
+		}
+	}
+
+	job.UpdatedAt = tbj.clock.Now()
+
+	if err := tbj.finisher.Finish(ctx, *job); err != nil {
+		return true, err
+	}
+
+	tbj.logger.Debug(ctx, "transfer bulk job processed", "status", job.Status, "error", job.Error)
+
+	return true, nil
+}
+
+// complete sets the job as completed with the outcome of the transfers, rejected rows of a partial bulk are failed.
+//
+// The job of a transfer bulk awaiting approval is completed too, its rows await approval.
+func (tbj *transactionBulkJob) complete(job *model.TransferBulkJob, output *TransactionBulkOutput) {
+	job.Status = model.TransferBulkJobCompleted
+	job.ProcessedRows = len(output.Rows)
+	job.Rows = make(model.TransferBulkJobRows, len(output.Rows))
+	job.TransferBulkID = output.TransferBulkID
+	job.DebitedCents = output.DebitedCents
+	job.BalanceCents = output.BalanceCents
+	job.Currency = output.Currency
+	job.FeesCents = output.FeesCents
+	job.Error = ""
+
+	for i, row := range output.Rows {
+		if row.Status == model.TransferBulkRowRejected {
+			job.Rows[i] = model.TransferBulkJobRow{
+				Status: model.TransferBulkJobRowFailed,
+				Error:  row.Reason,
+			}
+
+			continue
+		}
+
+		if row.Status == model.TransferBulkRowAwaitingApproval {
+			job.Rows[i] = model.TransferBulkJobRow{
+				Status: model.TransferBulkJobRowAwaitingApproval,
+			}
+
+			continue
+		}
+
+		if row.Status == model.TransferBulkRowHeldForReview {
+			job.Rows[i] = model.TransferBulkJobRow{
+				Status: model.TransferBulkJobRowHeldForReview,
+				Error:  row.Reason,
+			}
+
+			continue
+		}
+
+		job.Rows[i] = model.TransferBulkJobRow{
+			Status:        model.TransferBulkJobRowCompleted,
+			TransactionID: row.TransactionID,
+		}
+	}
+}
+
+// fail sets the job as failed, none of its transfers is performed.
+//
+// The field violations of the credit transfers are reported on their rows.
+func (tbj *transactionBulkJob) fail(job *model.TransferBulkJob, err error) {
+	job.Status = model.TransferBulkJobFailed
+	job.ProcessedRows = job.TotalRows
+	job.Rows = make(model.TransferBulkJobRows, job.TotalRows)
+	job.Error = failureReason(err)
+
+	rowErrors := make([][]string, job.TotalRows)
+
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		job.Error = verr.Error()
+
+		for _, v := range verr.Violations {
+			var i int
+
+			if _, err := fmt.Sscanf(v.Field, "credit_transfers[%d].", &i); err != nil || i < 0 || i >= job.TotalRows {
+				continue
+			}
+
+			field := v.Field[strings.Index(v.Field, ".")+1:]
+			rowErrors[i] = append(rowErrors[i], field+": "+v.Description)
+		}
+	}
+
+	for i := range job.Rows {
+		job.Rows[i] = model.TransferBulkJobRow{
+			Status: model.TransferBulkJobRowFailed,
+			Error:  strings.Join(rowErrors[i], "; "),
+		}
+	}
+}
+
+// parseExecutionDate returns the requested execution date, nil when empty.
+//
+// The date must not be before the UTC date of now.
+func parseExecutionDate(date string, now time.Time) (*time.Time, error) {
+	if date == "" {
+		return nil, nil
+	}
+
+	executionDate, err := time.Parse(executionDateLayout, date)
+	if err != nil {
+		return nil, errors.New("must be a date formatted as YYYY-MM-DD")
+	}
+
+	if executionDate.Before(today(now)) {
+		return nil, errors.New("must not be in the past")
+	}
+
+	return &executionDate, nil
+}
+
+// jobIdempotencyKey returns the idempotency key used to perform the transfers of the job.
+func jobIdempotencyKey(id model.TransferBulkJobID) string {
+	return fmt.Sprintf("transfer-bulk-job/%d", id)
+}
+
+// transientError tells whether the error is a failure of the infrastructure rather than of the transfers, e.g. a lost
+// connection, a serialization failure or a deadlock, performing the transfers again may succeed.
+func transientError(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	// the database errors tell their SQLSTATE code.
+	var dbErr interface{ SQLState() string }
+	if errors.As(err, &dbErr) {
+		code := dbErr.SQLState()
+
+		// connection exception, transaction rollback, insufficient resources or operator intervention classes.
+		return strings.HasPrefix(code, "08") || strings.HasPrefix(code, "40") || strings.HasPrefix(code, "53") ||
+			strings.HasPrefix(code, "57")
+	}
+
+	return false
+}
+
+// failureReason returns the message of the error cause, without the messages of the layers it went through.
+func failureReason(err error) string {
+	for {
+		cause := errors.Unwrap(err)
+		if cause == nil {
+			return err.Error()
+		}
+
+		err = cause
+	}
+}
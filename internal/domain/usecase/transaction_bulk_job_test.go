@@ -0,0 +1,699 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type transactionBulkMock struct {
+	t              *testing.T
+	idempotencyKey string
+	submittedBy    string
+
+	output *usecase.TransactionBulkOutput
+	err    error
+}
+
+func (tbm *transactionBulkMock) TransactionBulk(_ context.Context, input usecase.TransactionBulkInput) (*usecase.TransactionBulkOutput, error) {
+	assert.Equal(tbm.t, tbm.idempotencyKey, input.IdempotencyKey, "TransactionBulk() got idempotencyKey arg = %v, expected %v", input.IdempotencyKey, tbm.idempotencyKey)
+	assert.Equal(tbm.t, tbm.submittedBy, input.SubmittedBy, "TransactionBulk() got submittedBy arg = %v, expected %v", input.SubmittedBy, tbm.submittedBy)
+
+	return tbm.output, tbm.err
+}
+
+// transferBulkJobStorageMock mocks the transfer bulk job storage, the finished job is kept to be asserted.
+type transferBulkJobStorageMock struct {
+	stored    *model.TransferBulkJob
+	claimed   *model.TransferBulkJob
+	finished  *model.TransferBulkJob
+	cancelled bool
+	err       error
+}
+
+func (tbjsm *transferBulkJobStorageMock) Enqueue(_ context.Context, jobState model.TransferBulkJobState) (*model.TransferBulkJob, bool, error) {
+	if tbjsm.err != nil {
+		return nil, false, tbjsm.err
+	}
+
+	if tbjsm.stored != nil {
+		return tbjsm.stored, false, nil
+	}
+
+	return &model.TransferBulkJob{ID: 1, TransferBulkJobState: jobState}, true, nil
+}
+
+func (tbjsm *transferBulkJobStorageMock) Find(_ context.Context, _ model.TransferBulkJobID) (*model.TransferBulkJob, error) {
+	return tbjsm.stored, tbjsm.err
+}
+
+func (tbjsm *transferBulkJobStorageMock) Claim(_ context.Context, _, _ time.Time) (*model.TransferBulkJob, error) {
+	if tbjsm.claimed == nil {
+		return nil, tbjsm.err
+	}
+
+	// the claimed job is shared by the test cases.
+	job := *tbjsm.claimed
+
+	return &job, nil
+}
+
+func (tbjsm *transferBulkJobStorageMock) ListScheduled(_ context.Context, _ string) ([]model.TransferBulkJob, error) {
+	if tbjsm.stored == nil {
+		return nil, tbjsm.err
+	}
+
+	return []model.TransferBulkJob{*tbjsm.stored}, tbjsm.err
+}
+
+func (tbjsm *transferBulkJobStorageMock) Cancel(_ context.Context, _ model.TransferBulkJobID, _ time.Time) (bool, error) {
+	if tbjsm.err != nil {
+		return false, tbjsm.err
+	}
+
+	if tbjsm.cancelled {
+		tbjsm.stored.Status = model.TransferBulkJobCancelled
+	}
+
+	return tbjsm.cancelled, nil
+}
+
+func (tbjsm *transferBulkJobStorageMock) Finish(_ context.Context, job model.TransferBulkJob) error {
+	tbjsm.finished = &job
+
+	return nil
+}
+
+// sqlStateError mocks the database errors telling their SQLSTATE code.
+type sqlStateError string
+
+func (e sqlStateError) Error() string {
+	return "database error, SQLSTATE " + string(e)
+}
+
+func (e sqlStateError) SQLState() string {
+	return string(e)
+}
+
+func validTransactionBulkInput() usecase.TransactionBulkInput {
+	return usecase.TransactionBulkInput{
+		OrganizationName: "ACME Corp",
+		OrganizationIban: "FR10474608000002006107XXXXX",
+		OrganizationBic:  "OIVUSCLQXXX",
+		CreditTransfers: []usecase.TransactionBulkTransferInput{
+			{
+				Amount:           "14.50",
+				Currency:         "EUR",
+				CounterpartyName: "Bip Bip",
+				CounterpartyBic:  "CRLYFRPPTOU",
+				CounterpartyIban: "FR1420041010050500013M02606",
+				Description:      "Wonderland/4410",
+			},
+			{
+				Amount:           "10",
+				Currency:         "EUR",
+				CounterpartyName: "Wile E Coyote",
+				CounterpartyBic:  "CRLYFRPPTOU",
+				CounterpartyIban: "FR1420041010050500013M02606",
+				Description:      "//TeslaMotors/Invoice/12",
+			},
+		},
+		IdempotencyKey: "IdempotencyKey",
+	}
+}
+
+func Test_transactionBulkJob_Enqueue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 5, 9, 0, 0, 0, time.UTC)
+
+	invalidInput := validTransactionBulkInput()
+	invalidInput.CreditTransfers[1].CounterpartyIban = "FR00"
+
+	scheduledInput := validTransactionBulkInput()
+	scheduledInput.RequestedExecutionDate = "2021-12-10"
+
+	todayInput := validTransactionBulkInput()
+	todayInput.RequestedExecutionDate = "2021-12-05"
+
+	pastInput := validTransactionBulkInput()
+	pastInput.RequestedExecutionDate = "2021-12-04"
+
+	malformedDateInput := validTransactionBulkInput()
+	malformedDateInput.RequestedExecutionDate = "10/12/2021"
+
+	submittedInput := validTransactionBulkInput()
+	submittedInput.SubmittedBy = "alice"
+
+	tests := []struct {
+		name    string
+		input   usecase.TransactionBulkInput
+		storage *transferBulkJobStorageMock
+		// replay stores a job of the same request before enqueuing.
+		replay        bool
+		status        model.TransferBulkJobStatus
+		executionDate *time.Time
+		err           error
+	}{
+		{
+			name:    "job enqueued",
+			input:   validTransactionBulkInput(),
+			storage: &transferBulkJobStorageMock{},
+			status:  model.TransferBulkJobPending,
+		},
+		{
+			name:    "job enqueued on behalf of the submitter",
+			input:   submittedInput,
+			storage: &transferBulkJobStorageMock{},
+			status:  model.TransferBulkJobPending,
+		},
+		{
+			name:          "job scheduled",
+			input:         scheduledInput,
+			storage:       &transferBulkJobStorageMock{},
+			status:        model.TransferBulkJobScheduled,
+			executionDate: timePtr(time.Date(2021, 12, 10, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:          "job scheduled today",
+			input:         todayInput,
+			storage:       &transferBulkJobStorageMock{},
+			status:        model.TransferBulkJobScheduled,
+			executionDate: timePtr(time.Date(2021, 12, 5, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:    "requested execution date in the past",
+			input:   pastInput,
+			storage: &transferBulkJobStorageMock{},
+			err:     usecase.ErrInvalidInput,
+		},
+		{
+			name:    "requested execution date malformed",
+			input:   malformedDateInput,
+			storage: &transferBulkJobStorageMock{},
+			err:     usecase.ErrInvalidInput,
+		},
+		{
+			name:    "job already enqueued with the same request",
+			input:   validTransactionBulkInput(),
+			storage: &transferBulkJobStorageMock{},
+			replay:  true,
+			status:  model.TransferBulkJobCompleted,
+		},
+		{
+			name:  "idempotency key already used with a different request",
+			input: validTransactionBulkInput(),
+			storage: &transferBulkJobStorageMock{
+				stored: &model.TransferBulkJob{
+					ID: 1,
+					TransferBulkJobState: model.TransferBulkJobState{
+						RequestHash: "other",
+					},
+				},
+			},
+			err: usecase.ErrIdempotencyKeyMismatch,
+		},
+		{
+			name:    "invalid input",
+			input:   invalidInput,
+			storage: &transferBulkJobStorageMock{},
+			err:     usecase.ErrInvalidInput,
+		},
+		{
+			name:    "storage error",
+			input:   validTransactionBulkInput(),
+			storage: &transferBulkJobStorageMock{err: sql.ErrConnDone},
+			err:     sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tbj := usecase.NewTransactionBulkJob(
+				ctxd.NoOpLogger{},
+				nil,
+				clock.Fix(now),
+				time.Minute,
+				nil,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+			)
+
+			if tc.replay {
+				stored, err := tbj.Enqueue(context.Background(), tc.input)
+				require.NoError(t, err)
+
+				stored.Status = model.TransferBulkJobCompleted
+				tc.storage.stored = stored
+			}
+
+			got, err := tbj.Enqueue(context.Background(), tc.input)
+
+			assert.ErrorIsf(t, err, tc.err, "Enqueue() err got = %v, want %v", err, tc.err)
+
+			if tc.err != nil {
+				assert.Nil(t, got)
+
+				return
+			}
+
+			require.NotNil(t, got)
+			assert.Equal(t, tc.status, got.Status)
+
+			if !tc.replay {
+				var request usecase.TransactionBulkInput
+
+				require.NoError(t, json.Unmarshal([]byte(got.Request), &request))
+
+				// the submitter is kept by the job, not in the request.
+				want := tc.input
+				want.SubmittedBy = ""
+
+				assert.Equal(t, want, request)
+				assert.Equal(t, tc.input.SubmittedBy, got.SubmittedBy)
+				assert.Equal(t, len(tc.input.CreditTransfers), got.TotalRows)
+				assert.Equal(t, tc.input.OrganizationIban, got.OrganizationIban)
+				assert.Equal(t, tc.executionDate, got.ExecutionDate)
+				assert.Equal(t, now, got.CreatedAt)
+			}
+		})
+	}
+}
+
+func Test_transactionBulkJob_Cancel(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 5, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		storage *transferBulkJobStorageMock
+		status  model.TransferBulkJobStatus
+		err     error
+	}{
+		{
+			name: "job cancelled",
+			storage: &transferBulkJobStorageMock{
+				stored:    &model.TransferBulkJob{ID: 1, TransferBulkJobState: model.TransferBulkJobState{Status: model.TransferBulkJobScheduled}},
+				cancelled: true,
+			},
+			status: model.TransferBulkJobCancelled,
+		},
+		{
+			name: "job already cancelled",
+			storage: &transferBulkJobStorageMock{
+				stored: &model.TransferBulkJob{ID: 1, TransferBulkJobState: model.TransferBulkJobState{Status: model.TransferBulkJobCancelled}},
+			},
+			status: model.TransferBulkJobCancelled,
+		},
+		{
+			name: "job no longer scheduled",
+			storage: &transferBulkJobStorageMock{
+				stored: &model.TransferBulkJob{ID: 1, TransferBulkJobState: model.TransferBulkJobState{Status: model.TransferBulkJobProcessing}},
+			},
+			err: usecase.ErrTransferBulkJobNotScheduled,
+		},
+		{
+			name:    "storage error",
+			storage: &transferBulkJobStorageMock{err: sql.ErrConnDone},
+			err:     sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tbj := usecase.NewTransactionBulkJob(
+				ctxd.NoOpLogger{},
+				nil,
+				clock.Fix(now),
+				time.Minute,
+				nil,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+			)
+
+			got, err := tbj.Cancel(context.Background(), 1)
+
+			assert.ErrorIsf(t, err, tc.err, "Cancel() err got = %v, want %v", err, tc.err)
+
+			if tc.err != nil {
+				assert.Nil(t, got)
+
+				return
+			}
+
+			require.NotNil(t, got)
+			assert.Equal(t, tc.status, got.Status)
+		})
+	}
+}
+
+func Test_transactionBulkJob_ProcessNext(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 5, 9, 0, 0, 0, time.UTC)
+
+	request, err := json.Marshal(validTransactionBulkInput())
+	require.NoError(t, err)
+
+	claimed := model.TransferBulkJob{
+		ID: 7,
+		TransferBulkJobState: model.TransferBulkJobState{
+			Status:      model.TransferBulkJobProcessing,
+			Request:     string(request),
+			TotalRows:   2,
+			SubmittedBy: "alice",
+		},
+	}
+
+	anonymous := claimed
+	anonymous.SubmittedBy = ""
+
+	tests := []struct {
+		name            string
+		storage         *transferBulkJobStorageMock
+		transactionBulk *transactionBulkMock
+		processed       bool
+		finished        *model.TransferBulkJobState
+		err             error
+	}{
+		{
+			name:    "no job to process",
+			storage: &transferBulkJobStorageMock{},
+		},
+		{
+			name:    "storage error when claiming job",
+			storage: &transferBulkJobStorageMock{err: sql.ErrConnDone},
+			err:     sql.ErrConnDone,
+		},
+		{
+			name:    "job completed",
+			storage: &transferBulkJobStorageMock{claimed: &claimed},
+			transactionBulk: &transactionBulkMock{
+				output: &usecase.TransactionBulkOutput{
+					TransferBulkID: 3,
+					TransactionIDs: []model.TransactionID{4, 5},
+					Rows: []model.TransferBulkRow{
+						{Status: model.TransferBulkRowExecuted, TransactionID: 4},
+						{Status: model.TransferBulkRowExecuted, TransactionID: 5},
+					},
+					DebitedCents: 2450,
+					BalanceCents: 7000,
+					Currency:     "EUR",
+					FeesCents:    550,
+				},
+			},
+			processed: true,
+			finished: &model.TransferBulkJobState{
+				Status:        model.TransferBulkJobCompleted,
+				ProcessedRows: 2,
+				Rows: model.TransferBulkJobRows{
+					{Status: model.TransferBulkJobRowCompleted, TransactionID: 4},
+					{Status: model.TransferBulkJobRowCompleted, TransactionID: 5},
+				},
+				TransferBulkID: 3,
+				DebitedCents:   2450,
+				BalanceCents:   7000,
+				Currency:       "EUR",
+				FeesCents:      550,
+			},
+		},
+		{
+			name:    "job completed partially",
+			storage: &transferBulkJobStorageMock{claimed: &claimed},
+			transactionBulk: &transactionBulkMock{
+				output: &usecase.TransactionBulkOutput{
+					TransferBulkID: 3,
+					TransactionIDs: []model.TransactionID{4},
+					Rows: []model.TransferBulkRow{
+						{Status: model.TransferBulkRowExecuted, TransactionID: 4},
+						{Status: model.TransferBulkRowRejected, Reason: "not enough balance"},
+					},
+					DebitedCents: 1450,
+					BalanceCents: 500,
+					Currency:     "EUR",
+				},
+			},
+			processed: true,
+			finished: &model.TransferBulkJobState{
+				Status:        model.TransferBulkJobCompleted,
+				ProcessedRows: 2,
+				Rows: model.TransferBulkJobRows{
+					{Status: model.TransferBulkJobRowCompleted, TransactionID: 4},
+					{Status: model.TransferBulkJobRowFailed, Error: "not enough balance"},
+				},
+				TransferBulkID: 3,
+				DebitedCents:   1450,
+				BalanceCents:   500,
+				Currency:       "EUR",
+			},
+		},
+		{
+			name:    "job completed awaiting approval",
+			storage: &transferBulkJobStorageMock{claimed: &claimed},
+			transactionBulk: &transactionBulkMock{
+				output: &usecase.TransactionBulkOutput{
+					TransferBulkID: 3,
+					Rows: []model.TransferBulkRow{
+						{Status: model.TransferBulkRowAwaitingApproval},
+						{Status: model.TransferBulkRowAwaitingApproval},
+					},
+					DebitedCents: 2450,
+					BalanceCents: 10000,
+					Currency:     "EUR",
+					Status:       model.TransferBulkAwaitingApproval,
+				},
+			},
+			processed: true,
+			finished: &model.TransferBulkJobState{
+				Status:        model.TransferBulkJobCompleted,
+				ProcessedRows: 2,
+				Rows: model.TransferBulkJobRows{
+					{Status: model.TransferBulkJobRowAwaitingApproval},
+					{Status: model.TransferBulkJobRowAwaitingApproval},
+				},
+				TransferBulkID: 3,
+				DebitedCents:   2450,
+				BalanceCents:   10000,
+				Currency:       "EUR",
+			},
+		},
+		{
+			name:    "job completed held for review",
+			storage: &transferBulkJobStorageMock{claimed: &claimed},
+			transactionBulk: &transactionBulkMock{
+				output: &usecase.TransactionBulkOutput{
+					TransferBulkID: 3,
+					Rows: []model.TransferBulkRow{
+						{Status: model.TransferBulkRowAwaitingApproval},
+						{Status: model.TransferBulkRowHeldForReview, Reason: "screening match on iban: SDN-1 Evil Corp, score 1.00"},
+					},
+					DebitedCents: 2450,
+					BalanceCents: 10000,
+					Currency:     "EUR",
+					Status:       model.TransferBulkHeldForReview,
+				},
+			},
+			processed: true,
+			finished: &model.TransferBulkJobState{
+				Status:        model.TransferBulkJobCompleted,
+				ProcessedRows: 2,
+				Rows: model.TransferBulkJobRows{
+					{Status: model.TransferBulkJobRowAwaitingApproval},
+					{Status: model.TransferBulkJobRowHeldForReview, Error: "screening match on iban: SDN-1 Evil Corp, score 1.00"},
+				},
+				TransferBulkID: 3,
+				DebitedCents:   2450,
+				BalanceCents:   10000,
+				Currency:       "EUR",
+			},
+		},
+		{
+			name:    "job failed, not enough balance",
+			storage: &transferBulkJobStorageMock{claimed: &claimed},
+			transactionBulk: &transactionBulkMock{
+				err: ctxd.WrapError(context.Background(), storage.ErrNotEnoughBalance, "storage.BankAccount: failed to check account balance"),
+			},
+			processed: true,
+			finished: &model.TransferBulkJobState{
+				Status:        model.TransferBulkJobFailed,
+				ProcessedRows: 2,
+				Rows: model.TransferBulkJobRows{
+					{Status: model.TransferBulkJobRowFailed},
+					{Status: model.TransferBulkJobRowFailed},
+				},
+				Error: "not enough balance",
+			},
+		},
+		{
+			name:    "job failed, submitted without user and requiring approval",
+			storage: &transferBulkJobStorageMock{claimed: &anonymous},
+			transactionBulk: &transactionBulkMock{
+				err: ctxd.WrapError(context.Background(), model.ErrUnknownSubmitter, "failed to submit transfer bulk for approval"),
+			},
+			processed: true,
+			finished: &model.TransferBulkJobState{
+				Status:        model.TransferBulkJobFailed,
+				ProcessedRows: 2,
+				Rows: model.TransferBulkJobRows{
+					{Status: model.TransferBulkJobRowFailed},
+					{Status: model.TransferBulkJobRowFailed},
+				},
+				Error: "transfer bulk requiring approval must be submitted by a known user",
+			},
+		},
+		{
+			name:    "job processed again, serialization failure",
+			storage: &transferBulkJobStorageMock{claimed: &claimed},
+			transactionBulk: &transactionBulkMock{
+				err: ctxd.WrapError(context.Background(), sqlStateError("40001"), "storage.Transaction: failed to add transactions"),
+			},
+			processed: true,
+			err:       sqlStateError("40001"),
+		},
+		{
+			name:    "job processed again, lost connection",
+			storage: &transferBulkJobStorageMock{claimed: &claimed},
+			transactionBulk: &transactionBulkMock{
+				err: ctxd.WrapError(context.Background(), driver.ErrBadConn, "storage.BankAccount: failed to check account balance"),
+			},
+			processed: true,
+			err:       driver.ErrBadConn,
+		},
+		{
+			name:    "job failed, database error not transient",
+			storage: &transferBulkJobStorageMock{claimed: &claimed},
+			transactionBulk: &transactionBulkMock{
+				err: ctxd.WrapError(context.Background(), sqlStateError("23505"), "storage.Transaction: failed to add transactions"),
+			},
+			processed: true,
+			finished: &model.TransferBulkJobState{
+				Status:        model.TransferBulkJobFailed,
+				ProcessedRows: 2,
+				Rows: model.TransferBulkJobRows{
+					{Status: model.TransferBulkJobRowFailed},
+					{Status: model.TransferBulkJobRowFailed},
+				},
+				Error: "database error, SQLSTATE 23505",
+			},
+		},
+		{
+			name:    "job failed, credit transfer without exchange rate",
+			storage: &transferBulkJobStorageMock{claimed: &claimed},
+			transactionBulk: &transactionBulkMock{
+				err: &usecase.ValidationError{Violations: []usecase.FieldViolation{
+					{Field: "credit_transfers[1].currency", Description: "exchange rate not found"},
+				}},
+			},
+			processed: true,
+			finished: &model.TransferBulkJobState{
+				Status:        model.TransferBulkJobFailed,
+				ProcessedRows: 2,
+				Rows: model.TransferBulkJobRows{
+					{Status: model.TransferBulkJobRowFailed},
+					{Status: model.TransferBulkJobRowFailed, Error: "currency: exchange rate not found"},
+				},
+				Error: "invalid input: credit_transfers[1].currency: exchange rate not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			mock.ExpectBegin()
+
+			if tc.storage.err != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			var transactionBulk usecase.TransactionBulk
+
+			if tc.transactionBulk != nil {
+				tc.transactionBulk.t = t
+				tc.transactionBulk.idempotencyKey = "transfer-bulk-job/7"
+				tc.transactionBulk.submittedBy = tc.storage.claimed.SubmittedBy
+				transactionBulk = tc.transactionBulk
+			}
+
+			tbj := usecase.NewTransactionBulkJob(
+				ctxd.NoOpLogger{},
+				st,
+				clock.Fix(now),
+				time.Minute,
+				transactionBulk,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+				tc.storage,
+			)
+
+			processed, err := tbj.ProcessNext(context.Background())
+
+			assert.Equal(t, tc.processed, processed, "ProcessNext() got = %v, want %v", processed, tc.processed)
+			assert.ErrorIsf(t, err, tc.err, "ProcessNext() err got = %v, want %v", err, tc.err)
+
+			if tc.finished == nil {
+				assert.Nil(t, tc.storage.finished)
+			} else {
+				want := model.TransferBulkJob{ID: claimed.ID, TransferBulkJobState: *tc.finished}
+				want.Request = claimed.Request
+				want.TotalRows = claimed.TotalRows
+				want.SubmittedBy = tc.storage.claimed.SubmittedBy
+				want.UpdatedAt = now
+
+				assert.Equal(t, &want, tc.storage.finished)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("ProcessNext() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -3,8 +3,12 @@ package usecase_test
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/bool64/ctxd"
@@ -13,10 +17,88 @@ import (
 	"github.com/dohernandez/qonto/internal/domain/usecase"
 	"github.com/dohernandez/qonto/internal/platform/storage"
 	"github.com/jmoiron/sqlx"
+	"github.com/nhatthm/go-clock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+type transferBulkReserverMock struct {
+	t              *testing.T
+	idempotencyKey string
+
+	// stored is the transfer bulk returned when the idempotency key was already used, nil reserves the transfer bulk.
+	stored *model.TransferBulk
+	err    error
+}
+
+func (tbrm *transferBulkReserverMock) Reserve(_ context.Context, transferBulkState model.TransferBulkState) (*model.TransferBulk, bool, error) {
+	assert.Equal(tbrm.t, tbrm.idempotencyKey, transferBulkState.IdempotencyKey, "Reserve() got idempotencyKey arg = %v, expected %v", transferBulkState.IdempotencyKey, tbrm.idempotencyKey)
+	assert.NotEmpty(tbrm.t, transferBulkState.RequestHash, "Reserve() got empty requestHash arg")
+
+	if tbrm.err != nil {
+		return nil, false, tbrm.err
+	}
+
+	if tbrm.stored != nil {
+		stored := *tbrm.stored
+
+		// empty request hash mimics a replay of the same request.
+		if stored.RequestHash == "" {
+			stored.RequestHash = transferBulkState.RequestHash
+		}
+
+		return &stored, false, nil
+	}
+
+	return &model.TransferBulk{ID: 1, TransferBulkState: transferBulkState}, true, nil
+}
+
+type accountFinderMock struct {
+	t *testing.T
+
+	bankAccount *model.BankAccount
+	err         error
+}
+
+func (afm *accountFinderMock) Find(_ context.Context, accountState model.BankAccountState) (*model.BankAccount, error) {
+	if afm.bankAccount != nil {
+		assert.Equal(afm.t, afm.bankAccount.Iban, accountState.Iban, "Find() got diff iban arg = %v, expected %v", accountState.Iban, afm.bankAccount.Iban)
+	}
+
+	return afm.bankAccount, afm.err
+}
+
+type journalPosterMock struct {
+	t *testing.T
+
+	postingStates []model.PostingState
+	err           error
+}
+
+func (jpm *journalPosterMock) Post(_ context.Context, journalEntryState model.JournalEntryState, postingStates []model.PostingState) (model.JournalEntryID, error) {
+	assert.NotEmpty(jpm.t, journalEntryState.TransferBulkID, "Post() got empty transferBulkID arg")
+	assert.Equal(jpm.t, jpm.postingStates, postingStates, "Post() got diff postings arg = %v, expected %v", postingStates, jpm.postingStates)
+
+	if jpm.err != nil {
+		return 0, jpm.err
+	}
+
+	return 1, nil
+}
+
+type rateProviderMock struct {
+	rates map[string]string
+}
+
+func (rpm *rateProviderMock) Rate(_ context.Context, from, to string) (string, error) {
+	rate, ok := rpm.rates[from+"/"+to]
+	if !ok {
+		return "", usecase.ErrRateNotFound
+	}
+
+	return rate, nil
+}
+
 type accountBalanceCheckerMock struct {
 	t            *testing.T
 	accountState model.BankAccountState
@@ -44,9 +126,9 @@ type balanceUpdaterMock struct {
 	err       error
 }
 
-func (bdm *balanceUpdaterMock) BalanceUpdate(ctx context.Context, accountID model.BankAccountID, amount model.Cents) error {
-	assert.Equal(bdm.t, bdm.accountID, accountID, "BalanceUpdate() got diff accountID arg = %v, expected %v", accountID, bdm.accountID)
-	assert.Equal(bdm.t, bdm.amount, amount, "BalanceUpdate() got diff amount arg = %v, expected %v", amount, bdm.amount)
+func (bdm *balanceUpdaterMock) BalanceAdd(ctx context.Context, accountID model.BankAccountID, amount model.Cents) error {
+	assert.Equal(bdm.t, bdm.accountID, accountID, "BalanceAdd() got diff accountID arg = %v, expected %v", accountID, bdm.accountID)
+	assert.Equal(bdm.t, bdm.amount, amount, "BalanceAdd() got diff amount arg = %v, expected %v", amount, bdm.amount)
 
 	return bdm.err
 }
@@ -55,13 +137,226 @@ type transactionAdderMock struct {
 	t *testing.T
 
 	transactionStates []model.TransactionState
+	transactionIDs    []model.TransactionID
 	err               error
 }
 
-func (tam *transactionAdderMock) Add(_ context.Context, transactionStates []model.TransactionState) error {
+func (tam *transactionAdderMock) Add(_ context.Context, transactionStates []model.TransactionState) ([]model.TransactionID, error) {
 	assert.Equal(tam.t, tam.transactionStates, transactionStates, "BalanceUpdate() got account arg = %v, expected one of %v", transactionStates, tam.transactionStates)
 
-	return tam.err
+	if tam.err != nil {
+		return nil, tam.err
+	}
+
+	return tam.transactionIDs, nil
+}
+
+type transferBulkCompleterMock struct {
+	t *testing.T
+
+	transferBulk model.TransferBulk
+	err          error
+}
+
+func (tbcm *transferBulkCompleterMock) Complete(_ context.Context, transferBulk model.TransferBulk) error {
+	assert.Equal(tbcm.t, tbcm.transferBulk.ID, transferBulk.ID, "Complete() got diff id arg = %v, expected %v", transferBulk.ID, tbcm.transferBulk.ID)
+	assert.Equal(tbcm.t, tbcm.transferBulk.BankAccountID, transferBulk.BankAccountID, "Complete() got diff bankAccountID arg = %v, expected %v", transferBulk.BankAccountID, tbcm.transferBulk.BankAccountID)
+	assert.Equal(tbcm.t, tbcm.transferBulk.DebitedCents, transferBulk.DebitedCents, "Complete() got diff debitedCents arg = %v, expected %v", transferBulk.DebitedCents, tbcm.transferBulk.DebitedCents)
+	assert.Equal(tbcm.t, tbcm.transferBulk.FeesCents, transferBulk.FeesCents, "Complete() got diff feesCents arg = %v, expected %v", transferBulk.FeesCents, tbcm.transferBulk.FeesCents)
+	assert.Equal(tbcm.t, tbcm.transferBulk.BalanceCents, transferBulk.BalanceCents, "Complete() got diff balanceCents arg = %v, expected %v", transferBulk.BalanceCents, tbcm.transferBulk.BalanceCents)
+	assert.Equal(tbcm.t, tbcm.transferBulk.Currency, transferBulk.Currency, "Complete() got diff currency arg = %v, expected %v", transferBulk.Currency, tbcm.transferBulk.Currency)
+	assert.Equal(tbcm.t, tbcm.transferBulk.Rejections, transferBulk.Rejections, "Complete() got diff rejections arg = %v, expected %v", transferBulk.Rejections, tbcm.transferBulk.Rejections)
+
+	return tbcm.err
+}
+
+type transactionIDsFinderMock struct {
+	t *testing.T
+
+	transferBulkID model.TransferBulkID
+	transactionIDs []model.TransactionID
+	err            error
+}
+
+func (tifm *transactionIDsFinderMock) FindIDsByTransferBulk(_ context.Context, transferBulkID model.TransferBulkID) ([]model.TransactionID, error) {
+	assert.Equal(tifm.t, tifm.transferBulkID, transferBulkID, "FindIDsByTransferBulk() got diff transferBulkID arg = %v, expected %v", transferBulkID, tifm.transferBulkID)
+
+	return tifm.transactionIDs, tifm.err
+}
+
+// bankAccountsIbanFinderMock mocks the bank accounts of the service, none when accounts is empty.
+type bankAccountsIbanFinderMock struct {
+	accounts []model.BankAccount
+}
+
+func (baifm *bankAccountsIbanFinderMock) FindByIbans(_ context.Context, ibans []string) ([]model.BankAccount, error) {
+	var found []model.BankAccount
+
+	for _, account := range baifm.accounts {
+		for _, iban := range ibans {
+			if account.Iban == iban {
+				found = append(found, account)
+			}
+		}
+	}
+
+	return found, nil
+}
+
+// transferLimitsMock mocks the transfer limits of the account and their usage, the same usage for every limit.
+//
+// The organization usage lock is held until unlock is called, as the storage holds it until the transaction ends. The
+// transactions added, when the mock adds them, are part of the usage.
+type transferLimitsMock struct {
+	limits []model.TransferLimit
+	usage  model.TransferUsage
+	locked []string
+	// delay widens the window between reading the usage and adding the transactions.
+	delay time.Duration
+
+	lock sync.Mutex
+	mu   sync.Mutex
+}
+
+func (tlm *transferLimitsMock) FindForAccount(_ context.Context, _ model.BankAccount) ([]model.TransferLimit, error) {
+	return tlm.limits, nil
+}
+
+func (tlm *transferLimitsMock) LockOrganizationUsage(_ context.Context, organizationName string) error {
+	tlm.lock.Lock()
+
+	tlm.mu.Lock()
+	defer tlm.mu.Unlock()
+
+	tlm.locked = append(tlm.locked, organizationName)
+
+	return nil
+}
+
+func (tlm *transferLimitsMock) unlock() {
+	tlm.lock.Unlock()
+}
+
+func (tlm *transferLimitsMock) OutgoingUsage(_ context.Context, _ model.TransferLimit, _ time.Time) (*model.TransferUsage, error) {
+	tlm.mu.Lock()
+	usage := tlm.usage
+	tlm.mu.Unlock()
+
+	time.Sleep(tlm.delay)
+
+	return &usage, nil
+}
+
+func (tlm *transferLimitsMock) Add(_ context.Context, transactionStates []model.TransactionState) ([]model.TransactionID, error) {
+	tlm.mu.Lock()
+	defer tlm.mu.Unlock()
+
+	ids := make([]model.TransactionID, len(transactionStates))
+
+	for i, state := range transactionStates {
+		if state.DebitedCents > 0 {
+			tlm.usage.DailyCents += state.DebitedCents
+			tlm.usage.MonthlyCents += state.DebitedCents
+			tlm.usage.WindowTransfers++
+		}
+
+		ids[i] = model.TransactionID(i + 1)
+	}
+
+	return ids, nil
+}
+
+// feeCalculatorMock charges the fees by kind of credit transfer, no fee when fees is empty.
+type feeCalculatorMock struct {
+	fees map[model.TransferKind]model.Cents
+}
+
+func (fcm *feeCalculatorMock) Fee(_ context.Context, input usecase.FeeInput) (model.Cents, error) {
+	return fcm.fees[input.Kind], nil
+}
+
+// screenerMock matches the counterparties by iban, no match when hits is empty.
+type screenerMock struct {
+	hits map[string]model.ScreeningHit
+}
+
+func (sm *screenerMock) Screen(_ context.Context, input usecase.ScreeningInput) (*model.ScreeningHit, error) {
+	hit, ok := sm.hits[input.Iban]
+	if !ok {
+		return nil, nil
+	}
+
+	return &hit, nil
+}
+
+// testAccount returns the ACME Corp bank account the use cases are tested against.
+func testAccount() model.BankAccount {
+	return model.BankAccount{
+		ID: 1,
+		BankAccountState: model.BankAccountState{
+			OrganizationName: "ACME Corp",
+			BalanceCents:     100000,
+			Iban:             "FR10474608000002006107XXXXX",
+			Bic:              "OIVUSCLQXXX",
+			Currency:         "EUR",
+		},
+	}
+}
+
+// testTransfer returns the transaction of the transfer bulk 1 debiting the account by the amount of the credit
+// transfer.
+func testTransfer(
+	account model.BankAccount,
+	transfer usecase.TransactionBulkTransferInput,
+	amountCents model.Cents,
+) model.TransactionState {
+	return model.TransactionState{
+		CounterpartyName: transfer.CounterpartyName,
+		CounterpartyIban: transfer.CounterpartyIban,
+		CounterpartyBic:  transfer.CounterpartyBic,
+		AmountCents:      amountCents,
+		AmountCurrency:   account.Currency,
+		BankAccountID:    account.ID,
+		Description:      transfer.Description,
+		TransferBulkID:   1,
+		DebitedCents:     amountCents,
+		DebitedCurrency:  account.Currency,
+		ExchangeRate:     model.NoExchangeRate,
+	}
+}
+
+// testBalanceChecker returns the balance checker of the account, expecting the transfers to debit amount.
+func testBalanceChecker(t *testing.T, account *model.BankAccount, amount model.Cents) *accountBalanceCheckerMock {
+	t.Helper()
+
+	return &accountBalanceCheckerMock{
+		t: t,
+		accountState: model.BankAccountState{
+			OrganizationName: account.OrganizationName,
+			Iban:             account.Iban,
+			Bic:              account.Bic,
+		},
+		amount:      amount,
+		bankAccount: account,
+	}
+}
+
+// newTestStorage returns the storage backed by sqlmock, its sql transaction expected to be committed or rolled back.
+func newTestStorage(t *testing.T, committed bool) (*sqluct.Storage, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+
+	if committed {
+		mock.ExpectCommit()
+	} else {
+		mock.ExpectRollback()
+	}
+
+	return sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")), mock
 }
 
 func Test_transactionBulk_TransactionBulk(t *testing.T) {
@@ -79,33 +374,42 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 			BalanceCents:     model.Cents(100000),
 			Iban:             iban,
 			Bic:              bic,
+			Currency:         "EUR",
 		},
 	}
 
 	transactionStates := []model.TransactionState{
 		{
 			CounterpartyName: "CounterpartyName1",
-			CounterpartyIban: "CounterpartyIban1",
-			CounterpartyBic:  "CounterpartyBic1",
+			CounterpartyIban: "FR1420041010050500013M02606",
+			CounterpartyBic:  "CRLYFRPPTOU",
 			AmountCents:      balanceCents / 2,
 			AmountCurrency:   "EUR",
 			BankAccountID:    bankAccount.ID,
 			Description:      "Description1",
+			TransferBulkID:   1,
+			DebitedCents:     balanceCents / 2,
+			DebitedCurrency:  "EUR",
+			ExchangeRate:     model.NoExchangeRate,
 		},
 		{
 			CounterpartyName: "CounterpartyName1",
-			CounterpartyIban: "CounterpartyIban1",
-			CounterpartyBic:  "CounterpartyBic1",
+			CounterpartyIban: "FR1420041010050500013M02606",
+			CounterpartyBic:  "CRLYFRPPTOU",
 			AmountCents:      balanceCents / 2,
 			AmountCurrency:   "EUR",
 			BankAccountID:    bankAccount.ID,
 			Description:      "Description1",
+			TransferBulkID:   1,
+			DebitedCents:     balanceCents / 2,
+			DebitedCurrency:  "EUR",
+			ExchangeRate:     model.NoExchangeRate,
 		},
 	}
 
 	creditTransfer := []usecase.TransactionBulkTransferInput{
 		{
-			Amount:           float64(transactionStates[0].AmountCents / 100),
+			Amount:           model.FormatCents(transactionStates[0].AmountCents, transactionStates[0].AmountCurrency),
 			Currency:         transactionStates[0].AmountCurrency,
 			CounterpartyName: transactionStates[0].CounterpartyName,
 			CounterpartyBic:  transactionStates[0].CounterpartyBic,
@@ -113,7 +417,7 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 			Description:      transactionStates[0].Description,
 		},
 		{
-			Amount:           float64(transactionStates[1].AmountCents / 100),
+			Amount:           model.FormatCents(transactionStates[1].AmountCents, transactionStates[1].AmountCurrency),
 			Currency:         transactionStates[1].AmountCurrency,
 			CounterpartyName: transactionStates[1].CounterpartyName,
 			CounterpartyBic:  transactionStates[1].CounterpartyBic,
@@ -122,10 +426,63 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 		},
 	}
 
+	usdCreditTransfer := make([]usecase.TransactionBulkTransferInput, len(creditTransfer))
+	usdTransactionStates := make([]model.TransactionState, len(transactionStates))
+
+	for i := range creditTransfer {
+		usdCreditTransfer[i] = creditTransfer[i]
+		usdCreditTransfer[i].Amount = "25.00"
+		usdCreditTransfer[i].Currency = "USD"
+
+		usdTransactionStates[i] = transactionStates[i]
+		usdTransactionStates[i].AmountCents = 2500
+		usdTransactionStates[i].AmountCurrency = "USD"
+		usdTransactionStates[i].DebitedCents = 2250
+		usdTransactionStates[i].ExchangeRate = "0.9"
+	}
+
+	postings := []model.PostingState{
+		{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: bankAccount.ID, AmountCents: -balanceCents, Currency: "EUR"},
+		{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 1, AmountCents: balanceCents / 2, Currency: "EUR"},
+		{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 2, AmountCents: balanceCents / 2, Currency: "EUR"},
+	}
+
+	usdPostings := []model.PostingState{
+		{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: bankAccount.ID, AmountCents: -4500, Currency: "EUR"},
+		{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 1, AmountCents: 2250, Currency: "EUR"},
+		{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 2, AmountCents: 2250, Currency: "EUR"},
+	}
+
+	executedRows := []model.TransferBulkRow{
+		{Status: model.TransferBulkRowExecuted, TransactionID: 1},
+		{Status: model.TransferBulkRowExecuted, TransactionID: 2},
+	}
+
+	// partialBankAccount balance covers only one of the credit transfers.
+	partialBankAccount := bankAccount
+	partialBankAccount.BalanceCents = 7000
+
+	completedTransferBulk := model.TransferBulk{
+		ID: 1,
+		TransferBulkState: model.TransferBulkState{
+			BankAccountID: bankAccount.ID,
+			DebitedCents:  balanceCents,
+			BalanceCents:  bankAccount.BalanceCents - balanceCents,
+			Currency:      "EUR",
+		},
+	}
+
 	type fields struct {
-		checker usecase.AccountBalanceChecker
-		updater usecase.BalanceUpdater
-		adder   usecase.TransactionAdder
+		reserver  usecase.TransferBulkReserver
+		completer usecase.TransferBulkCompleter
+		// accountFinder defaults to find the bank account.
+		accountFinder usecase.AccountFinder
+		checker       usecase.AccountBalanceChecker
+		updater       usecase.BalanceUpdater
+		adder         usecase.TransactionAdder
+		finder        usecase.TransactionIDsFinder
+		poster        usecase.JournalPoster
+		rates         usecase.RateProvider
 	}
 
 	type args struct {
@@ -136,12 +493,19 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 		name    string
 		fields  fields
 		args    args
+		want    *usecase.TransactionBulkOutput
 		wantErr bool
-		err     error
+		noTx    bool
+		// rollback is whether the transaction is rolled back without error, as validate-only transfer bulks are.
+		rollback bool
+		err      error
+		// violations are the expected field violations when the input is invalid.
+		violations []usecase.FieldViolation
 	}{
 		{
 			name: "transaction proceed successfully",
 			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
 				checker: &accountBalanceCheckerMock{
 					t: t,
 					accountState: model.BankAccountState{
@@ -156,12 +520,21 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 				updater: &balanceUpdaterMock{
 					t:         t,
 					accountID: bankAccount.ID,
-					amount:    bankAccount.BalanceCents - balanceCents,
+					amount:    -balanceCents,
 					err:       nil,
 				},
 				adder: &transactionAdderMock{
 					t:                 t,
 					transactionStates: transactionStates,
+					transactionIDs:    []model.TransactionID{1, 2},
+				},
+				poster: &journalPosterMock{
+					t:             t,
+					postingStates: postings,
+				},
+				completer: &transferBulkCompleterMock{
+					t:            t,
+					transferBulk: completedTransferBulk,
 				},
 			},
 			args: args{
@@ -172,12 +545,22 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 					CreditTransfers:  creditTransfer,
 				},
 			},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				TransactionIDs: []model.TransactionID{1, 2},
+				Rows:           executedRows,
+				DebitedCents:   balanceCents,
+				BalanceCents:   bankAccount.BalanceCents - balanceCents,
+				Currency:       "EUR",
+				Status:         model.TransferBulkExecuted,
+			},
 			wantErr: false,
 			err:     nil,
 		},
 		{
 			name: "transaction proceed failed, not enough balance",
 			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
 				checker: &accountBalanceCheckerMock{
 					t: t,
 					accountState: model.BankAccountState{
@@ -206,6 +589,7 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 		{
 			name: "transaction proceed failed, error update balance",
 			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
 				checker: &accountBalanceCheckerMock{
 					t: t,
 					accountState: model.BankAccountState{
@@ -220,12 +604,17 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 				updater: &balanceUpdaterMock{
 					t:         t,
 					accountID: bankAccount.ID,
-					amount:    bankAccount.BalanceCents - balanceCents,
+					amount:    -balanceCents,
 					err:       ctxd.WrapError(context.Background(), sql.ErrTxDone, "storage.BankAccount: failed to update account balance"),
 				},
 				adder: &transactionAdderMock{
 					t:                 t,
 					transactionStates: transactionStates,
+					transactionIDs:    []model.TransactionID{1, 2},
+				},
+				poster: &journalPosterMock{
+					t:             t,
+					postingStates: postings,
 				},
 			},
 			args: args{
@@ -242,6 +631,7 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 		{
 			name: "transaction proceed failed, error adding transfer",
 			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
 				checker: &accountBalanceCheckerMock{
 					t: t,
 					accountState: model.BankAccountState{
@@ -256,7 +646,7 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 				updater: &balanceUpdaterMock{
 					t:         t,
 					accountID: bankAccount.ID,
-					amount:    bankAccount.BalanceCents - balanceCents,
+					amount:    -balanceCents,
 					err:       nil,
 				},
 				adder: &transactionAdderMock{
@@ -276,34 +666,1662 @@ func Test_transactionBulk_TransactionBulk(t *testing.T) {
 			wantErr: true,
 			err:     ctxd.WrapError(context.Background(), sql.ErrTxDone, "storage.Transaction: failed to add transaction"),
 		},
-	}
-
-	for _, tt := range tests {
-		tc := tt
-
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
-
-			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
-			require.NoError(t, err)
-
-			mock.ExpectBegin()
-
-			if !tc.wantErr {
-				mock.ExpectCommit()
-			} else {
-				mock.ExpectRollback()
-			}
-
-			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
-
-			tb := usecase.NewTransactionBulk(ctxd.NoOpLogger{}, st, tc.fields.checker, tc.fields.updater, tc.fields.adder)
-
-			if err = tb.TransactionBulk(context.Background(), tc.args.input); (err != nil) != tc.wantErr {
-				t.Errorf("TransactionBulk() error = %v, wantErr %v", err, tc.wantErr)
-			}
-
-			assert.ErrorIsf(t, tc.err, err, "TransactionBulk() err got = %v, want %v", err, tc.err)
+		{
+			name: "transaction proceed failed, error posting journal entry",
+			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
+				checker: &accountBalanceCheckerMock{
+					t: t,
+					accountState: model.BankAccountState{
+						OrganizationName: organizationName,
+						Iban:             iban,
+						Bic:              bic,
+					},
+					amount:      balanceCents,
+					bankAccount: &bankAccount,
+					err:         nil,
+				},
+				adder: &transactionAdderMock{
+					t:                 t,
+					transactionStates: transactionStates,
+					transactionIDs:    []model.TransactionID{1, 2},
+				},
+				poster: &journalPosterMock{
+					t:             t,
+					postingStates: postings,
+					err:           ctxd.WrapError(context.Background(), sql.ErrTxDone, "storage.Ledger: failed to post journal entry"),
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+				},
+			},
+			wantErr: true,
+			err:     ctxd.WrapError(context.Background(), sql.ErrTxDone, "storage.Ledger: failed to post journal entry"),
+		},
+		{
+			name: "transaction already proceed, idempotent replay",
+			fields: fields{
+				reserver: &transferBulkReserverMock{
+					t:              t,
+					idempotencyKey: "IdempotencyKey",
+					stored: &model.TransferBulk{
+						ID: 1,
+						TransferBulkState: model.TransferBulkState{
+							IdempotencyKey: "IdempotencyKey",
+							BankAccountID:  bankAccount.ID,
+							DebitedCents:   balanceCents,
+							BalanceCents:   bankAccount.BalanceCents - balanceCents,
+							Currency:       "EUR",
+						},
+					},
+				},
+				checker: nil,
+				updater: nil,
+				adder:   nil,
+				finder: &transactionIDsFinderMock{
+					t:              t,
+					transferBulkID: 1,
+					transactionIDs: []model.TransactionID{1, 2},
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+					IdempotencyKey:   "IdempotencyKey",
+				},
+			},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				TransactionIDs: []model.TransactionID{1, 2},
+				Rows:           executedRows,
+				DebitedCents:   balanceCents,
+				BalanceCents:   bankAccount.BalanceCents - balanceCents,
+				Currency:       "EUR",
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "transaction proceed failed, idempotency key used with a different request",
+			fields: fields{
+				reserver: &transferBulkReserverMock{
+					t:              t,
+					idempotencyKey: "IdempotencyKey",
+					stored: &model.TransferBulk{
+						ID: 1,
+						TransferBulkState: model.TransferBulkState{
+							IdempotencyKey: "IdempotencyKey",
+							RequestHash:    "RequestHash",
+						},
+					},
+				},
+				checker: nil,
+				updater: nil,
+				adder:   nil,
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+					IdempotencyKey:   "IdempotencyKey",
+				},
+			},
+			wantErr: true,
+			err:     usecase.ErrIdempotencyKeyMismatch,
+		},
+		{
+			name: "transaction proceed successfully, converted into the account currency",
+			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
+				checker: &accountBalanceCheckerMock{
+					t: t,
+					accountState: model.BankAccountState{
+						OrganizationName: organizationName,
+						Iban:             iban,
+						Bic:              bic,
+					},
+					amount:      4500,
+					bankAccount: &bankAccount,
+					err:         nil,
+				},
+				updater: &balanceUpdaterMock{
+					t:         t,
+					accountID: bankAccount.ID,
+					amount:    -4500,
+					err:       nil,
+				},
+				adder: &transactionAdderMock{
+					t:                 t,
+					transactionStates: usdTransactionStates,
+					transactionIDs:    []model.TransactionID{1, 2},
+				},
+				poster: &journalPosterMock{
+					t:             t,
+					postingStates: usdPostings,
+				},
+				completer: &transferBulkCompleterMock{
+					t: t,
+					transferBulk: model.TransferBulk{
+						ID: 1,
+						TransferBulkState: model.TransferBulkState{
+							BankAccountID: bankAccount.ID,
+							DebitedCents:  4500,
+							BalanceCents:  bankAccount.BalanceCents - 4500,
+							Currency:      "EUR",
+						},
+					},
+				},
+				rates: &rateProviderMock{
+					rates: map[string]string{"USD/EUR": "0.9"},
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  usdCreditTransfer,
+				},
+			},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				TransactionIDs: []model.TransactionID{1, 2},
+				Rows:           executedRows,
+				DebitedCents:   4500,
+				BalanceCents:   bankAccount.BalanceCents - 4500,
+				Currency:       "EUR",
+				Status:         model.TransferBulkExecuted,
+			},
+			wantErr: false,
+			err:     nil,
+		},
+		{
+			name: "transaction proceed failed, no exchange rate into the account currency",
+			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  usdCreditTransfer,
+				},
+			},
+			wantErr: true,
+			err:     usecase.ErrInvalidInput,
+			violations: []usecase.FieldViolation{
+				{
+					Field:       "credit_transfers[0].currency",
+					Description: "exchange rate not found",
+				},
+				{
+					Field:       "credit_transfers[1].currency",
+					Description: "exchange rate not found",
+				},
+			},
+		},
+		{
+			name: "transaction proceed partially, credit transfers not covered by the balance rejected",
+			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
+				checker: &accountBalanceCheckerMock{
+					t: t,
+					accountState: model.BankAccountState{
+						OrganizationName: organizationName,
+						Iban:             iban,
+						Bic:              bic,
+					},
+					amount:      0,
+					bankAccount: &partialBankAccount,
+				},
+				updater: &balanceUpdaterMock{
+					t:         t,
+					accountID: bankAccount.ID,
+					amount:    -balanceCents / 2,
+				},
+				adder: &transactionAdderMock{
+					t:                 t,
+					transactionStates: transactionStates[:1],
+					transactionIDs:    []model.TransactionID{1},
+				},
+				poster: &journalPosterMock{
+					t: t,
+					postingStates: []model.PostingState{
+						{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: bankAccount.ID, AmountCents: -balanceCents / 2, Currency: "EUR"},
+						{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 1, AmountCents: balanceCents / 2, Currency: "EUR"},
+					},
+				},
+				completer: &transferBulkCompleterMock{
+					t: t,
+					transferBulk: model.TransferBulk{
+						ID: 1,
+						TransferBulkState: model.TransferBulkState{
+							BankAccountID: bankAccount.ID,
+							DebitedCents:  balanceCents / 2,
+							BalanceCents:  partialBankAccount.BalanceCents - balanceCents/2,
+							Currency:      "EUR",
+							Rejections:    model.TransferBulkRejections{{Row: 1, Reason: "not enough balance"}},
+						},
+					},
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+					Mode:             model.TransferBulkPartial,
+				},
+			},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				TransactionIDs: []model.TransactionID{1},
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowExecuted, TransactionID: 1},
+					{Status: model.TransferBulkRowRejected, Reason: "not enough balance"},
+				},
+				DebitedCents: balanceCents / 2,
+				BalanceCents: partialBankAccount.BalanceCents - balanceCents/2,
+				Currency:     "EUR",
+				Status:       model.TransferBulkExecuted,
+			},
+		},
+		{
+			name: "transaction proceed partially, none of the credit transfers covered by the balance",
+			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
+				checker: &accountBalanceCheckerMock{
+					t: t,
+					accountState: model.BankAccountState{
+						OrganizationName: organizationName,
+						Iban:             iban,
+						Bic:              bic,
+					},
+					amount: 0,
+					bankAccount: &model.BankAccount{
+						ID: bankAccount.ID,
+						BankAccountState: model.BankAccountState{
+							BalanceCents: 1000,
+							Currency:     "EUR",
+						},
+					},
+				},
+				completer: &transferBulkCompleterMock{
+					t: t,
+					transferBulk: model.TransferBulk{
+						ID: 1,
+						TransferBulkState: model.TransferBulkState{
+							BankAccountID: bankAccount.ID,
+							BalanceCents:  1000,
+							Currency:      "EUR",
+							Rejections: model.TransferBulkRejections{
+								{Row: 0, Reason: "not enough balance"},
+								{Row: 1, Reason: "not enough balance"},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+					Mode:             model.TransferBulkPartial,
+				},
+			},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowRejected, Reason: "not enough balance"},
+					{Status: model.TransferBulkRowRejected, Reason: "not enough balance"},
+				},
+				BalanceCents: 1000,
+				Currency:     "EUR",
+				Status:       model.TransferBulkExecuted,
+			},
+		},
+		{
+			name: "transaction proceed partially, none of the credit transfers covered by the overdraft facility",
+			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
+				checker: &accountBalanceCheckerMock{
+					t: t,
+					accountState: model.BankAccountState{
+						OrganizationName: organizationName,
+						Iban:             iban,
+						Bic:              bic,
+					},
+					amount: 0,
+					bankAccount: &model.BankAccount{
+						ID: bankAccount.ID,
+						BankAccountState: model.BankAccountState{
+							BalanceCents:        1000,
+							Currency:            "EUR",
+							OverdraftLimitCents: 1000,
+						},
+					},
+				},
+				completer: &transferBulkCompleterMock{
+					t: t,
+					transferBulk: model.TransferBulk{
+						ID: 1,
+						TransferBulkState: model.TransferBulkState{
+							BankAccountID: bankAccount.ID,
+							BalanceCents:  1000,
+							Currency:      "EUR",
+							Rejections: model.TransferBulkRejections{
+								{Row: 0, Reason: "overdraft limit exceeded"},
+								{Row: 1, Reason: "overdraft limit exceeded"},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+					Mode:             model.TransferBulkPartial,
+				},
+			},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowRejected, Reason: "overdraft limit exceeded"},
+					{Status: model.TransferBulkRowRejected, Reason: "overdraft limit exceeded"},
+				},
+				BalanceCents: 1000,
+				Currency:     "EUR",
+				Status:       model.TransferBulkExecuted,
+			},
+		},
+		{
+			name: "transaction proceed partially already, idempotent replay",
+			fields: fields{
+				reserver: &transferBulkReserverMock{
+					t:              t,
+					idempotencyKey: "IdempotencyKey",
+					stored: &model.TransferBulk{
+						ID: 1,
+						TransferBulkState: model.TransferBulkState{
+							IdempotencyKey: "IdempotencyKey",
+							BankAccountID:  bankAccount.ID,
+							DebitedCents:   balanceCents / 2,
+							BalanceCents:   partialBankAccount.BalanceCents - balanceCents/2,
+							Currency:       "EUR",
+							Rejections:     model.TransferBulkRejections{{Row: 0, Reason: "not enough balance"}},
+						},
+					},
+				},
+				finder: &transactionIDsFinderMock{
+					t:              t,
+					transferBulkID: 1,
+					transactionIDs: []model.TransactionID{2},
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+					IdempotencyKey:   "IdempotencyKey",
+					Mode:             model.TransferBulkPartial,
+				},
+			},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				TransactionIDs: []model.TransactionID{2},
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowRejected, Reason: "not enough balance"},
+					{Status: model.TransferBulkRowExecuted, TransactionID: 2},
+				},
+				DebitedCents: balanceCents / 2,
+				BalanceCents: partialBankAccount.BalanceCents - balanceCents/2,
+				Currency:     "EUR",
+			},
+		},
+		{
+			name: "transaction proceed failed, account not found",
+			fields: fields{
+				reserver: &transferBulkReserverMock{t: t},
+				accountFinder: &accountFinderMock{
+					t:   t,
+					err: ctxd.WrapError(context.Background(), storage.ErrNotFound, "storage.BankAccount: failed to find account"),
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+				},
+			},
+			wantErr: true,
+			err:     storage.ErrNotFound,
+		},
+		{
+			name: "transaction proceed failed, amount exceeds the currency precision",
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers: []usecase.TransactionBulkTransferInput{
+						{
+							Amount:           "10.005",
+							Currency:         "EUR",
+							CounterpartyName: "CounterpartyName1",
+							CounterpartyBic:  "CRLYFRPPTOU",
+							CounterpartyIban: "FR1420041010050500013M02606",
+							Description:      "Description1",
+						},
+					},
+				},
+			},
+			wantErr: true,
+			noTx:    true,
+			err:     usecase.ErrInvalidInput,
+			violations: []usecase.FieldViolation{
+				{
+					Field:       "credit_transfers[0].amount",
+					Description: `amount exceeds the currency precision: "10.005" has more than 2 decimals`,
+				},
+			},
+		},
+		{
+			name: "validate only, transfers not performed",
+			fields: fields{
+				checker: &accountBalanceCheckerMock{
+					t: t,
+					accountState: model.BankAccountState{
+						OrganizationName: organizationName,
+						Iban:             iban,
+						Bic:              bic,
+					},
+					amount:      balanceCents,
+					bankAccount: &bankAccount,
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+					IdempotencyKey:   "key",
+					ValidateOnly:     true,
+				},
+			},
+			want: &usecase.TransactionBulkOutput{
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowExecutable},
+					{Status: model.TransferBulkRowExecutable},
+				},
+				DebitedCents: balanceCents,
+				BalanceCents: bankAccount.BalanceCents - balanceCents,
+				Currency:     "EUR",
+			},
+			rollback: true,
+		},
+		{
+			name: "validate only in partial mode, transfers not covered by the balance rejected",
+			fields: fields{
+				checker: &accountBalanceCheckerMock{
+					t: t,
+					accountState: model.BankAccountState{
+						OrganizationName: organizationName,
+						Iban:             iban,
+						Bic:              bic,
+					},
+					amount:      0,
+					bankAccount: &partialBankAccount,
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+					Mode:             model.TransferBulkPartial,
+					ValidateOnly:     true,
+				},
+			},
+			want: &usecase.TransactionBulkOutput{
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowExecutable},
+					{Status: model.TransferBulkRowRejected, Reason: "not enough balance"},
+				},
+				DebitedCents: balanceCents / 2,
+				BalanceCents: partialBankAccount.BalanceCents - balanceCents/2,
+				Currency:     "EUR",
+			},
+			rollback: true,
+		},
+		{
+			name: "validate only failed, not enough balance",
+			fields: fields{
+				checker: &accountBalanceCheckerMock{
+					t: t,
+					accountState: model.BankAccountState{
+						OrganizationName: organizationName,
+						Iban:             iban,
+						Bic:              bic,
+					},
+					amount: balanceCents,
+					err:    ctxd.WrapError(context.Background(), storage.ErrNotEnoughBalance, "storage.BankAccount: failed to check account balance"),
+				},
+			},
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers:  creditTransfer,
+					ValidateOnly:     true,
+				},
+			},
+			wantErr: true,
+			err:     storage.ErrNotEnoughBalance,
+		},
+		{
+			name: "transaction proceed failed, invalid input",
+			args: args{
+				input: usecase.TransactionBulkInput{
+					OrganizationName: organizationName,
+					OrganizationIban: iban,
+					OrganizationBic:  bic,
+					CreditTransfers: []usecase.TransactionBulkTransferInput{
+						{
+							Amount:           "-10",
+							Currency:         "EUR",
+							CounterpartyName: "CounterpartyName1",
+							CounterpartyBic:  "ZDRPLBQ",
+							CounterpartyIban: "DE9935420810036209081725212",
+							Description:      "Description1",
+						},
+					},
+				},
+			},
+			wantErr: true,
+			noTx:    true,
+			err:     usecase.ErrInvalidInput,
+			violations: []usecase.FieldViolation{
+				{
+					Field:       "credit_transfers[0].amount",
+					Description: "invalid amount: must be positive",
+				},
+				{
+					Field:       "credit_transfers[0].counterparty_iban",
+					Description: "invalid IBAN: DE IBAN must have 22 characters, got 27",
+				},
+				{
+					Field:       "credit_transfers[0].counterparty_bic",
+					Description: `invalid BIC: "ZDRPLBQ" must have 8 or 11 characters, bank and country code letters`,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			if !tc.noTx {
+				mock.ExpectBegin()
+
+				if !tc.wantErr && !tc.rollback {
+					mock.ExpectCommit()
+				} else {
+					mock.ExpectRollback()
+				}
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			accountFinder := tc.fields.accountFinder
+			if accountFinder == nil {
+				accountFinder = &accountFinderMock{t: t, bankAccount: &bankAccount}
+			}
+
+			rates := tc.fields.rates
+			if rates == nil {
+				rates = &rateProviderMock{}
+			}
+
+			tb := usecase.NewTransactionBulk(
+				ctxd.NoOpLogger{},
+				st,
+				tc.fields.reserver,
+				tc.fields.completer,
+				accountFinder,
+				tc.fields.checker,
+				tc.fields.updater,
+				tc.fields.adder,
+				tc.fields.finder,
+				tc.fields.poster,
+				rates,
+				&bankAccountsIbanFinderMock{},
+				nil,
+				clock.New(),
+				&transferLimitsMock{},
+				&transferLimitsMock{},
+				&feeCalculatorMock{},
+				nil,
+				nil,
+				&screenerMock{},
+			)
+
+			got, err := tb.TransactionBulk(context.Background(), tc.args.input)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("TransactionBulk() error = %v, wantErr %v", err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.want, got, "TransactionBulk() got = %v, want %v", got, tc.want)
+
+			assert.ErrorIsf(t, err, tc.err, "TransactionBulk() err got = %v, want %v", err, tc.err)
+
+			if tc.violations != nil {
+				var verr *usecase.ValidationError
+
+				require.ErrorAs(t, err, &verr)
+				assert.Equal(t, tc.violations, verr.Violations, "TransactionBulk() violations got = %v, want %v", verr.Violations, tc.violations)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("TransactionBulk() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+// internalTransferStorageMock records the locks, the added transactions, the postings and the balance updates of the
+// transfer bulks with internal credit transfers.
+type internalTransferStorageMock struct {
+	mu sync.Mutex
+
+	accounts map[model.BankAccountID]model.BankAccount
+
+	locked   []model.BankAccountID
+	added    []model.TransactionState
+	postings []model.PostingState
+	// balances are the amounts added to the balances of the accounts.
+	balances map[model.BankAccountID]model.Cents
+}
+
+func (itsm *internalTransferStorageMock) Lock(_ context.Context, id model.BankAccountID) (*model.BankAccount, error) {
+	itsm.mu.Lock()
+	defer itsm.mu.Unlock()
+
+	itsm.locked = append(itsm.locked, id)
+
+	account := itsm.accounts[id]
+
+	return &account, nil
+}
+
+func (itsm *internalTransferStorageMock) Add(_ context.Context, transactionStates []model.TransactionState) ([]model.TransactionID, error) {
+	itsm.mu.Lock()
+	defer itsm.mu.Unlock()
+
+	ids := make([]model.TransactionID, len(transactionStates))
+
+	// the ids follow the ids of the transactions added before.
+	for i := range ids {
+		ids[i] = model.TransactionID(len(itsm.added) + i + 1)
+	}
+
+	itsm.added = append(itsm.added, transactionStates...)
+
+	return ids, nil
+}
+
+func (itsm *internalTransferStorageMock) Post(_ context.Context, _ model.JournalEntryState, postingStates []model.PostingState) (model.JournalEntryID, error) {
+	itsm.mu.Lock()
+	defer itsm.mu.Unlock()
+
+	itsm.postings = postingStates
+
+	return 1, model.ValidateJournal(postingStates)
+}
+
+func (itsm *internalTransferStorageMock) BalanceAdd(_ context.Context, accountID model.BankAccountID, amount model.Cents) error {
+	itsm.mu.Lock()
+	defer itsm.mu.Unlock()
+
+	if itsm.balances == nil {
+		itsm.balances = make(map[model.BankAccountID]model.Cents)
+	}
+
+	itsm.balances[accountID] += amount
+
+	return nil
+}
+
+func Test_transactionBulk_TransactionBulk_internal(t *testing.T) {
+	t.Parallel()
+
+	debtor := testAccount()
+	debtor.ID = 2
+
+	eurReceiver := model.BankAccount{
+		ID: 1,
+		BankAccountState: model.BankAccountState{
+			OrganizationName: "Road Runner",
+			BalanceCents:     5000,
+			Iban:             "FR1420041010050500013M02606",
+			Bic:              "CRLYFRPPTOU",
+			Currency:         "EUR",
+		},
+	}
+
+	usdReceiver := model.BankAccount{
+		ID: 3,
+		BankAccountState: model.BankAccountState{
+			OrganizationName: "Wile E Coyote",
+			BalanceCents:     0,
+			Iban:             "EE303680981021245685",
+			Bic:              "CRLYFRPPTOU",
+			Currency:         "USD",
+		},
+	}
+
+	input := usecase.TransactionBulkInput{
+		OrganizationName: debtor.OrganizationName,
+		OrganizationIban: debtor.Iban,
+		OrganizationBic:  debtor.Bic,
+		CreditTransfers: []usecase.TransactionBulkTransferInput{
+			{Amount: "100", Currency: "EUR", CounterpartyName: "Road Runner", CounterpartyIban: eurReceiver.Iban, CounterpartyBic: eurReceiver.Bic, Description: "Seeds"},
+			{Amount: "20", Currency: "EUR", CounterpartyName: "Bugs Bunny", CounterpartyIban: "DE89370400440532013000", CounterpartyBic: "COBADEFFXXX", Description: "Carrots"},
+			{Amount: "50", Currency: "EUR", CounterpartyName: "Wile E Coyote", CounterpartyIban: usdReceiver.Iban, CounterpartyBic: usdReceiver.Bic, Description: "Rockets"},
+		},
+	}
+
+	debit := func(transfer usecase.TransactionBulkTransferInput, amountCents model.Cents, internal bool) model.TransactionState {
+		return model.TransactionState{
+			CounterpartyName: transfer.CounterpartyName,
+			CounterpartyIban: transfer.CounterpartyIban,
+			CounterpartyBic:  transfer.CounterpartyBic,
+			AmountCents:      amountCents,
+			AmountCurrency:   "EUR",
+			BankAccountID:    debtor.ID,
+			Description:      transfer.Description,
+			TransferBulkID:   1,
+			DebitedCents:     amountCents,
+			DebitedCurrency:  "EUR",
+			ExchangeRate:     model.NoExchangeRate,
+			Internal:         internal,
+		}
+	}
+
+	credit := func(receiver model.BankAccount, description string, amountCents, creditedCents model.Cents, rate string) model.TransactionState {
+		return model.TransactionState{
+			CounterpartyName: debtor.OrganizationName,
+			CounterpartyIban: debtor.Iban,
+			CounterpartyBic:  debtor.Bic,
+			AmountCents:      -amountCents,
+			AmountCurrency:   "EUR",
+			BankAccountID:    receiver.ID,
+			Description:      description,
+			DebitedCents:     -creditedCents,
+			DebitedCurrency:  receiver.Currency,
+			ExchangeRate:     rate,
+			Internal:         true,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		rates    map[string]string
+		locked   []model.BankAccountID
+		added    []model.TransactionState
+		postings []model.PostingState
+		balances map[model.BankAccountID]model.Cents
+		want     *usecase.TransactionBulkOutput
+		err      error
+	}{
+		{
+			name:   "internal credit transfers credit the receivers",
+			rates:  map[string]string{"EUR/USD": "1.1"},
+			locked: []model.BankAccountID{1, 2, 3},
+			added: []model.TransactionState{
+				debit(input.CreditTransfers[0], 10000, true),
+				debit(input.CreditTransfers[1], 2000, false),
+				debit(input.CreditTransfers[2], 5000, true),
+				credit(eurReceiver, "Seeds", 10000, 10000, model.NoExchangeRate),
+				credit(usdReceiver, "Rockets", 5000, 5500, "1.1"),
+			},
+			postings: []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 2, AmountCents: -17000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 1, AmountCents: 10000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 2, AmountCents: 2000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 3, AmountCents: 5000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 1, AmountCents: 10000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountIncomingTransfers, TransactionID: 4, AmountCents: -10000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 3, AmountCents: 5500, Currency: "USD"},
+				{LedgerAccount: model.LedgerAccountIncomingTransfers, TransactionID: 5, AmountCents: -5500, Currency: "USD"},
+			},
+			balances: map[model.BankAccountID]model.Cents{1: 10000, 2: -17000, 3: 5500},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				TransactionIDs: []model.TransactionID{1, 2, 3},
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowExecuted, TransactionID: 1},
+					{Status: model.TransferBulkRowExecuted, TransactionID: 2},
+					{Status: model.TransferBulkRowExecuted, TransactionID: 3},
+				},
+				DebitedCents: 17000,
+				BalanceCents: 83000,
+				Currency:     "EUR",
+				Status:       model.TransferBulkExecuted,
+			},
+		},
+		{
+			name:   "receiver currency without exchange rate",
+			locked: []model.BankAccountID{1, 2, 3},
+			err:    usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+
+			mock.ExpectBegin()
+
+			if tc.err != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			its := &internalTransferStorageMock{
+				accounts: map[model.BankAccountID]model.BankAccount{1: eurReceiver, 2: debtor, 3: usdReceiver},
+			}
+
+			tb := usecase.NewTransactionBulk(
+				ctxd.NoOpLogger{},
+				st,
+				&transferBulkReserverMock{t: t},
+				&transferBulkCompleterMock{t: t, transferBulk: model.TransferBulk{
+					ID: 1,
+					TransferBulkState: model.TransferBulkState{
+						BankAccountID: debtor.ID,
+						DebitedCents:  17000,
+						BalanceCents:  83000,
+						Currency:      "EUR",
+					},
+				}},
+				&accountFinderMock{t: t, bankAccount: &debtor},
+				&accountBalanceCheckerMock{
+					t:            t,
+					accountState: model.BankAccountState{OrganizationName: debtor.OrganizationName, Iban: debtor.Iban, Bic: debtor.Bic},
+					amount:       17000,
+					bankAccount:  &debtor,
+				},
+				its,
+				its,
+				nil,
+				its,
+				&rateProviderMock{rates: tc.rates},
+				&bankAccountsIbanFinderMock{accounts: []model.BankAccount{eurReceiver, usdReceiver}},
+				its,
+				clock.New(),
+				&transferLimitsMock{},
+				&transferLimitsMock{},
+				&feeCalculatorMock{},
+				nil,
+				nil,
+				&screenerMock{},
+			)
+
+			got, err := tb.TransactionBulk(context.Background(), input)
+
+			assert.ErrorIsf(t, err, tc.err, "TransactionBulk() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.locked, its.locked, "accounts are locked in the order of their ids")
+			assert.Equal(t, tc.added, its.added)
+			assert.Equal(t, tc.postings, its.postings)
+			assert.Equal(t, tc.balances, its.balances)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("TransactionBulk() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func Test_transactionBulk_TransactionBulk_limits(t *testing.T) {
+	t.Parallel()
+
+	bankAccount := testAccount()
+
+	input := usecase.TransactionBulkInput{
+		OrganizationName: bankAccount.OrganizationName,
+		OrganizationBic:  bankAccount.Bic,
+		OrganizationIban: bankAccount.Iban,
+		ValidateOnly:     true,
+		CreditTransfers: []usecase.TransactionBulkTransferInput{
+			{
+				Amount:           "300.00",
+				Currency:         "EUR",
+				CounterpartyName: "Bip Bip",
+				CounterpartyBic:  "CRLYFRPPTOU",
+				CounterpartyIban: "EE303680981021245685",
+				Description:      "Wonderland/4410",
+			},
+			{
+				Amount:           "200.00",
+				Currency:         "EUR",
+				CounterpartyName: "Bip Bip",
+				CounterpartyBic:  "CRLYFRPPTOU",
+				CounterpartyIban: "EE303680981021245685",
+				Description:      "Wonderland/4411",
+			},
+		},
+	}
+
+	accountLimit := model.TransferLimit{
+		ID:                 1,
+		TransferLimitState: model.TransferLimitState{BankAccountID: bankAccount.ID, MaxTransferCents: 50000},
+	}
+
+	tests := []struct {
+		name   string
+		limits []model.TransferLimit
+		usage  model.TransferUsage
+		locked []string
+		want   *model.TransferLimitError
+	}{
+		{
+			name:   "within the limits",
+			limits: []model.TransferLimit{accountLimit},
+		},
+		{
+			name:   "no limits",
+			limits: nil,
+			usage:  model.TransferUsage{DailyCents: 1000000},
+		},
+		{
+			name: "account limit exceeded",
+			limits: []model.TransferLimit{
+				{ID: 1, TransferLimitState: model.TransferLimitState{BankAccountID: bankAccount.ID, MaxBatchCents: 40000}},
+			},
+			want: &model.TransferLimitError{Limit: model.TransferLimitMaxBatch, Value: 40000, Requested: 50000},
+		},
+		{
+			name: "organization limit exceeded",
+			limits: []model.TransferLimit{
+				accountLimit,
+				{ID: 2, TransferLimitState: model.TransferLimitState{OrganizationName: "ACME Corp", DailyCents: 100000}},
+			},
+			usage:  model.TransferUsage{DailyCents: 60000},
+			locked: []string{"ACME Corp"},
+			want:   &model.TransferLimitError{Limit: model.TransferLimitDaily, Value: 100000, Requested: 110000},
+		},
+		{
+			name: "within the organization limits",
+			limits: []model.TransferLimit{
+				{ID: 2, TransferLimitState: model.TransferLimitState{OrganizationName: "ACME Corp", DailyCents: 100000}},
+				{ID: 3, TransferLimitState: model.TransferLimitState{OrganizationName: "ACME Corp", MonthlyCents: 200000}},
+			},
+			usage:  model.TransferUsage{DailyCents: 50000},
+			locked: []string{"ACME Corp"},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// validate-only transfers are rolled back.
+			st, mock := newTestStorage(t, false)
+
+			limits := &transferLimitsMock{limits: tc.limits, usage: tc.usage}
+
+			tb := usecase.NewTransactionBulk(
+				ctxd.NoOpLogger{},
+				st,
+				nil,
+				nil,
+				&accountFinderMock{t: t, bankAccount: &bankAccount},
+				testBalanceChecker(t, &bankAccount, 50000),
+				nil,
+				nil,
+				nil,
+				nil,
+				&rateProviderMock{},
+				&bankAccountsIbanFinderMock{},
+				nil,
+				clock.Fix(time.Date(2021, 12, 15, 9, 0, 0, 0, time.UTC)),
+				limits,
+				limits,
+				&feeCalculatorMock{},
+				nil,
+				nil,
+				&screenerMock{},
+			)
+
+			got, err := tb.TransactionBulk(context.Background(), input)
+
+			if merr := mock.ExpectationsWereMet(); merr != nil {
+				t.Errorf("TransactionBulk() expectations were not met = %v", merr)
+			}
+
+			// the organization usage is locked once, only when the organization is limited.
+			assert.Equal(t, tc.locked, limits.locked)
+
+			if tc.want == nil {
+				require.NoError(t, err)
+				assert.Equal(t, model.Cents(50000), got.DebitedCents)
+
+				return
+			}
+
+			assert.Nil(t, got)
+			assert.ErrorIs(t, err, model.ErrTransferLimitExceeded)
+
+			var lerr *model.TransferLimitError
+
+			require.ErrorAs(t, err, &lerr)
+			assert.Equal(t, tc.want, lerr)
+		})
+	}
+}
+
+func Test_transactionBulk_TransactionBulk_limitsConcurrently(t *testing.T) {
+	t.Parallel()
+
+	// the transfer bulks of two accounts of the organization within its daily limit each, not together.
+	limits := &transferLimitsMock{
+		limits: []model.TransferLimit{
+			{ID: 1, TransferLimitState: model.TransferLimitState{OrganizationName: "ACME Corp", DailyCents: 100000}},
+		},
+		delay: 50 * time.Millisecond,
+	}
+
+	its := &internalTransferStorageMock{}
+	recorder := &transferBulkRecorderMock{}
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, 2)
+	)
+
+	for i, iban := range []string{"FR10474608000002006107XXXXX", "FR7630006000011234567890189"} {
+		bankAccount := testAccount()
+		bankAccount.ID = model.BankAccountID(i + 1)
+		bankAccount.Iban = iban
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		// the transfer bulk performed first commits, the other rolls back.
+		mock.MatchExpectationsInOrder(false)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+		mock.ExpectRollback()
+
+		tb := usecase.NewTransactionBulk(
+			ctxd.NoOpLogger{},
+			sqluct.NewStorage(sqlx.NewDb(db, "sqlmock")),
+			&transferBulkReserverMock{t: t},
+			recorder,
+			&accountFinderMock{t: t, bankAccount: &bankAccount},
+			testBalanceChecker(t, &bankAccount, 60000),
+			its,
+			limits,
+			nil,
+			its,
+			&rateProviderMock{},
+			&bankAccountsIbanFinderMock{},
+			its,
+			clock.Fix(time.Date(2021, 12, 15, 9, 0, 0, 0, time.UTC)),
+			limits,
+			limits,
+			&feeCalculatorMock{},
+			nil,
+			recorder,
+			&screenerMock{},
+		)
+
+		input := usecase.TransactionBulkInput{
+			OrganizationName: bankAccount.OrganizationName,
+			OrganizationIban: bankAccount.Iban,
+			OrganizationBic:  bankAccount.Bic,
+			CreditTransfers: []usecase.TransactionBulkTransferInput{
+				{Amount: "600", Currency: "EUR", CounterpartyName: "Bip Bip", CounterpartyIban: "EE303680981021245685", CounterpartyBic: "CRLYFRPPTOU", Description: "Seeds"},
+			},
+		}
+
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			_, errs[i] = tb.TransactionBulk(context.Background(), input)
+
+			// the transaction ended, the organization usage is unlocked.
+			limits.unlock()
+		}(i)
+	}
+
+	wg.Wait()
+
+	var succeeded, denied int
+
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, model.ErrTransferLimitExceeded):
+			denied++
+		default:
+			t.Errorf("TransactionBulk() got unexpected err = %v", err)
+		}
+	}
+
+	assert.Equal(t, 1, succeeded, "one transfer bulk performed")
+	assert.Equal(t, 1, denied, "the other transfer bulk breaches the organization limit")
+	assert.Equal(t, model.Cents(60000), limits.usage.DailyCents)
+	assert.Equal(t, []string{"ACME Corp", "ACME Corp"}, limits.locked)
+}
+
+func Test_transactionBulk_TransactionBulk_fees(t *testing.T) {
+	t.Parallel()
+
+	debtor := testAccount()
+
+	input := usecase.TransactionBulkInput{
+		OrganizationName: debtor.OrganizationName,
+		OrganizationBic:  debtor.Bic,
+		OrganizationIban: debtor.Iban,
+		CreditTransfers: []usecase.TransactionBulkTransferInput{
+			{
+				Amount:           "100.00",
+				Currency:         "EUR",
+				CounterpartyName: "Bip Bip",
+				CounterpartyBic:  "CRLYFRPPTOU",
+				CounterpartyIban: "EE303680981021245685",
+				Description:      "Wonderland/4410",
+			},
+			{
+				Amount:           "200.00",
+				Currency:         "EUR",
+				CounterpartyName: "Road Runner",
+				CounterpartyBic:  "CRLYFRPPTOU",
+				CounterpartyIban: "FR1420041010050500013M02606",
+				Description:      "Wonderland/4411",
+				Instant:          true,
+			},
+			{
+				Amount:           "300.00",
+				Currency:         "EUR",
+				CounterpartyName: "Wile E Coyote",
+				CounterpartyBic:  "TGBATRISXXX",
+				CounterpartyIban: "TR330006100519786457841326",
+				Description:      "Wonderland/4412",
+			},
+		},
+	}
+
+	fees := map[model.TransferKind]model.Cents{
+		model.TransferKindInstant:       50,
+		model.TransferKindInternational: 500,
+	}
+
+	transfer := func(i int, amountCents model.Cents) model.TransactionState {
+		return testTransfer(debtor, input.CreditTransfers[i], amountCents)
+	}
+
+	fee := func(i int, id model.TransactionID, kind model.TransferKind, feeCents model.Cents) model.TransactionState {
+		fee := transfer(i, feeCents)
+		fee.Description = "Fee " + string(kind) + " transfer: " + fee.Description
+		fee.ChargedTransactionID = id
+
+		return fee
+	}
+
+	tests := []struct {
+		name     string
+		fees     map[model.TransferKind]model.Cents
+		checked  model.Cents
+		err      error
+		want     *usecase.TransactionBulkOutput
+		added    []model.TransactionState
+		postings []model.PostingState
+	}{
+		{
+			name:    "fees charged by kind of credit transfer",
+			fees:    fees,
+			checked: 60550,
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				TransactionIDs: []model.TransactionID{1, 2, 3},
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowExecuted, TransactionID: 1},
+					{Status: model.TransferBulkRowExecuted, TransactionID: 2},
+					{Status: model.TransferBulkRowExecuted, TransactionID: 3},
+				},
+				DebitedCents: 60000,
+				FeesCents:    550,
+				BalanceCents: 39450,
+				Currency:     "EUR",
+				Status:       model.TransferBulkExecuted,
+			},
+			added: []model.TransactionState{
+				transfer(0, 10000),
+				transfer(1, 20000),
+				transfer(2, 30000),
+				fee(1, 2, model.TransferKindInstant, 50),
+				fee(2, 3, model.TransferKindInternational, 500),
+			},
+			postings: []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: debtor.ID, AmountCents: -60000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 1, AmountCents: 10000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 2, AmountCents: 20000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 3, AmountCents: 30000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: debtor.ID, AmountCents: -550, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountFeeIncome, TransactionID: 4, AmountCents: 50, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountFeeIncome, TransactionID: 5, AmountCents: 500, Currency: "EUR"},
+			},
+		},
+		{
+			name:    "no fees charged",
+			checked: 60000,
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				TransactionIDs: []model.TransactionID{1, 2, 3},
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowExecuted, TransactionID: 1},
+					{Status: model.TransferBulkRowExecuted, TransactionID: 2},
+					{Status: model.TransferBulkRowExecuted, TransactionID: 3},
+				},
+				DebitedCents: 60000,
+				BalanceCents: 40000,
+				Currency:     "EUR",
+				Status:       model.TransferBulkExecuted,
+			},
+			added: []model.TransactionState{
+				transfer(0, 10000),
+				transfer(1, 20000),
+				transfer(2, 30000),
+			},
+			postings: []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: debtor.ID, AmountCents: -60000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 1, AmountCents: 10000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 2, AmountCents: 20000, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 3, AmountCents: 30000, Currency: "EUR"},
+			},
+		},
+		{
+			name:    "balance covering the amounts but not the fees",
+			fees:    fees,
+			checked: 60550,
+			err:     storage.ErrNotEnoughBalance,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			st, mock := newTestStorage(t, tc.err == nil)
+
+			its := &internalTransferStorageMock{}
+
+			var completed model.TransferBulk
+
+			if tc.want != nil {
+				completed = model.TransferBulk{
+					ID: 1,
+					TransferBulkState: model.TransferBulkState{
+						BankAccountID: debtor.ID,
+						DebitedCents:  tc.want.DebitedCents,
+						FeesCents:     tc.want.FeesCents,
+						BalanceCents:  tc.want.BalanceCents,
+						Currency:      "EUR",
+					},
+				}
+			}
+
+			checker := testBalanceChecker(t, &debtor, tc.checked)
+
+			if tc.err != nil {
+				checker.bankAccount = nil
+				checker.err = tc.err
+			}
+
+			tb := usecase.NewTransactionBulk(
+				ctxd.NoOpLogger{},
+				st,
+				&transferBulkReserverMock{t: t},
+				&transferBulkCompleterMock{t: t, transferBulk: completed},
+				&accountFinderMock{t: t, bankAccount: &debtor},
+				checker,
+				its,
+				its,
+				nil,
+				its,
+				&rateProviderMock{},
+				&bankAccountsIbanFinderMock{},
+				its,
+				clock.New(),
+				&transferLimitsMock{},
+				&transferLimitsMock{},
+				&feeCalculatorMock{fees: tc.fees},
+				nil,
+				nil,
+				&screenerMock{},
+			)
+
+			got, err := tb.TransactionBulk(context.Background(), input)
+
+			assert.ErrorIsf(t, err, tc.err, "TransactionBulk() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.added, its.added)
+			assert.Equal(t, tc.postings, its.postings)
+
+			if tc.want != nil {
+				assert.Equal(t, map[model.BankAccountID]model.Cents{debtor.ID: tc.want.BalanceCents - debtor.BalanceCents}, its.balances)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("TransactionBulk() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+// transferBulkRecorderMock records the completed transfer bulks and the decisions on them.
+type transferBulkRecorderMock struct {
+	mu sync.Mutex
+
+	completed []model.TransferBulk
+	decisions []model.TransferBulkDecisionState
+}
+
+func (tbrm *transferBulkRecorderMock) Complete(_ context.Context, transferBulk model.TransferBulk) error {
+	tbrm.mu.Lock()
+	defer tbrm.mu.Unlock()
+
+	tbrm.completed = append(tbrm.completed, transferBulk)
+
+	return nil
+}
+
+func (tbrm *transferBulkRecorderMock) Add(_ context.Context, decisionState model.TransferBulkDecisionState) (*model.TransferBulkDecision, error) {
+	tbrm.mu.Lock()
+	defer tbrm.mu.Unlock()
+
+	tbrm.decisions = append(tbrm.decisions, decisionState)
+
+	return &model.TransferBulkDecision{
+		ID:                        model.TransferBulkDecisionID(len(tbrm.decisions)),
+		TransferBulkDecisionState: decisionState,
+	}, nil
+}
+
+func Test_transactionBulk_TransactionBulk_approval(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 17, 9, 0, 0, 0, time.UTC)
+
+	debtor := model.BankAccount{
+		ID: 2,
+		BankAccountState: model.BankAccountState{
+			OrganizationName: "ACME Corp",
+			BalanceCents:     100000,
+			Iban:             "FR10474608000002006107XXXXX",
+			Bic:              "OIVUSCLQXXX",
+			Currency:         "EUR",
+		},
+	}
+
+	input := usecase.TransactionBulkInput{
+		OrganizationName: debtor.OrganizationName,
+		OrganizationIban: debtor.Iban,
+		OrganizationBic:  debtor.Bic,
+		CreditTransfers: []usecase.TransactionBulkTransferInput{
+			{Amount: "100", Currency: "EUR", CounterpartyName: "Bip Bip", CounterpartyIban: "EE303680981021245685", CounterpartyBic: "CRLYFRPPTOU", Description: "Seeds"},
+			{Amount: "500", Currency: "EUR", CounterpartyName: "Bugs Bunny", CounterpartyIban: "FR9810009380540930414023042", CounterpartyBic: "RNJZNTMC", Description: "Carrots"},
+		},
+		IdempotencyKey: "payroll",
+		SubmittedBy:    "alice",
+	}
+
+	awaitingRows := []model.TransferBulkRow{
+		{Status: model.TransferBulkRowAwaitingApproval},
+		{Status: model.TransferBulkRowAwaitingApproval},
+	}
+
+	tests := []struct {
+		name       string
+		thresholds model.ApprovalThresholds
+		hits       map[string]model.ScreeningHit
+		stored     *model.TransferBulk
+		anonymous  bool
+		want       *usecase.TransactionBulkOutput
+		completed  model.TransferBulkStatus
+		decisions  []model.TransferBulkDecisionState
+		added      int
+		err        error
+	}{
+		{
+			name:       "transfer bulk exceeding the threshold awaits approval",
+			thresholds: model.ApprovalThresholds{"EUR": 50000},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				Rows:           awaitingRows,
+				DebitedCents:   60000,
+				BalanceCents:   100000,
+				Currency:       "EUR",
+				Status:         model.TransferBulkAwaitingApproval,
+			},
+			completed: model.TransferBulkAwaitingApproval,
+			decisions: []model.TransferBulkDecisionState{
+				{TransferBulkID: 1, Decision: model.TransferBulkSubmitted, UserID: "alice", CreatedAt: now},
+			},
+		},
+		{
+			name:       "transfer bulk matching the lists held for review",
+			thresholds: model.ApprovalThresholds{"EUR": 60000},
+			hits: map[string]model.ScreeningHit{
+				"FR9810009380540930414023042": {EntryID: "SDN-1", EntryName: "Bugs Bunny", MatchedOn: model.ScreeningIban, Score: 1},
+			},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowAwaitingApproval},
+					{Status: model.TransferBulkRowHeldForReview, Reason: "screening match on iban: SDN-1 Bugs Bunny, score 1.00"},
+				},
+				DebitedCents: 60000,
+				BalanceCents: 100000,
+				Currency:     "EUR",
+				Status:       model.TransferBulkHeldForReview,
+			},
+			completed: model.TransferBulkHeldForReview,
+			decisions: []model.TransferBulkDecisionState{
+				{TransferBulkID: 1, Decision: model.TransferBulkSubmitted, UserID: "alice", CreatedAt: now},
+			},
+		},
+		{
+			name:       "transfer bulk within the threshold performed",
+			thresholds: model.ApprovalThresholds{"EUR": 60000},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				TransactionIDs: []model.TransactionID{1, 2},
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowExecuted, TransactionID: 1},
+					{Status: model.TransferBulkRowExecuted, TransactionID: 2},
+				},
+				DebitedCents: 60000,
+				BalanceCents: 40000,
+				Currency:     "EUR",
+				Status:       model.TransferBulkExecuted,
+			},
+			completed: model.TransferBulkExecuted,
+			added:     2,
+		},
+		{
+			name:       "transfer bulk exceeding the threshold submitted without user",
+			thresholds: model.ApprovalThresholds{"EUR": 50000},
+			anonymous:  true,
+			err:        model.ErrUnknownSubmitter,
+		},
+		{
+			name:       "transfer bulk matching the lists submitted without user",
+			thresholds: model.ApprovalThresholds{"EUR": 60000},
+			hits: map[string]model.ScreeningHit{
+				"FR9810009380540930414023042": {EntryID: "SDN-1", EntryName: "Bugs Bunny", MatchedOn: model.ScreeningIban, Score: 1},
+			},
+			anonymous: true,
+			err:       model.ErrUnknownSubmitter,
+		},
+		{
+			name:       "transfer bulk within the threshold submitted without user performed",
+			thresholds: model.ApprovalThresholds{"EUR": 60000},
+			anonymous:  true,
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				TransactionIDs: []model.TransactionID{1, 2},
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowExecuted, TransactionID: 1},
+					{Status: model.TransferBulkRowExecuted, TransactionID: 2},
+				},
+				DebitedCents: 60000,
+				BalanceCents: 40000,
+				Currency:     "EUR",
+				Status:       model.TransferBulkExecuted,
+			},
+			completed: model.TransferBulkExecuted,
+			added:     2,
+		},
+		{
+			name:       "transfer bulk awaiting approval already, idempotent replay",
+			thresholds: model.ApprovalThresholds{"EUR": 50000},
+			stored: &model.TransferBulk{
+				ID: 1,
+				TransferBulkState: model.TransferBulkState{
+					BankAccountID: debtor.ID,
+					DebitedCents:  60000,
+					BalanceCents:  100000,
+					Currency:      "EUR",
+					Status:        model.TransferBulkAwaitingApproval,
+				},
+			},
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				Rows:           awaitingRows,
+				DebitedCents:   60000,
+				BalanceCents:   100000,
+				Currency:       "EUR",
+				Status:         model.TransferBulkAwaitingApproval,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			st, mock := newTestStorage(t, tc.err == nil)
+
+			its := &internalTransferStorageMock{}
+			recorder := &transferBulkRecorderMock{}
+
+			tb := usecase.NewTransactionBulk(
+				ctxd.NoOpLogger{},
+				st,
+				&transferBulkReserverMock{t: t, idempotencyKey: "payroll", stored: tc.stored},
+				recorder,
+				&accountFinderMock{t: t, bankAccount: &debtor},
+				testBalanceChecker(t, &debtor, 60000),
+				its,
+				its,
+				&transactionIDsFinderMock{t: t, transferBulkID: 1},
+				its,
+				&rateProviderMock{},
+				&bankAccountsIbanFinderMock{},
+				its,
+				clock.Fix(now),
+				&transferLimitsMock{},
+				&transferLimitsMock{},
+				&feeCalculatorMock{},
+				tc.thresholds,
+				recorder,
+				&screenerMock{hits: tc.hits},
+			)
+
+			input := input
+			if tc.anonymous {
+				input.SubmittedBy = ""
+			}
+
+			got, err := tb.TransactionBulk(context.Background(), input)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.want, got)
+			assert.Len(t, its.added, tc.added)
+			assert.Equal(t, tc.decisions, recorder.decisions)
+
+			if tc.completed == "" {
+				assert.Empty(t, recorder.completed)
+			} else {
+				require.Len(t, recorder.completed, 1)
+				assert.Equal(t, tc.completed, recorder.completed[0].Status)
+			}
+
+			if tc.completed.Pending() {
+				assert.Nil(t, its.balances, "awaiting approval, the balance is not debited")
+				assert.Equal(t, "alice", recorder.completed[0].SubmittedBy)
+				assert.Len(t, recorder.completed[0].ScreeningHits, len(tc.hits))
+
+				var request usecase.TransactionBulkInput
+
+				require.NoError(t, json.Unmarshal([]byte(recorder.completed[0].Request), &request))
+				assert.Equal(t, input.CreditTransfers, request.CreditTransfers)
+			}
 
 			if err = mock.ExpectationsWereMet(); err != nil {
 				t.Errorf("TransactionBulk() expectations were not met = %v", err)
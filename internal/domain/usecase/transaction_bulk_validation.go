@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const (
+	// MaxCreditTransfers is the maximum number of credit transfers of a transfer bulk.
+	MaxCreditTransfers = 1000
+	// MaxDescriptionLength is the maximum number of characters of a transfer description, as the SEPA
+	// unstructured remittance information.
+	MaxDescriptionLength = 140
+	// MaxCounterpartyNameLength is the maximum number of characters of a counterparty name.
+	MaxCounterpartyNameLength = 140
+)
+
+// ErrInvalidInput error represents when the use case input does not pass the validation.
+var ErrInvalidInput = errors.New("invalid input")
+
+// FieldViolation describes a field of the use case input that does not pass the validation.
+type FieldViolation struct {
+	// Field is the path of the field, e.g. "credit_transfers[2].counterparty_iban".
+	Field       string
+	Description string
+}
+
+// ValidationError contains the field violations of the use case input.
+//
+// It matches ErrInvalidInput.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// Error returns the error message.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+
+	for i, v := range e.Violations {
+		msgs[i] = v.Field + ": " + v.Description
+	}
+
+	return fmt.Sprintf("%s: %s", ErrInvalidInput, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns ErrInvalidInput.
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidInput
+}
+
+func (e *ValidationError) add(field string, err error) {
+	e.Violations = append(e.Violations, FieldViolation{
+		Field:       field,
+		Description: err.Error(),
+	})
+}
+
+// ValidateTransactionBulkInput validates the input and returns the amounts of the credit transfers in cents, in the
+// currency of each transfer.
+//
+// Returns a *ValidationError with all the field violations when the input is not valid.
+func ValidateTransactionBulkInput(input TransactionBulkInput) ([]model.Cents, error) {
+	var verr ValidationError
+
+	if input.OrganizationName == "" {
+		verr.add("organization_name", errors.New("must not be empty"))
+	}
+
+	if input.OrganizationIban == "" {
+		verr.add("organization_iban", errors.New("must not be empty"))
+	}
+
+	if input.OrganizationBic == "" {
+		verr.add("organization_bic", errors.New("must not be empty"))
+	}
+
+	switch {
+	case len(input.CreditTransfers) == 0:
+		verr.add("credit_transfers", errors.New("must not be empty"))
+	case len(input.CreditTransfers) > MaxCreditTransfers:
+		verr.add("credit_transfers", fmt.Errorf("must not have more than %d transfers", MaxCreditTransfers))
+	}
+
+	amountsCents := make([]model.Cents, len(input.CreditTransfers))
+
+	var total model.Cents
+
+	for i, transfer := range input.CreditTransfers {
+		row := fmt.Sprintf("credit_transfers[%d].", i)
+
+		amountCents := validateTransfer(&verr, row, transfer)
+
+		switch {
+		case amountCents == 0:
+		case total > math.MaxInt64-amountCents:
+			verr.add(row+"amount", fmt.Errorf("%w: total amount is out of range", model.ErrInvalidAmount))
+		default:
+			amountsCents[i] = amountCents
+			total += amountCents
+		}
+	}
+
+	if len(verr.Violations) > 0 {
+		return nil, &verr
+	}
+
+	return amountsCents, nil
+}
+
+// validateTransfer validates the credit transfer and returns its amount in cents, 0 when the amount is not valid. The
+// violations are added to verr, the fields prefixed by row.
+func validateTransfer(verr *ValidationError, row string, transfer TransactionBulkTransferInput) model.Cents {
+	var amountCents model.Cents
+
+	if _, ok := model.CurrencyMinorUnit(transfer.Currency); !ok {
+		verr.add(row+"currency", fmt.Errorf("%w: %q is not an ISO 4217 currency", model.ErrUnknownCurrency, transfer.Currency))
+	} else {
+		cents, err := model.ParseCents(transfer.Amount, transfer.Currency)
+
+		switch {
+		case err != nil:
+			verr.add(row+"amount", err)
+		case cents <= 0:
+			verr.add(row+"amount", fmt.Errorf("%w: must be positive", model.ErrInvalidAmount))
+		default:
+			amountCents = cents
+		}
+	}
+
+	if transfer.CounterpartyName == "" {
+		verr.add(row+"counterparty_name", errors.New("must not be empty"))
+	} else if utf8.RuneCountInString(transfer.CounterpartyName) > MaxCounterpartyNameLength {
+		verr.add(row+"counterparty_name", fmt.Errorf("must not have more than %d characters", MaxCounterpartyNameLength))
+	}
+
+	if err := model.ValidateIBAN(transfer.CounterpartyIban); err != nil {
+		verr.add(row+"counterparty_iban", err)
+	}
+
+	if err := model.ValidateBIC(transfer.CounterpartyBic); err != nil {
+		verr.add(row+"counterparty_bic", err)
+	}
+
+	if transfer.Description == "" {
+		verr.add(row+"description", errors.New("must not be empty"))
+	} else if utf8.RuneCountInString(transfer.Description) > MaxDescriptionLength {
+		verr.add(row+"description", fmt.Errorf("must not have more than %d characters", MaxDescriptionLength))
+	}
+
+	return amountCents
+}
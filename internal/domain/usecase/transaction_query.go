@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const (
+	// DefaultTransactionsPageSize is the number of transactions listed when the page size is not given.
+	DefaultTransactionsPageSize = 50
+	// MaxTransactionsPageSize is the maximum number of transactions listed at once.
+	MaxTransactionsPageSize = 100
+)
+
+// TransactionQuery defines the functionality of the use case TransactionQuery used to read the transactions of a bank
+// account.
+type TransactionQuery interface {
+	// ListTransactions lists a page of the transactions of the bank account matching the input filters.
+	ListTransactions(ctx context.Context, input ListTransactionsInput) (*ListTransactionsOutput, error)
+	// Transaction returns the transaction of the bank account.
+	Transaction(ctx context.Context, iban string, id model.TransactionID) (*model.Transaction, error)
+}
+
+// ListTransactionsInput is the input of the use case TransactionQuery used to list transactions.
+type ListTransactionsInput struct {
+	Iban string
+	// From and To are RFC 3339 date times, From is inclusive and To exclusive. Empty values do not filter.
+	From string
+	To   string
+	// Counterparty matches part of the counterparty name, or the whole counterparty iban.
+	Counterparty string
+	// MinAmountCents and MaxAmountCents are the inclusive bounds of the debited amount, in the bank account currency.
+	MinAmountCents *model.Cents
+	MaxAmountCents *model.Cents
+	// Sort is the order of the transactions, newest first when empty.
+	Sort model.TransactionSort
+	// PageSize is the maximum number of transactions listed, DefaultTransactionsPageSize when 0.
+	PageSize int
+	// PageToken is the NextPageToken of the previous page, empty for the first page.
+	PageToken string
+}
+
+// ListTransactionsOutput is the output of the use case TransactionQuery used to list transactions.
+type ListTransactionsOutput struct {
+	Transactions []model.Transaction
+	// NextPageToken lists the next page, empty when there are no more transactions.
+	NextPageToken string
+}
+
+// BankAccountIbanFinder is a storage interface that defines the functionality to find the bank account by iban.
+type BankAccountIbanFinder interface {
+	// FindByIban finds the bank account of the iban from a storage.
+	FindByIban(ctx context.Context, iban string) (*model.BankAccount, error)
+}
+
+// TransactionFinder is a storage interface that defines the functionality to find the transaction.
+type TransactionFinder interface {
+	// Find finds the transaction of the bank account from a storage.
+	Find(ctx context.Context, bankAccountID model.BankAccountID, id model.TransactionID) (*model.Transaction, error)
+}
+
+// TransactionLister is a storage interface that defines the functionality to list transactions.
+type TransactionLister interface {
+	// List lists the transactions matching the filter from a storage.
+	List(ctx context.Context, filter model.TransactionFilter) ([]model.Transaction, error)
+}
+
+type transactionQuery struct {
+	accountFinder     BankAccountIbanFinder
+	transactionFinder TransactionFinder
+	lister            TransactionLister
+}
+
+var _ TransactionQuery = new(transactionQuery)
+
+// NewTransactionQuery creates an instance of TransactionQuery use case.
+func NewTransactionQuery(
+	accountFinder BankAccountIbanFinder,
+	transactionFinder TransactionFinder,
+	lister TransactionLister,
+) TransactionQuery {
+	return &transactionQuery{
+		accountFinder:     accountFinder,
+		transactionFinder: transactionFinder,
+		lister:            lister,
+	}
+}
+
+// ListTransactions lists a page of the transactions of the bank account matching the input filters.
+func (tq *transactionQuery) ListTransactions(ctx context.Context, input ListTransactionsInput) (*ListTransactionsOutput, error) {
+	ctx = ctxd.AddFields(ctx, "iban", input.Iban)
+
+	filter, err := transactionFilter(input)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to validate input")
+	}
+
+	account, err := tq.accountFinder.FindByIban(ctx, input.Iban)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to find account")
+	}
+
+	filter.BankAccountID = account.ID
+
+	// one more transaction is listed to know whether there is a next page.
+	pageSize := filter.Limit
+	filter.Limit++
+
+	transactions, err := tq.lister.List(ctx, filter)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to list transactions")
+	}
+
+	output := ListTransactionsOutput{
+		Transactions: transactions,
+	}
+
+	if len(transactions) > pageSize {
+		output.Transactions = transactions[:pageSize]
+
+		last := output.Transactions[pageSize-1]
+
+		output.NextPageToken = encodePageToken(model.TransactionCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+	}
+
+	return &output, nil
+}
+
+// Transaction returns the transaction of the bank account.
+func (tq *transactionQuery) Transaction(ctx context.Context, iban string, id model.TransactionID) (*model.Transaction, error) {
+	ctx = ctxd.AddFields(ctx, "iban", iban, "transaction_id", id)
+
+	account, err := tq.accountFinder.FindByIban(ctx, iban)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to find account")
+	}
+
+	transaction, err := tq.transactionFinder.Find(ctx, account.ID, id)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to find transaction")
+	}
+
+	return transaction, nil
+}
+
+// transactionFilter validates the input and returns the filter of the transactions to list.
+//
+// Returns a *ValidationError with all the field violations when the input is not valid.
+func transactionFilter(input ListTransactionsInput) (model.TransactionFilter, error) {
+	var (
+		verr   ValidationError
+		filter = model.TransactionFilter{
+			Counterparty:   input.Counterparty,
+			MinAmountCents: input.MinAmountCents,
+			MaxAmountCents: input.MaxAmountCents,
+			Sort:           input.Sort,
+			Limit:          input.PageSize,
+		}
+		err error
+	)
+
+	if input.Iban == "" {
+		verr.add("iban", errors.New("must not be empty"))
+	}
+
+	if input.From != "" {
+		if filter.From, err = time.Parse(time.RFC3339, input.From); err != nil {
+			verr.add("from", errors.New("must be a RFC 3339 date time"))
+		}
+	}
+
+	if input.To != "" {
+		if filter.To, err = time.Parse(time.RFC3339, input.To); err != nil {
+			verr.add("to", errors.New("must be a RFC 3339 date time"))
+		}
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		verr.add("to", errors.New("must be after from"))
+	}
+
+	if filter.MinAmountCents != nil && filter.MaxAmountCents != nil && *filter.MinAmountCents > *filter.MaxAmountCents {
+		verr.add("max_amount_cents", errors.New("must not be less than min_amount_cents"))
+	}
+
+	switch filter.Sort {
+	case "":
+		filter.Sort = model.TransactionSortNewest
+	case model.TransactionSortNewest, model.TransactionSortOldest:
+	default:
+		verr.add("sort", fmt.Errorf("must be %s or %s", model.TransactionSortNewest, model.TransactionSortOldest))
+	}
+
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = DefaultTransactionsPageSize
+	case filter.Limit < 0 || filter.Limit > MaxTransactionsPageSize:
+		verr.add("page_size", fmt.Errorf("must be between 1 and %d", MaxTransactionsPageSize))
+	}
+
+	if input.PageToken != "" {
+		cursor, err := decodePageToken(input.PageToken)
+		if err != nil {
+			verr.add("page_token", err)
+		}
+
+		filter.After = cursor
+	}
+
+	if len(verr.Violations) > 0 {
+		return model.TransactionFilter{}, &verr
+	}
+
+	return filter, nil
+}
+
+// encodePageToken encodes the cursor as an opaque page token.
+func encodePageToken(cursor model.TransactionCursor) string {
+	token := fmt.Sprintf("%d:%d", cursor.CreatedAt.UnixNano(), cursor.ID)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+// decodePageToken decodes the cursor of the page token.
+func decodePageToken(token string) (*model.TransactionCursor, error) {
+	errInvalid := errors.New("is not valid")
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errInvalid
+	}
+
+	var (
+		nanos int64
+		id    model.TransactionID
+	)
+
+	if _, err := fmt.Sscanf(string(b), "%d:%d", &nanos, &id); err != nil {
+		return nil, errInvalid
+	}
+
+	return &model.TransactionCursor{
+		CreatedAt: time.Unix(0, nanos).UTC(),
+		ID:        id,
+	}, nil
+}
@@ -0,0 +1,257 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// transactionStorageMock mocks the bank account and transaction storage, the listed filter is kept to be asserted.
+type transactionStorageMock struct {
+	account      *model.BankAccount
+	transactions []model.Transaction
+	err          error
+
+	filter *model.TransactionFilter
+}
+
+func (tsm *transactionStorageMock) FindByIban(_ context.Context, iban string) (*model.BankAccount, error) {
+	if tsm.account == nil || tsm.account.Iban != iban {
+		return nil, storage.ErrNotFound
+	}
+
+	return tsm.account, nil
+}
+
+func (tsm *transactionStorageMock) Find(_ context.Context, bankAccountID model.BankAccountID, id model.TransactionID) (*model.Transaction, error) {
+	for _, transaction := range tsm.transactions {
+		if transaction.ID == id && transaction.BankAccountID == bankAccountID {
+			return &transaction, nil
+		}
+	}
+
+	return nil, storage.ErrNotFound
+}
+
+func (tsm *transactionStorageMock) List(_ context.Context, filter model.TransactionFilter) ([]model.Transaction, error) {
+	tsm.filter = &filter
+
+	if tsm.err != nil {
+		return nil, tsm.err
+	}
+
+	if filter.Limit > 0 && len(tsm.transactions) > filter.Limit {
+		return tsm.transactions[:filter.Limit], nil
+	}
+
+	return tsm.transactions, nil
+}
+
+func queryTransactions(n int) []model.Transaction {
+	createdAt := time.Date(2021, 12, 7, 9, 0, 0, 0, time.UTC)
+	transactions := make([]model.Transaction, n)
+
+	for i := range transactions {
+		transactions[i] = model.Transaction{
+			ID: model.TransactionID(n - i),
+			TransactionState: model.TransactionState{
+				CounterpartyName: "Bip Bip",
+				BankAccountID:    1,
+				DebitedCents:     1450,
+				CreatedAt:        createdAt.Add(-time.Duration(i) * time.Minute),
+			},
+		}
+	}
+
+	return transactions
+}
+
+func Test_transactionQuery_ListTransactions(t *testing.T) {
+	t.Parallel()
+
+	account := &model.BankAccount{
+		ID:               1,
+		BankAccountState: model.BankAccountState{Iban: "FR10474608000002006107XXXXX", Currency: "EUR"},
+	}
+
+	minAmount := model.Cents(2000)
+	maxAmount := model.Cents(1000)
+
+	tests := []struct {
+		name    string
+		input   usecase.ListTransactionsInput
+		storage *transactionStorageMock
+		want    []model.Transaction
+		filter  *model.TransactionFilter
+		next    bool
+		err     error
+	}{
+		{
+			name:    "first page listed, newest first by default",
+			input:   usecase.ListTransactionsInput{Iban: account.Iban, PageSize: 2},
+			storage: &transactionStorageMock{account: account, transactions: queryTransactions(3)},
+			want:    queryTransactions(3)[:2],
+			filter: &model.TransactionFilter{
+				BankAccountID: 1,
+				Sort:          model.TransactionSortNewest,
+				Limit:         3,
+			},
+			next: true,
+		},
+		{
+			name: "last page listed with filters",
+			input: usecase.ListTransactionsInput{
+				Iban:         account.Iban,
+				From:         "2021-12-01T00:00:00Z",
+				To:           "2021-12-08T00:00:00+01:00",
+				Counterparty: "Bip",
+				Sort:         model.TransactionSortOldest,
+			},
+			storage: &transactionStorageMock{account: account, transactions: queryTransactions(2)},
+			want:    queryTransactions(2),
+			filter: &model.TransactionFilter{
+				BankAccountID: 1,
+				From:          time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+				To:            time.Date(2021, 12, 8, 0, 0, 0, 0, time.FixedZone("", 3600)),
+				Counterparty:  "Bip",
+				Sort:          model.TransactionSortOldest,
+				Limit:         usecase.DefaultTransactionsPageSize + 1,
+			},
+		},
+		{
+			name:    "account not found",
+			input:   usecase.ListTransactionsInput{Iban: "FR1420041010050500013M02606"},
+			storage: &transactionStorageMock{account: account},
+			err:     storage.ErrNotFound,
+		},
+		{
+			name: "invalid input",
+			input: usecase.ListTransactionsInput{
+				From:           "yesterday",
+				MinAmountCents: &minAmount,
+				MaxAmountCents: &maxAmount,
+				Sort:           "random",
+				PageSize:       usecase.MaxTransactionsPageSize + 1,
+				PageToken:      "!",
+			},
+			storage: &transactionStorageMock{account: account},
+			err:     usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tq := usecase.NewTransactionQuery(tc.storage, tc.storage, tc.storage)
+
+			got, err := tq.ListTransactions(context.Background(), tc.input)
+
+			assert.ErrorIsf(t, err, tc.err, "ListTransactions() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.filter, tc.storage.filter, "ListTransactions() got filter = %v, want %v", tc.storage.filter, tc.filter)
+
+			if tc.err != nil {
+				assert.Nil(t, got)
+
+				return
+			}
+
+			require.NotNil(t, got)
+			assert.Equal(t, tc.want, got.Transactions)
+			assert.Equal(t, tc.next, got.NextPageToken != "", "ListTransactions() got next page token = %q", got.NextPageToken)
+		})
+	}
+}
+
+func Test_transactionQuery_ListTransactions_nextPage(t *testing.T) {
+	t.Parallel()
+
+	account := &model.BankAccount{
+		ID:               1,
+		BankAccountState: model.BankAccountState{Iban: "FR10474608000002006107XXXXX"},
+	}
+
+	transactions := queryTransactions(3)
+	st := &transactionStorageMock{account: account, transactions: transactions}
+	tq := usecase.NewTransactionQuery(st, st, st)
+
+	first, err := tq.ListTransactions(context.Background(), usecase.ListTransactionsInput{Iban: account.Iban, PageSize: 2})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.NextPageToken)
+
+	st.transactions = transactions[2:]
+
+	second, err := tq.ListTransactions(context.Background(), usecase.ListTransactionsInput{
+		Iban:      account.Iban,
+		PageSize:  2,
+		PageToken: first.NextPageToken,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, &model.TransactionCursor{CreatedAt: transactions[1].CreatedAt, ID: transactions[1].ID}, st.filter.After)
+	assert.Equal(t, transactions[2:], second.Transactions)
+	assert.Empty(t, second.NextPageToken)
+}
+
+func Test_transactionQuery_Transaction(t *testing.T) {
+	t.Parallel()
+
+	account := &model.BankAccount{
+		ID:               1,
+		BankAccountState: model.BankAccountState{Iban: "FR10474608000002006107XXXXX"},
+	}
+
+	transactions := queryTransactions(2)
+	transactions[1].BankAccountID = 2
+
+	tests := []struct {
+		name string
+		iban string
+		id   model.TransactionID
+		want *model.Transaction
+		err  error
+	}{
+		{
+			name: "transaction found",
+			iban: account.Iban,
+			id:   2,
+			want: &transactions[0],
+		},
+		{
+			name: "transaction of another account",
+			iban: account.Iban,
+			id:   1,
+			err:  storage.ErrNotFound,
+		},
+		{
+			name: "account not found",
+			iban: "FR1420041010050500013M02606",
+			id:   2,
+			err:  storage.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			st := &transactionStorageMock{account: account, transactions: transactions}
+			tq := usecase.NewTransactionQuery(st, st, st)
+
+			got, err := tq.Transaction(context.Background(), tc.iban, tc.id)
+
+			assert.ErrorIsf(t, err, tc.err, "Transaction() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.want, got, "Transaction() got = %v, want %v", got, tc.want)
+		})
+	}
+}
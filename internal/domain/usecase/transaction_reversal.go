@@ -0,0 +1,383 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/nhatthm/go-clock"
+)
+
+// TransactionReversal defines the functionality of the use case TransactionReversal used to reverse transactions.
+//
+// A reversal is a transaction crediting back the bank account, linked to the transaction it reverses. A transaction
+// can be reversed in several times, partially, until its whole debited amount is reversed.
+type TransactionReversal interface {
+	// ReverseTransaction reverses the transaction of the bank account, the amount not reversed yet when the input
+	// amount is 0.
+	ReverseTransaction(ctx context.Context, input ReverseTransactionInput) (*ReversalOutput, error)
+	// ReverseTransferBulk reverses the amounts not reversed yet of the transactions performed by the transfer bulk.
+	ReverseTransferBulk(ctx context.Context, input ReverseTransferBulkInput) (*ReversalOutput, error)
+}
+
+// ReverseTransactionInput is the input of the use case TransactionReversal used to reverse a transaction.
+type ReverseTransactionInput struct {
+	Iban          string
+	TransactionID model.TransactionID
+	// AmountCents is the amount to reverse, in the bank account currency. The amount not reversed yet when 0.
+	AmountCents model.Cents
+	Reason      model.ReversalReason
+}
+
+// ReverseTransferBulkInput is the input of the use case TransactionReversal used to reverse a transfer bulk.
+type ReverseTransferBulkInput struct {
+	TransferBulkID model.TransferBulkID
+	Reason         model.ReversalReason
+}
+
+// ReversalOutput is the outcome of the use case TransactionReversal.
+type ReversalOutput struct {
+	// Transactions are the reversals, in the same order as the transactions they reverse.
+	Transactions []model.Transaction
+	// ReversedCents is the amount credited back to the bank account.
+	ReversedCents model.Cents
+	BalanceCents  model.Cents
+	// Currency is the bank account currency of the reversed and balance amounts.
+	Currency string
+}
+
+// AccountLocker is a storage interface that defines the functionality to lock the account.
+type AccountLocker interface {
+	// Lock finds the bank account of the id from a storage, locked until the transaction ends.
+	Lock(ctx context.Context, id model.BankAccountID) (*model.BankAccount, error)
+}
+
+// TransferBulkFinder is a storage interface that defines the functionality to find the transfer bulk.
+type TransferBulkFinder interface {
+	// Find finds the transfer bulk from a storage.
+	Find(ctx context.Context, id model.TransferBulkID) (*model.TransferBulk, error)
+}
+
+// TransferBulkTransactionsFinder is a storage interface that defines the functionality to find the transactions of a
+// transfer bulk.
+type TransferBulkTransactionsFinder interface {
+	// FindByTransferBulk finds the transactions added by the transfer bulk from a storage.
+	FindByTransferBulk(ctx context.Context, transferBulkID model.TransferBulkID) ([]model.Transaction, error)
+}
+
+// TransactionReversedSummer is a storage interface that defines the functionality to sum the reversed amounts.
+type TransactionReversedSummer interface {
+	// SumReversed sums the amounts reversed of the transactions from a storage, by transaction id.
+	SumReversed(ctx context.Context, ids []model.TransactionID) (map[model.TransactionID]model.Cents, error)
+}
+
+type transactionReversal struct {
+	logger             ctxd.Logger
+	storage            *sqluct.Storage
+	clock              clock.Clock
+	accountFinder      BankAccountIbanFinder
+	locker             AccountLocker
+	transferBulkFinder TransferBulkFinder
+	transactionFinder  TransactionFinder
+	bulkFinder         TransferBulkTransactionsFinder
+	summer             TransactionReversedSummer
+	adder              TransactionAdder
+	poster             JournalPoster
+	updater            BalanceUpdater
+}
+
+var _ TransactionReversal = new(transactionReversal)
+
+// NewTransactionReversal creates an instance of TransactionReversal use case.
+func NewTransactionReversal(
+	logger ctxd.Logger,
+	storage *sqluct.Storage,
+	clock clock.Clock,
+	accountFinder BankAccountIbanFinder,
+	locker AccountLocker,
+	transferBulkFinder TransferBulkFinder,
+	transactionFinder TransactionFinder,
+	bulkFinder TransferBulkTransactionsFinder,
+	summer TransactionReversedSummer,
+	adder TransactionAdder,
+	poster JournalPoster,
+	updater BalanceUpdater,
+) TransactionReversal {
+	return &transactionReversal{
+		logger:             logger,
+		storage:            storage,
+		clock:              clock,
+		accountFinder:      accountFinder,
+		locker:             locker,
+		transferBulkFinder: transferBulkFinder,
+		transactionFinder:  transactionFinder,
+		bulkFinder:         bulkFinder,
+		summer:             summer,
+		adder:              adder,
+		poster:             poster,
+		updater:            updater,
+	}
+}
+
+// ReverseTransaction reverses the transaction of the bank account, the amount not reversed yet when the input amount
+// is 0.
+func (tr *transactionReversal) ReverseTransaction(ctx context.Context, input ReverseTransactionInput) (*ReversalOutput, error) {
+	ctx = ctxd.AddFields(ctx,
+		"iban", input.Iban,
+		"transaction_id", input.TransactionID,
+		"reason", input.Reason,
+	)
+
+	var verr ValidationError
+
+	if input.Iban == "" {
+		verr.add("iban", errors.New("must not be empty"))
+	}
+
+	if input.AmountCents < 0 {
+		verr.add("amount_cents", errors.New("must not be negative"))
+	}
+
+	validateReversalReason(&verr, input.Reason)
+
+	if len(verr.Violations) > 0 {
+		return nil, ctxd.WrapError(ctx, &verr, "failed to validate input")
+	}
+
+	// the account is locked by id within the transaction, not to hold a share lock first.
+	account, err := tr.accountFinder.FindByIban(ctx, input.Iban)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to find account")
+	}
+
+	var output *ReversalOutput
+
+	err = tr.storage.InTx(ctx, func(ctx context.Context) error {
+		account, err := tr.locker.Lock(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		transaction, err := tr.transactionFinder.Find(ctx, account.ID, input.TransactionID)
+		if err != nil {
+			return err
+		}
+
+		reversed, err := tr.summer.SumReversed(ctx, []model.TransactionID{transaction.ID})
+		if err != nil {
+			return err
+		}
+
+		remaining, err := transaction.Reversible(reversed[transaction.ID])
+		if err != nil {
+			return ctxd.WrapError(ctx, err, "failed to reverse transaction")
+		}
+
+		amountCents := remaining
+
+		if input.AmountCents != 0 {
+			if input.AmountCents > remaining {
+				return ctxd.WrapError(ctx, model.ErrReversalExceedsAmount, "failed to reverse transaction",
+					"remaining_cents", remaining,
+				)
+			}
+
+			amountCents = input.AmountCents
+		}
+
+		output, err = tr.reverse(ctx, account, "Reversal", []model.Transaction{*transaction}, []model.TransactionState{
+			transaction.Reversal(amountCents, input.Reason),
+		})
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ReverseTransferBulk reverses the amounts not reversed yet of the transactions performed by the transfer bulk.
+//
+// The transactions already reversed are left as they are.
+func (tr *transactionReversal) ReverseTransferBulk(ctx context.Context, input ReverseTransferBulkInput) (*ReversalOutput, error) {
+	ctx = ctxd.AddFields(ctx,
+		"transfer_bulk_id", input.TransferBulkID,
+		"reason", input.Reason,
+	)
+
+	var verr ValidationError
+
+	validateReversalReason(&verr, input.Reason)
+
+	if len(verr.Violations) > 0 {
+		return nil, ctxd.WrapError(ctx, &verr, "failed to validate input")
+	}
+
+	var output *ReversalOutput
+
+	err := tr.storage.InTx(ctx, func(ctx context.Context) error {
+		transferBulk, err := tr.transferBulkFinder.Find(ctx, input.TransferBulkID)
+		if err != nil {
+			return err
+		}
+
+		transactions, err := tr.bulkFinder.FindByTransferBulk(ctx, transferBulk.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(transactions) == 0 {
+			return ctxd.WrapError(ctx, model.ErrTransactionNotReversible, "transfer bulk performed no transactions")
+		}
+
+		account, err := tr.locker.Lock(ctx, transferBulk.BankAccountID)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]model.TransactionID, len(transactions))
+
+		for i, transaction := range transactions {
+			ids[i] = transaction.ID
+		}
+
+		reversed, err := tr.summer.SumReversed(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		var (
+			reversedTransactions []model.Transaction
+			reversals            []model.TransactionState
+		)
+
+		for _, transaction := range transactions {
+			remaining, err := transaction.Reversible(reversed[transaction.ID])
+			if err != nil {
+				if errors.Is(err, model.ErrTransactionAlreadyReversed) {
+					continue
+				}
+
+				return ctxd.WrapError(ctx, err, "failed to reverse transaction", "transaction_id", transaction.ID)
+			}
+
+			reversedTransactions = append(reversedTransactions, transaction)
+			reversals = append(reversals, transaction.Reversal(remaining, input.Reason))
+		}
+
+		if len(reversals) == 0 {
+			return ctxd.WrapError(ctx, model.ErrTransactionAlreadyReversed, "failed to reverse transfer bulk")
+		}
+
+		description := fmt.Sprintf("Reversal of transfer bulk %d", transferBulk.ID)
+
+		output, err = tr.reverse(ctx, account, description, reversedTransactions, reversals)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// reverse adds the reversals, posts them into the ledger and credits back the bank account, locked by the caller.
+//
+// The reversals are in the same order as the transactions reversed, each posted against the ledger account its
+// transaction was posted against, e.g. the fee income for a fee.
+func (tr *transactionReversal) reverse(
+	ctx context.Context,
+	account *model.BankAccount,
+	description string,
+	reversed []model.Transaction,
+	reversals []model.TransactionState,
+) (*ReversalOutput, error) {
+	now := tr.clock.Now()
+
+	var total model.Cents
+
+	for i := range reversals {
+		reversals[i].CreatedAt = now
+		total -= reversals[i].DebitedCents
+	}
+
+	ids, err := tr.adder.Add(ctx, reversals)
+	if err != nil {
+		return nil, err
+	}
+
+	postings := make([]model.PostingState, 0, len(ids)+1)
+
+	postings = append(postings, model.PostingState{
+		LedgerAccount: model.LedgerAccountBankAccount,
+		BankAccountID: account.ID,
+		AmountCents:   total,
+		Currency:      account.Currency,
+	})
+
+	for i, id := range ids {
+		postings = append(postings, model.PostingState{
+			LedgerAccount: reversed[i].ContraLedgerAccount(),
+			TransactionID: id,
+			AmountCents:   reversals[i].DebitedCents,
+			Currency:      account.Currency,
+		})
+	}
+
+	journalEntryID, err := tr.poster.Post(ctx, model.JournalEntryState{Description: description}, postings)
+	if err != nil {
+		return nil, err
+	}
+
+	tr.logger.Debug(ctx, "journal entry posted", "journal_entry_id", journalEntryID)
+
+	err = tr.updater.BalanceAdd(ctx, account.ID, total)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceCents := account.BalanceCents + total
+
+	tr.logger.Debug(ctx, "transactions reversed", "reversed_cents", total, "balance_cents", balanceCents)
+
+	output := ReversalOutput{
+		Transactions:  make([]model.Transaction, len(ids)),
+		ReversedCents: total,
+		BalanceCents:  balanceCents,
+		Currency:      account.Currency,
+	}
+
+	for i, id := range ids {
+		output.Transactions[i] = model.Transaction{
+			ID:               id,
+			TransactionState: reversals[i],
+		}
+	}
+
+	return &output, nil
+}
+
+// validateReversalReason adds the violation of the reversal reason code, when it is not valid.
+func validateReversalReason(verr *ValidationError, reason model.ReversalReason) {
+	switch reason {
+	case model.ReversalDuplicate,
+		model.ReversalFraud,
+		model.ReversalIncorrectAmount,
+		model.ReversalRequestedByCustomer,
+		model.ReversalReturned:
+	default:
+		verr.add("reason", fmt.Errorf("must be one of %s, %s, %s, %s or %s",
+			model.ReversalDuplicate,
+			model.ReversalFraud,
+			model.ReversalIncorrectAmount,
+			model.ReversalRequestedByCustomer,
+			model.ReversalReturned,
+		))
+	}
+}
@@ -0,0 +1,368 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reversalStorageMock mocks the storage of the transactions to reverse, the added reversals, the postings and the
+// updated balance are kept to be asserted.
+type reversalStorageMock struct {
+	account      *model.BankAccount
+	transactions []model.Transaction
+	reversed     map[model.TransactionID]model.Cents
+
+	added             []model.TransactionState
+	postings          []model.PostingState
+	balanceAddedCents *model.Cents
+}
+
+func (rsm *reversalStorageMock) FindByIban(_ context.Context, _ string) (*model.BankAccount, error) {
+	if rsm.account == nil {
+		return nil, storage.ErrNotFound
+	}
+
+	return rsm.account, nil
+}
+
+func (rsm *reversalStorageMock) Lock(ctx context.Context, _ model.BankAccountID) (*model.BankAccount, error) {
+	return rsm.FindByIban(ctx, "")
+}
+
+func (rsm *reversalStorageMock) Find(_ context.Context, _ model.BankAccountID, id model.TransactionID) (*model.Transaction, error) {
+	for _, transaction := range rsm.transactions {
+		if transaction.ID == id {
+			return &transaction, nil
+		}
+	}
+
+	return nil, storage.ErrNotFound
+}
+
+func (rsm *reversalStorageMock) FindByTransferBulk(_ context.Context, _ model.TransferBulkID) ([]model.Transaction, error) {
+	return rsm.transactions, nil
+}
+
+func (rsm *reversalStorageMock) SumReversed(_ context.Context, _ []model.TransactionID) (map[model.TransactionID]model.Cents, error) {
+	return rsm.reversed, nil
+}
+
+func (rsm *reversalStorageMock) Add(_ context.Context, transactionStates []model.TransactionState) ([]model.TransactionID, error) {
+	rsm.added = transactionStates
+
+	ids := make([]model.TransactionID, len(transactionStates))
+
+	for i := range ids {
+		ids[i] = model.TransactionID(10 + i)
+	}
+
+	return ids, nil
+}
+
+func (rsm *reversalStorageMock) Post(_ context.Context, _ model.JournalEntryState, postingStates []model.PostingState) (model.JournalEntryID, error) {
+	rsm.postings = postingStates
+
+	return 1, nil
+}
+
+func (rsm *reversalStorageMock) BalanceAdd(_ context.Context, _ model.BankAccountID, amount model.Cents) error {
+	rsm.balanceAddedCents = &amount
+
+	return nil
+}
+
+type transferBulkFinderMock struct {
+	transferBulk *model.TransferBulk
+}
+
+func (tbfm *transferBulkFinderMock) Find(_ context.Context, _ model.TransferBulkID) (*model.TransferBulk, error) {
+	if tbfm.transferBulk == nil {
+		return nil, storage.ErrNotFound
+	}
+
+	return tbfm.transferBulk, nil
+}
+
+// testReversedTransfer is the credit transfer of the transactions to reverse.
+var testReversedTransfer = usecase.TransactionBulkTransferInput{
+	CounterpartyName: "Bip Bip",
+	CounterpartyIban: "EE303680981021245685",
+	CounterpartyBic:  "CRLYFRPPTOU",
+	Description:      "Wonderland/4410",
+}
+
+func testReversedTransaction(id model.TransactionID, debitedCents model.Cents) model.Transaction {
+	return model.Transaction{ID: id, TransactionState: testTransfer(testAccount(), testReversedTransfer, debitedCents)}
+}
+
+func testReversedFee(id, chargedID model.TransactionID, feeCents model.Cents) model.Transaction {
+	fee := testReversedTransaction(id, feeCents)
+	fee.ChargedTransactionID = chargedID
+
+	return fee
+}
+
+func Test_transactionReversal_ReverseTransaction(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		input         usecase.ReverseTransactionInput
+		reversed      map[model.TransactionID]model.Cents
+		reversedCents model.Cents
+		ledgerAccount model.LedgerAccount
+		err           error
+	}{
+		{
+			name:          "whole amount reversed",
+			input:         usecase.ReverseTransactionInput{TransactionID: 1, Reason: model.ReversalDuplicate},
+			reversedCents: 1500,
+		},
+		{
+			name:          "fee reversed from the fee income",
+			input:         usecase.ReverseTransactionInput{TransactionID: 3, Reason: model.ReversalRequestedByCustomer},
+			reversedCents: 50,
+			ledgerAccount: model.LedgerAccountFeeIncome,
+		},
+		{
+			name:          "partial amount reversed",
+			input:         usecase.ReverseTransactionInput{TransactionID: 1, AmountCents: 500, Reason: model.ReversalIncorrectAmount},
+			reversedCents: 500,
+		},
+		{
+			name:          "remaining amount reversed",
+			input:         usecase.ReverseTransactionInput{TransactionID: 1, Reason: model.ReversalRequestedByCustomer},
+			reversed:      map[model.TransactionID]model.Cents{1: 500},
+			reversedCents: 1000,
+		},
+		{
+			name:     "amount exceeding the remaining amount",
+			input:    usecase.ReverseTransactionInput{TransactionID: 1, AmountCents: 1200, Reason: model.ReversalIncorrectAmount},
+			reversed: map[model.TransactionID]model.Cents{1: 500},
+			err:      model.ErrReversalExceedsAmount,
+		},
+		{
+			name:     "transaction already reversed",
+			input:    usecase.ReverseTransactionInput{TransactionID: 1, Reason: model.ReversalDuplicate},
+			reversed: map[model.TransactionID]model.Cents{1: 1500},
+			err:      model.ErrTransactionAlreadyReversed,
+		},
+		{
+			name:  "transaction not found",
+			input: usecase.ReverseTransactionInput{TransactionID: 2, Reason: model.ReversalDuplicate},
+			err:   storage.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			st, mock := newTestStorage(t, tc.err == nil)
+			account := testAccount()
+
+			rs := &reversalStorageMock{
+				account:      &account,
+				transactions: []model.Transaction{testReversedTransaction(1, 1500), testReversedFee(3, 1, 50)},
+				reversed:     tc.reversed,
+			}
+
+			tr := usecase.NewTransactionReversal(
+				ctxd.NoOpLogger{}, st, clock.Fix(now), rs, rs, &transferBulkFinderMock{}, rs, rs, rs, rs, rs, rs,
+			)
+
+			tc.input.Iban = "FR10474608000002006107XXXXX"
+
+			got, err := tr.ReverseTransaction(context.Background(), tc.input)
+
+			assert.ErrorIsf(t, err, tc.err, "ReverseTransaction() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("ReverseTransaction() expectations were not met = %v", err)
+			}
+
+			if tc.err != nil {
+				assert.Nil(t, got)
+				assert.Nil(t, rs.added)
+				assert.Nil(t, rs.balanceAddedCents)
+
+				return
+			}
+
+			reversal := model.TransactionState{
+				CounterpartyName:      "Bip Bip",
+				CounterpartyIban:      "EE303680981021245685",
+				CounterpartyBic:       "CRLYFRPPTOU",
+				AmountCents:           -tc.reversedCents,
+				AmountCurrency:        "EUR",
+				BankAccountID:         1,
+				Description:           "Wonderland/4410",
+				DebitedCents:          -tc.reversedCents,
+				DebitedCurrency:       "EUR",
+				ExchangeRate:          model.NoExchangeRate,
+				ReversedTransactionID: tc.input.TransactionID,
+				ReversalReason:        tc.input.Reason,
+				CreatedAt:             now,
+			}
+
+			if tc.ledgerAccount == "" {
+				tc.ledgerAccount = model.LedgerAccountOutgoingTransfers
+			}
+
+			assert.Equal(t, []model.TransactionState{reversal}, rs.added)
+			assert.Equal(t, []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 1, AmountCents: tc.reversedCents, Currency: "EUR"},
+				{LedgerAccount: tc.ledgerAccount, TransactionID: 10, AmountCents: -tc.reversedCents, Currency: "EUR"},
+			}, rs.postings)
+			require.NotNil(t, rs.balanceAddedCents)
+			assert.Equal(t, tc.reversedCents, *rs.balanceAddedCents)
+
+			assert.Equal(t, &usecase.ReversalOutput{
+				Transactions:  []model.Transaction{{ID: 10, TransactionState: reversal}},
+				ReversedCents: tc.reversedCents,
+				BalanceCents:  100000 + tc.reversedCents,
+				Currency:      "EUR",
+			}, got)
+		})
+	}
+}
+
+func Test_transactionReversal_ReverseTransaction_invalidInput(t *testing.T) {
+	t.Parallel()
+
+	tr := usecase.NewTransactionReversal(
+		ctxd.NoOpLogger{}, nil, clock.Fix(time.Now()), nil, nil, nil, nil, nil, nil, nil, nil, nil,
+	)
+
+	got, err := tr.ReverseTransaction(context.Background(), usecase.ReverseTransactionInput{
+		TransactionID: 1,
+		AmountCents:   -100,
+		Reason:        "mistake",
+	})
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, usecase.ErrInvalidInput)
+
+	var verr *usecase.ValidationError
+
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, []usecase.FieldViolation{
+		{Field: "iban", Description: "must not be empty"},
+		{Field: "amount_cents", Description: "must not be negative"},
+		{Field: "reason", Description: "must be one of duplicate, fraud, incorrect_amount, requested_by_customer or returned"},
+	}, verr.Violations)
+}
+
+func Test_transactionReversal_ReverseTransferBulk(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		transferBulk *model.TransferBulk
+		reversed     map[model.TransactionID]model.Cents
+		added        []model.TransactionState
+		postings     []model.PostingState
+		err          error
+	}{
+		{
+			name:         "transactions not reversed yet reversed, fee refunded",
+			transferBulk: &model.TransferBulk{ID: 1, TransferBulkState: model.TransferBulkState{BankAccountID: 1}},
+			reversed:     map[model.TransactionID]model.Cents{1: 1500, 2: 200},
+			added: []model.TransactionState{
+				{DebitedCents: -300, ReversedTransactionID: 2},
+				{DebitedCents: -700, ReversedTransactionID: 3},
+				{DebitedCents: -50, ReversedTransactionID: 4},
+			},
+			postings: []model.PostingState{
+				{LedgerAccount: model.LedgerAccountBankAccount, BankAccountID: 1, AmountCents: 1050, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 10, AmountCents: -300, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountOutgoingTransfers, TransactionID: 11, AmountCents: -700, Currency: "EUR"},
+				{LedgerAccount: model.LedgerAccountFeeIncome, TransactionID: 12, AmountCents: -50, Currency: "EUR"},
+			},
+		},
+		{
+			name:         "transfer bulk already reversed",
+			transferBulk: &model.TransferBulk{ID: 1, TransferBulkState: model.TransferBulkState{BankAccountID: 1}},
+			reversed:     map[model.TransactionID]model.Cents{1: 1500, 2: 500, 3: 700, 4: 50},
+			err:          model.ErrTransactionAlreadyReversed,
+		},
+		{
+			name: "transfer bulk not found",
+			err:  storage.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			st, mock := newTestStorage(t, tc.err == nil)
+			account := testAccount()
+
+			rs := &reversalStorageMock{
+				account: &account,
+				transactions: []model.Transaction{
+					testReversedTransaction(1, 1500),
+					testReversedTransaction(2, 500),
+					testReversedTransaction(3, 700),
+					testReversedFee(4, 2, 50),
+				},
+				reversed: tc.reversed,
+			}
+
+			tr := usecase.NewTransactionReversal(
+				ctxd.NoOpLogger{}, st, clock.Fix(now), rs, rs, &transferBulkFinderMock{transferBulk: tc.transferBulk},
+				rs, rs, rs, rs, rs, rs,
+			)
+
+			got, err := tr.ReverseTransferBulk(context.Background(), usecase.ReverseTransferBulkInput{
+				TransferBulkID: 1,
+				Reason:         model.ReversalReturned,
+			})
+
+			assert.ErrorIsf(t, err, tc.err, "ReverseTransferBulk() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("ReverseTransferBulk() expectations were not met = %v", err)
+			}
+
+			if tc.err != nil {
+				assert.Nil(t, got)
+				assert.Nil(t, rs.added)
+
+				return
+			}
+
+			require.Len(t, rs.added, len(tc.added))
+
+			for i, want := range tc.added {
+				assert.Equal(t, want.DebitedCents, rs.added[i].DebitedCents)
+				assert.Equal(t, want.ReversedTransactionID, rs.added[i].ReversedTransactionID)
+				assert.Equal(t, model.ReversalReturned, rs.added[i].ReversalReason)
+				assert.Empty(t, rs.added[i].TransferBulkID)
+			}
+
+			assert.Equal(t, tc.postings, rs.postings)
+			assert.Equal(t, model.Cents(1050), got.ReversedCents)
+			assert.Equal(t, model.Cents(101050), got.BalanceCents)
+			assert.Len(t, got.Transactions, 3)
+		})
+	}
+}
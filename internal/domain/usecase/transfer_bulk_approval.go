@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/nhatthm/go-clock"
+)
+
+// ErrTransferBulkNotAwaitingApproval error represents when the transfer bulk decided is no longer awaiting approval,
+// nor held for review.
+var ErrTransferBulkNotAwaitingApproval = errors.New("transfer bulk is not awaiting approval")
+
+// TransferBulkApproval defines the functionality of the use case TransferBulkApproval used to decide on the transfer
+// bulks awaiting approval, or held for review.
+//
+// The users submitting and deciding are identified by the user ids asserted by a trusted proxy, the four-eyes
+// approval is as strong as the proxy authenticating them.
+type TransferBulkApproval interface {
+	// Approve performs the transfers of the transfer bulk awaiting approval.
+	Approve(ctx context.Context, input TransferBulkDecisionInput) (*TransactionBulkOutput, error)
+	// Reject rejects the transfer bulk awaiting approval, its transfers are not performed.
+	Reject(ctx context.Context, input TransferBulkDecisionInput) (*TransactionBulkOutput, error)
+}
+
+// TransferBulkDecisionInput is the input of the use case TransferBulkApproval.
+type TransferBulkDecisionInput struct {
+	TransferBulkID model.TransferBulkID
+	// UserID is the user deciding, other than the user who submitted the transfer bulk.
+	UserID string
+	// Reason is the comment of the user on the decision.
+	Reason string
+}
+
+// TransferBulkLocker is a storage interface that defines the functionality to lock the transfer bulk.
+type TransferBulkLocker interface {
+	// Lock finds the transfer bulk from a storage, locked until the transaction ends.
+	Lock(ctx context.Context, id model.TransferBulkID) (*model.TransferBulk, error)
+}
+
+type transferBulkApproval struct {
+	logger          ctxd.Logger
+	storage         *sqluct.Storage
+	clock           clock.Clock
+	transactionBulk TransactionBulk
+	locker          TransferBulkLocker
+	completer       TransferBulkCompleter
+	decisions       TransferBulkDecisionAdder
+}
+
+var _ TransferBulkApproval = new(transferBulkApproval)
+
+// NewTransferBulkApproval creates an instance of TransferBulkApproval use case.
+//
+// The transfers of an approved transfer bulk are performed by the TransactionBulk use case, as if just requested,
+// the balance and the limits are checked then.
+func NewTransferBulkApproval(
+	logger ctxd.Logger,
+	storage *sqluct.Storage,
+	clock clock.Clock,
+	transactionBulk TransactionBulk,
+	locker TransferBulkLocker,
+	completer TransferBulkCompleter,
+	decisions TransferBulkDecisionAdder,
+) TransferBulkApproval {
+	return &transferBulkApproval{
+		logger:          logger,
+		storage:         storage,
+		clock:           clock,
+		transactionBulk: transactionBulk,
+		locker:          locker,
+		completer:       completer,
+		decisions:       decisions,
+	}
+}
+
+// Approve performs the transfers of the transfer bulk awaiting approval.
+//
+// Returns model.ErrSelfApproval when the user submitted the transfer bulk, and ErrTransferBulkNotAwaitingApproval when
+// the transfer bulk is executed or rejected already. The transfer bulk keeps awaiting approval when its transfers can
+// not be performed, e.g. not enough balance.
+func (a *transferBulkApproval) Approve(ctx context.Context, input TransferBulkDecisionInput) (*TransactionBulkOutput, error) {
+	ctx = ctxd.AddFields(ctx, "transfer_bulk_id", input.TransferBulkID, "user_id", input.UserID)
+
+	var output *TransactionBulkOutput
+
+	err := a.storage.InTx(ctx, func(ctx context.Context) error {
+		transferBulk, request, err := a.awaitingApproval(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		request.approved = transferBulk
+
+		output, err = a.transactionBulk.TransactionBulk(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		return a.decide(ctx, input, model.TransferBulkApproved)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Info(ctx, "transfer bulk approved", "debited_cents", output.DebitedCents)
+
+	return output, nil
+}
+
+// Reject rejects the transfer bulk awaiting approval, its transfers are not performed.
+//
+// Returns the same errors as Approve.
+func (a *transferBulkApproval) Reject(ctx context.Context, input TransferBulkDecisionInput) (*TransactionBulkOutput, error) {
+	ctx = ctxd.AddFields(ctx, "transfer_bulk_id", input.TransferBulkID, "user_id", input.UserID)
+
+	var output *TransactionBulkOutput
+
+	err := a.storage.InTx(ctx, func(ctx context.Context) error {
+		transferBulk, request, err := a.awaitingApproval(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		transferBulk.Status = model.TransferBulkRejected
+
+		if err := a.completer.Complete(ctx, *transferBulk); err != nil {
+			return err
+		}
+
+		output = &TransactionBulkOutput{
+			TransferBulkID: transferBulk.ID,
+			Rows:           transferBulk.Rows(len(request.CreditTransfers), nil),
+			BalanceCents:   transferBulk.BalanceCents,
+			Currency:       transferBulk.Currency,
+			Status:         transferBulk.Status,
+		}
+
+		return a.decide(ctx, input, model.TransferBulkDeclined)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Info(ctx, "transfer bulk rejected")
+
+	return output, nil
+}
+
+// awaitingApproval locks the transfer bulk and checks the user may decide on it.
+//
+// Returns the transfer bulk and its request.
+func (a *transferBulkApproval) awaitingApproval(
+	ctx context.Context,
+	input TransferBulkDecisionInput,
+) (*model.TransferBulk, TransactionBulkInput, error) {
+	var request TransactionBulkInput
+
+	if input.UserID == "" {
+		var verr ValidationError
+
+		verr.add("user_id", errors.New("must not be empty"))
+
+		return nil, request, ctxd.WrapError(ctx, &verr, "failed to validate input")
+	}
+
+	transferBulk, err := a.locker.Lock(ctx, input.TransferBulkID)
+	if err != nil {
+		return nil, request, err
+	}
+
+	if !transferBulk.Status.Pending() {
+		return nil, request, ctxd.WrapError(ctx, ErrTransferBulkNotAwaitingApproval, "failed to decide on transfer bulk",
+			"status", transferBulk.Status,
+		)
+	}
+
+	if err := transferBulk.CheckDecider(input.UserID); err != nil {
+		return nil, request, ctxd.WrapError(ctx, err, "failed to decide on transfer bulk")
+	}
+
+	if err := json.Unmarshal([]byte(transferBulk.Request), &request); err != nil {
+		return nil, request, ctxd.WrapError(ctx, err, "failed to decode request")
+	}
+
+	return transferBulk, request, nil
+}
+
+// decide adds the decision of the user to the approval trail of the transfer bulk.
+func (a *transferBulkApproval) decide(ctx context.Context, input TransferBulkDecisionInput, decision model.TransferBulkDecisionKind) error {
+	_, err := a.decisions.Add(ctx, model.TransferBulkDecisionState{
+		TransferBulkID: input.TransferBulkID,
+		Decision:       decision,
+		UserID:         input.UserID,
+		Reason:         input.Reason,
+		CreatedAt:      a.clock.Now(),
+	})
+
+	return err
+}
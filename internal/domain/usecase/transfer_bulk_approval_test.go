@@ -0,0 +1,275 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// transferBulkLockerMock mocks the transfer bulk storage, locked is the transfer bulk found, nil when not found.
+type transferBulkLockerMock struct {
+	locked *model.TransferBulk
+}
+
+func (tblm *transferBulkLockerMock) Lock(_ context.Context, id model.TransferBulkID) (*model.TransferBulk, error) {
+	if tblm.locked == nil || tblm.locked.ID != id {
+		return nil, storage.ErrNotFound
+	}
+
+	locked := *tblm.locked
+
+	return &locked, nil
+}
+
+// testAwaitingTransferBulk returns the transfer bulk submitted by alice awaiting approval.
+func testAwaitingTransferBulk(t *testing.T) model.TransferBulk {
+	t.Helper()
+
+	request, err := json.Marshal(validTransactionBulkInput())
+	require.NoError(t, err)
+
+	return model.TransferBulk{
+		ID: 1,
+		TransferBulkState: model.TransferBulkState{
+			BankAccountID: 1,
+			DebitedCents:  2450,
+			BalanceCents:  10000,
+			Currency:      "EUR",
+			Status:        model.TransferBulkAwaitingApproval,
+			Request:       string(request),
+			SubmittedBy:   "alice",
+		},
+	}
+}
+
+func Test_transferBulkApproval_Approve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 17, 9, 0, 0, 0, time.UTC)
+
+	awaiting := testAwaitingTransferBulk(t)
+
+	executed := awaiting
+	executed.Status = model.TransferBulkExecuted
+
+	held := awaiting
+	held.Status = model.TransferBulkHeldForReview
+	held.ScreeningHits = model.ScreeningHits{{Row: 1, EntryID: "SDN-1", EntryName: "Bugs Bunny", MatchedOn: model.ScreeningName, Score: 0.92}}
+
+	performed := &usecase.TransactionBulkOutput{
+		TransferBulkID: 1,
+		TransactionIDs: []model.TransactionID{4, 5},
+		Rows: []model.TransferBulkRow{
+			{Status: model.TransferBulkRowExecuted, TransactionID: 4},
+			{Status: model.TransferBulkRowExecuted, TransactionID: 5},
+		},
+		DebitedCents: 2450,
+		BalanceCents: 7550,
+		Currency:     "EUR",
+		Status:       model.TransferBulkExecuted,
+	}
+
+	tests := []struct {
+		name            string
+		locked          *model.TransferBulk
+		userID          string
+		transactionBulk *transactionBulkMock
+		want            *usecase.TransactionBulkOutput
+		invalid         bool
+		err             error
+	}{
+		{
+			name:            "transfer bulk approved",
+			locked:          &awaiting,
+			userID:          "bob",
+			transactionBulk: &transactionBulkMock{idempotencyKey: "IdempotencyKey", output: performed},
+			want:            performed,
+		},
+		{
+			name:            "transfer bulk held for review approved",
+			locked:          &held,
+			userID:          "bob",
+			transactionBulk: &transactionBulkMock{idempotencyKey: "IdempotencyKey", output: performed},
+			want:            performed,
+		},
+		{
+			name:   "transfer bulk approved by the submitter",
+			locked: &awaiting,
+			userID: "alice",
+			err:    model.ErrSelfApproval,
+		},
+		{
+			name:   "transfer bulk not awaiting approval",
+			locked: &executed,
+			userID: "bob",
+			err:    usecase.ErrTransferBulkNotAwaitingApproval,
+		},
+		{
+			name:   "transfer bulk not found",
+			userID: "bob",
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:    "transfer bulk approved without user",
+			locked:  &awaiting,
+			invalid: true,
+		},
+		{
+			name:   "transfer bulk approved, not enough balance",
+			locked: &awaiting,
+			userID: "bob",
+			transactionBulk: &transactionBulkMock{
+				idempotencyKey: "IdempotencyKey",
+				err:            ctxd.WrapError(context.Background(), storage.ErrNotEnoughBalance, "storage.BankAccount: failed to check account balance"),
+			},
+			err: storage.ErrNotEnoughBalance,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			st, mock := newTestStorage(t, tc.err == nil && !tc.invalid)
+
+			if tc.transactionBulk != nil {
+				tc.transactionBulk.t = t
+			}
+
+			recorder := &transferBulkRecorderMock{}
+
+			a := usecase.NewTransferBulkApproval(
+				ctxd.NoOpLogger{},
+				st,
+				clock.Fix(now),
+				tc.transactionBulk,
+				&transferBulkLockerMock{locked: tc.locked},
+				recorder,
+				recorder,
+			)
+
+			got, err := a.Approve(context.Background(), usecase.TransferBulkDecisionInput{
+				TransferBulkID: 1,
+				UserID:         tc.userID,
+				Reason:         "payroll checked",
+			})
+
+			if tc.invalid {
+				var verr *usecase.ValidationError
+
+				assert.ErrorAs(t, err, &verr)
+			} else {
+				assert.ErrorIsf(t, err, tc.err, "Approve() err got = %v, want %v", err, tc.err)
+			}
+
+			assert.Equal(t, tc.want, got)
+			assert.Empty(t, recorder.completed, "the transfer bulk is completed by TransactionBulk")
+
+			if tc.want != nil {
+				assert.Equal(t, []model.TransferBulkDecisionState{
+					{TransferBulkID: 1, Decision: model.TransferBulkApproved, UserID: "bob", Reason: "payroll checked", CreatedAt: now},
+				}, recorder.decisions)
+			} else {
+				assert.Empty(t, recorder.decisions)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Approve() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func Test_transferBulkApproval_Reject(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 17, 9, 0, 0, 0, time.UTC)
+
+	awaiting := testAwaitingTransferBulk(t)
+
+	tests := []struct {
+		name   string
+		userID string
+		want   *usecase.TransactionBulkOutput
+		err    error
+	}{
+		{
+			name:   "transfer bulk rejected",
+			userID: "bob",
+			want: &usecase.TransactionBulkOutput{
+				TransferBulkID: 1,
+				Rows: []model.TransferBulkRow{
+					{Status: model.TransferBulkRowRejected, Reason: "transfer bulk rejected"},
+					{Status: model.TransferBulkRowRejected, Reason: "transfer bulk rejected"},
+				},
+				BalanceCents: 10000,
+				Currency:     "EUR",
+				Status:       model.TransferBulkRejected,
+			},
+		},
+		{
+			name:   "transfer bulk rejected by the submitter",
+			userID: "alice",
+			err:    model.ErrSelfApproval,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			st, mock := newTestStorage(t, tc.err == nil)
+
+			recorder := &transferBulkRecorderMock{}
+
+			a := usecase.NewTransferBulkApproval(
+				ctxd.NoOpLogger{},
+				st,
+				clock.Fix(now),
+				nil,
+				&transferBulkLockerMock{locked: &awaiting},
+				recorder,
+				recorder,
+			)
+
+			got, err := a.Reject(context.Background(), usecase.TransferBulkDecisionInput{
+				TransferBulkID: 1,
+				UserID:         tc.userID,
+				Reason:         "unknown beneficiary",
+			})
+
+			assert.ErrorIsf(t, err, tc.err, "Reject() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.want, got)
+
+			if tc.err == nil {
+				rejected := awaiting
+				rejected.Status = model.TransferBulkRejected
+
+				assert.Equal(t, []model.TransferBulk{rejected}, recorder.completed)
+				assert.Equal(t, []model.TransferBulkDecisionState{
+					{TransferBulkID: 1, Decision: model.TransferBulkDeclined, UserID: "bob", Reason: "unknown beneficiary", CreatedAt: now},
+				}, recorder.decisions)
+			} else {
+				assert.Empty(t, recorder.completed)
+				assert.Empty(t, recorder.decisions)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Reject() expectations were not met = %v", err)
+			}
+		})
+	}
+}
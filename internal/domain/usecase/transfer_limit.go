@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/nhatthm/go-clock"
+)
+
+// TransferLimits defines the functionality of the use case TransferLimits used by the administrators to manage the
+// limits of the credit transfers of the bank accounts and of the organizations.
+type TransferLimits interface {
+	// SetLimits sets the transfer limits of the bank account, or of all the bank accounts of the organization.
+	SetLimits(ctx context.Context, input TransferLimitsInput) (*model.TransferLimit, error)
+}
+
+// TransferLimitsInput is the input of the use case TransferLimits.
+//
+// Either Iban or OrganizationName is given. The limits are in cents of the bank account currency, 0 for no limit.
+type TransferLimitsInput struct {
+	Iban             string
+	OrganizationName string
+
+	MaxTransferCents model.Cents
+	MaxBatchCents    model.Cents
+	DailyCents       model.Cents
+	MonthlyCents     model.Cents
+	// MaxTransfers is the number of credit transfers sent within WindowSeconds.
+	MaxTransfers  int64
+	WindowSeconds int64
+}
+
+// TransferLimitSetter is a storage interface that defines the functionality to set the transfer limits.
+type TransferLimitSetter interface {
+	// Set sets the limits of the bank account, or of the organization, replacing the limits set before.
+	Set(ctx context.Context, limitState model.TransferLimitState) (*model.TransferLimit, error)
+}
+
+type transferLimits struct {
+	logger        ctxd.Logger
+	clock         clock.Clock
+	accountFinder BankAccountIbanFinder
+	setter        TransferLimitSetter
+}
+
+var _ TransferLimits = new(transferLimits)
+
+// NewTransferLimits creates an instance of TransferLimits use case.
+func NewTransferLimits(
+	logger ctxd.Logger,
+	clock clock.Clock,
+	accountFinder BankAccountIbanFinder,
+	setter TransferLimitSetter,
+) TransferLimits {
+	return &transferLimits{
+		logger:        logger,
+		clock:         clock,
+		accountFinder: accountFinder,
+		setter:        setter,
+	}
+}
+
+// SetLimits sets the transfer limits of the bank account, or of all the bank accounts of the organization.
+//
+// The limits replace the limits set before, the limits not given are removed. The limits apply to the transfer bulks
+// processed from then on, the credit transfers sent already count towards them.
+func (tl *transferLimits) SetLimits(ctx context.Context, input TransferLimitsInput) (*model.TransferLimit, error) {
+	ctx = ctxd.AddFields(ctx,
+		"iban", input.Iban,
+		"organization_name", input.OrganizationName,
+	)
+
+	err := validateTransferLimitsInput(input)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to validate input")
+	}
+
+	limitState := model.TransferLimitState{
+		OrganizationName: input.OrganizationName,
+		MaxTransferCents: input.MaxTransferCents,
+		MaxBatchCents:    input.MaxBatchCents,
+		DailyCents:       input.DailyCents,
+		MonthlyCents:     input.MonthlyCents,
+		MaxTransfers:     input.MaxTransfers,
+		WindowSeconds:    input.WindowSeconds,
+		UpdatedAt:        tl.clock.Now(),
+	}
+
+	if input.Iban != "" {
+		account, err := tl.accountFinder.FindByIban(ctx, input.Iban)
+		if err != nil {
+			return nil, ctxd.WrapError(ctx, err, "failed to find account")
+		}
+
+		limitState.BankAccountID = account.ID
+	}
+
+	limit, err := tl.setter.Set(ctx, limitState)
+	if err != nil {
+		return nil, ctxd.WrapError(ctx, err, "failed to set transfer limits")
+	}
+
+	tl.logger.Info(ctx, "transfer limits set", "transfer_limit_id", limit.ID)
+
+	return limit, nil
+}
+
+func validateTransferLimitsInput(input TransferLimitsInput) error {
+	var verr ValidationError
+
+	if (input.Iban == "") == (input.OrganizationName == "") {
+		verr.add("iban", errors.New("either iban or organization name must be given"))
+	}
+
+	for _, limit := range []struct {
+		field string
+		value int64
+	}{
+		{field: "max_transfer_cents", value: int64(input.MaxTransferCents)},
+		{field: "max_batch_cents", value: int64(input.MaxBatchCents)},
+		{field: "daily_cents", value: int64(input.DailyCents)},
+		{field: "monthly_cents", value: int64(input.MonthlyCents)},
+		{field: "max_transfers", value: input.MaxTransfers},
+		{field: "window_seconds", value: input.WindowSeconds},
+	} {
+		if limit.value < 0 {
+			verr.add(limit.field, errors.New("must not be negative"))
+		}
+	}
+
+	if input.MaxTransfers > 0 && input.WindowSeconds == 0 {
+		verr.add("window_seconds", errors.New("must be given with max transfers"))
+	}
+
+	if len(verr.Violations) > 0 {
+		return &verr
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/nhatthm/go-clock"
+	"github.com/stretchr/testify/assert"
+)
+
+// transferLimitStorageMock mocks the storage of the transfer limits, set is the limits stored, nil when not set.
+type transferLimitStorageMock struct {
+	account *model.BankAccount
+
+	set *model.TransferLimitState
+}
+
+func (tlsm *transferLimitStorageMock) FindByIban(_ context.Context, iban string) (*model.BankAccount, error) {
+	if tlsm.account == nil || tlsm.account.Iban != iban {
+		return nil, storage.ErrNotFound
+	}
+
+	return tlsm.account, nil
+}
+
+func (tlsm *transferLimitStorageMock) Set(_ context.Context, limitState model.TransferLimitState) (*model.TransferLimit, error) {
+	tlsm.set = &limitState
+
+	return &model.TransferLimit{ID: 1, TransferLimitState: limitState}, nil
+}
+
+func Test_transferLimits_SetLimits(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 15, 9, 0, 0, 0, time.UTC)
+
+	account := testAccount()
+
+	tests := []struct {
+		name  string
+		input usecase.TransferLimitsInput
+		want  *model.TransferLimitState
+		err   error
+	}{
+		{
+			name: "bank account limits set",
+			input: usecase.TransferLimitsInput{
+				Iban:             account.Iban,
+				MaxTransferCents: 100000,
+				MaxTransfers:     10,
+				WindowSeconds:    3600,
+			},
+			want: &model.TransferLimitState{
+				BankAccountID:    account.ID,
+				MaxTransferCents: 100000,
+				MaxTransfers:     10,
+				WindowSeconds:    3600,
+				UpdatedAt:        now,
+			},
+		},
+		{
+			name:  "organization limits set",
+			input: usecase.TransferLimitsInput{OrganizationName: "ACME Corp", DailyCents: 500000, MonthlyCents: 2000000},
+			want: &model.TransferLimitState{
+				OrganizationName: "ACME Corp",
+				DailyCents:       500000,
+				MonthlyCents:     2000000,
+				UpdatedAt:        now,
+			},
+		},
+		{
+			name:  "bank account not found",
+			input: usecase.TransferLimitsInput{Iban: "EE303680981021245685", DailyCents: 500000},
+			err:   storage.ErrNotFound,
+		},
+		{
+			name:  "neither bank account nor organization",
+			input: usecase.TransferLimitsInput{DailyCents: 500000},
+			err:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "negative limit",
+			input: usecase.TransferLimitsInput{Iban: account.Iban, MaxBatchCents: -1},
+			err:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "max transfers without window",
+			input: usecase.TransferLimitsInput{Iban: account.Iban, MaxTransfers: 10},
+			err:   usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tlsm := &transferLimitStorageMock{account: &account}
+			tl := usecase.NewTransferLimits(ctxd.NoOpLogger{}, clock.Fix(now), tlsm, tlsm)
+
+			got, err := tl.SetLimits(context.Background(), tc.input)
+
+			assert.ErrorIsf(t, err, tc.err, "SetLimits() err got = %v, want %v", err, tc.err)
+			assert.Equal(t, tc.want, tlsm.set)
+
+			if tc.want != nil {
+				assert.Equal(t, &model.TransferLimit{ID: 1, TransferLimitState: *tc.want}, got)
+			} else {
+				assert.Nil(t, got)
+			}
+		})
+	}
+}
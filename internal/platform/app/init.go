@@ -9,10 +9,15 @@ import (
 	"github.com/bool64/ctxd"
 	"github.com/bool64/sqluct"
 	"github.com/bool64/zapctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
 	"github.com/dohernandez/qonto/internal/domain/usecase"
 	"github.com/dohernandez/qonto/internal/platform/config"
+	"github.com/dohernandez/qonto/internal/platform/exchange"
+	"github.com/dohernandez/qonto/internal/platform/fee"
 	"github.com/dohernandez/qonto/internal/platform/handler"
+	"github.com/dohernandez/qonto/internal/platform/screening"
 	"github.com/dohernandez/qonto/internal/platform/service"
+	"github.com/dohernandez/qonto/internal/platform/statement"
 	"github.com/dohernandez/qonto/internal/platform/storage"
 	grpcZapLogger "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpcRecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -44,9 +49,79 @@ type Locator struct {
 
 	GRPCUnitaryInterceptors []grpc.UnaryServerInterceptor
 
-	AccountBalanceChecker usecase.AccountBalanceChecker
-	BalanceUpdater        usecase.BalanceUpdater
-	TransactionAdder      usecase.TransactionAdder
+	TransferBulkReserver   usecase.TransferBulkReserver
+	TransferBulkCompleter  usecase.TransferBulkCompleter
+	TransferBulkLocker     usecase.TransferBulkLocker
+	AccountBalanceChecker  usecase.AccountBalanceChecker
+	BalanceUpdater         usecase.BalanceUpdater
+	TransactionAdder       usecase.TransactionAdder
+	TransactionIDsFinder   usecase.TransactionIDsFinder
+	AccountFinder          usecase.AccountFinder
+	JournalPoster          usecase.JournalPoster
+	LedgerInvariantFinder  usecase.LedgerInvariantFinder
+	AccountIbanFinder      usecase.BankAccountIbanFinder
+	AccountIDFinder        usecase.BankAccountIDFinder
+	AccountIbanBicFinder   usecase.BankAccountIbanBicFinder
+	TransactionFinder      usecase.TransactionFinder
+	TransactionLister      usecase.TransactionLister
+	TransactionDebitSummer usecase.TransactionDebitSummer
+
+	AccountLocker                  usecase.AccountLocker
+	AccountsIbanFinder             usecase.BankAccountsIbanFinder
+	TransferBulkFinder             usecase.TransferBulkFinder
+	TransferBulkTransactionsFinder usecase.TransferBulkTransactionsFinder
+	TransactionReversedSummer      usecase.TransactionReversedSummer
+	TransactionReferenceFinder     usecase.TransactionReferenceFinder
+
+	TransferBulkJobEnqueuer  usecase.TransferBulkJobEnqueuer
+	TransferBulkJobFinder    usecase.TransferBulkJobFinder
+	TransferBulkJobClaimer   usecase.TransferBulkJobClaimer
+	TransferBulkJobFinisher  usecase.TransferBulkJobFinisher
+	TransferBulkJobLister    usecase.TransferBulkJobLister
+	TransferBulkJobCanceller usecase.TransferBulkJobCanceller
+
+	StandingOrderAdder           usecase.StandingOrderAdder
+	StandingOrderFinder          usecase.StandingOrderFinder
+	StandingOrderLister          usecase.StandingOrderLister
+	StandingOrderUpdater         usecase.StandingOrderUpdater
+	StandingOrderClaimer         usecase.StandingOrderClaimer
+	StandingOrderExecutionAdder  usecase.StandingOrderExecutionAdder
+	StandingOrderExecutionLister usecase.StandingOrderExecutionLister
+
+	HoldAdder         usecase.HoldAdder
+	HoldFinder        usecase.HoldFinder
+	HoldExpiredFinder usecase.HoldExpiredFinder
+	HoldUpdater       usecase.HoldUpdater
+	HeldUpdater       usecase.HeldUpdater
+
+	OverdraftLimitUpdater usecase.OverdraftLimitUpdater
+
+	TransferLimitFinder usecase.TransferLimitFinder
+	TransferLimitSetter usecase.TransferLimitSetter
+	TransferUsageFinder usecase.TransferUsageFinder
+
+	TransferBulkDecisionAdder usecase.TransferBulkDecisionAdder
+	// ApprovalThresholds are the amounts, by bank account currency, the transfer bulks debiting more than await
+	// approval, parsed from config.
+	ApprovalThresholds model.ApprovalThresholds
+
+	// RateProvider provides the exchange rates, the static rates from config are used when not set by an Option.
+	RateProvider usecase.RateProvider
+	// FeeCalculator calculates the transfer fees, the static fees from config are used when not set by an Option.
+	FeeCalculator usecase.FeeCalculator
+	// CounterpartyScreener screens the counterparties, the screening list is used when not set by an Option.
+	CounterpartyScreener usecase.CounterpartyScreener
+	// ScreeningList is the sanctions and block lists of the file from config, reloaded by the worker.
+	ScreeningList *screening.ListScreener
+
+	// TransactionBulkJob processes the asynchronous and the scheduled transfer bulks, run by the worker.
+	TransactionBulkJob usecase.TransactionBulkJob
+	// StandingOrder executes the due standing orders, run by the worker.
+	StandingOrder usecase.StandingOrder
+	// FundHold places, captures and releases the holds, the expired holds are released by the worker.
+	FundHold usecase.FundHold
+	// StatementExporter exports the account statements, served by the REST handler and the statement command.
+	StatementExporter *statement.Exporter
 
 	QontoService     *service.QontoService
 	QontoRESTService *service.QontoRESTService
@@ -68,6 +143,8 @@ func NewServiceLocator(cfg *config.Config, opts ...Option) (*Locator, error) {
 
 	handler.AppendStandardHandlers(cfg.ServiceName, &l.Provider)
 	handler.SetResponseModifier(&l.Provider)
+	handler.SetIncomingHeaderMatcher(&l.Provider)
+	handler.SetRawBodyMarshalers(&l.Provider)
 
 	var err error
 
@@ -87,7 +164,9 @@ func NewServiceLocator(cfg *config.Config, opts ...Option) (*Locator, error) {
 	l.setGRPCUnitaryInterceptors()
 
 	// setting up use cases dependencies
-	l.setupUsecaseDependencies()
+	if err := l.setupUsecaseDependencies(); err != nil {
+		return nil, err
+	}
 
 	// setting up services
 	l.setupServices()
@@ -177,28 +256,260 @@ func (l *Locator) setGRPCUnitaryInterceptors() {
 	}...)
 }
 
-func (l *Locator) setupUsecaseDependencies() {
+func (l *Locator) setupUsecaseDependencies() error {
 	accountStorage := storage.NewBankAccount(l.Storage)
 	transactionStorage := storage.NewTransaction(l.Storage)
-
+	transferBulkStorage := storage.NewTransferBulk(l.Storage)
+	ledgerStorage := storage.NewLedger(l.Storage)
+	transferBulkJobStorage := storage.NewTransferBulkJob(l.Storage)
+	standingOrderStorage := storage.NewStandingOrder(l.Storage)
+	holdStorage := storage.NewHold(l.Storage)
+	transferLimitStorage := storage.NewTransferLimit(l.Storage)
+	transferBulkDecisionStorage := storage.NewTransferBulkDecision(l.Storage)
+
+	l.TransferBulkReserver = transferBulkStorage
+	l.TransferBulkCompleter = transferBulkStorage
+	l.TransferBulkFinder = transferBulkStorage
+	l.TransferBulkLocker = transferBulkStorage
+	l.TransferBulkDecisionAdder = transferBulkDecisionStorage
+
+	l.AccountFinder = accountStorage
 	l.AccountBalanceChecker = accountStorage
 	l.BalanceUpdater = accountStorage
+	l.AccountIbanFinder = accountStorage
+	l.AccountIDFinder = accountStorage
+	l.AccountIbanBicFinder = accountStorage
+	l.AccountLocker = accountStorage
+	l.AccountsIbanFinder = accountStorage
+	l.HeldUpdater = accountStorage
+	l.OverdraftLimitUpdater = accountStorage
 
 	l.TransactionAdder = transactionStorage
+	l.TransactionIDsFinder = transactionStorage
+	l.TransactionFinder = transactionStorage
+	l.TransactionLister = transactionStorage
+	l.TransactionDebitSummer = transactionStorage
+	l.TransferBulkTransactionsFinder = transactionStorage
+	l.TransactionReversedSummer = transactionStorage
+	l.TransactionReferenceFinder = transactionStorage
+	l.TransferUsageFinder = transactionStorage
+
+	l.JournalPoster = ledgerStorage
+	l.LedgerInvariantFinder = ledgerStorage
+
+	l.TransferBulkJobEnqueuer = transferBulkJobStorage
+	l.TransferBulkJobFinder = transferBulkJobStorage
+	l.TransferBulkJobClaimer = transferBulkJobStorage
+	l.TransferBulkJobFinisher = transferBulkJobStorage
+	l.TransferBulkJobLister = transferBulkJobStorage
+	l.TransferBulkJobCanceller = transferBulkJobStorage
+
+	l.StandingOrderAdder = standingOrderStorage
+	l.StandingOrderFinder = standingOrderStorage
+	l.StandingOrderLister = standingOrderStorage
+	l.StandingOrderUpdater = standingOrderStorage
+	l.StandingOrderClaimer = standingOrderStorage
+	l.StandingOrderExecutionAdder = standingOrderStorage
+	l.StandingOrderExecutionLister = standingOrderStorage
+
+	l.HoldAdder = holdStorage
+	l.HoldFinder = holdStorage
+	l.HoldExpiredFinder = holdStorage
+	l.HoldUpdater = holdStorage
+
+	l.TransferLimitFinder = transferLimitStorage
+	l.TransferLimitSetter = transferLimitStorage
+
+	if l.RateProvider == nil {
+		rates, err := exchange.NewStaticRates(l.Config.FXRates)
+		if err != nil {
+			return err
+		}
+
+		l.RateProvider = rates
+	}
+
+	if l.FeeCalculator == nil {
+		fees, err := fee.NewStaticFees(l.Config.TransferFees)
+		if err != nil {
+			return err
+		}
+
+		l.FeeCalculator = fees
+	}
+
+	screeningList, err := screening.NewListScreener(l.Config.Screening.ListFile, l.Config.Screening.Threshold)
+	if err != nil {
+		return err
+	}
+
+	l.ScreeningList = screeningList
+
+	if l.CounterpartyScreener == nil {
+		l.CounterpartyScreener = screeningList
+	}
+
+	thresholds, err := model.ParseApprovalThresholds(l.Config.ApprovalThresholds)
+	if err != nil {
+		return err
+	}
+
+	l.ApprovalThresholds = thresholds
+
+	return nil
 }
 
 func (l *Locator) setupServices() {
+	transactionBulk := usecase.NewTransactionBulk(
+		l.CtxdLogger(),
+		l.Storage,
+		l.TransferBulkReserver,
+		l.TransferBulkCompleter,
+		l.AccountFinder,
+		l.AccountBalanceChecker,
+		l.BalanceUpdater,
+		l.TransactionAdder,
+		l.TransactionIDsFinder,
+		l.JournalPoster,
+		l.RateProvider,
+		l.AccountsIbanFinder,
+		l.AccountLocker,
+		l.Clock(),
+		l.TransferLimitFinder,
+		l.TransferUsageFinder,
+		l.FeeCalculator,
+		l.ApprovalThresholds,
+		l.TransferBulkDecisionAdder,
+		l.CounterpartyScreener,
+	)
+
+	l.TransactionBulkJob = usecase.NewTransactionBulkJob(
+		l.CtxdLogger(),
+		l.Storage,
+		l.Clock(),
+		l.Config.TransferBulkJob.Timeout,
+		transactionBulk,
+		l.TransferBulkJobEnqueuer,
+		l.TransferBulkJobFinder,
+		l.TransferBulkJobClaimer,
+		l.TransferBulkJobFinisher,
+		l.TransferBulkJobLister,
+		l.TransferBulkJobCanceller,
+	)
+
+	l.StandingOrder = usecase.NewStandingOrder(
+		l.CtxdLogger(),
+		l.Storage,
+		l.Clock(),
+		l.TransactionBulkJob,
+		l.StandingOrderAdder,
+		l.StandingOrderFinder,
+		l.StandingOrderLister,
+		l.StandingOrderUpdater,
+		l.StandingOrderClaimer,
+		l.StandingOrderExecutionAdder,
+		l.StandingOrderExecutionLister,
+	)
+
+	l.FundHold = usecase.NewFundHold(
+		l.CtxdLogger(),
+		l.Storage,
+		l.Clock(),
+		l.AccountIbanFinder,
+		l.AccountLocker,
+		l.HoldAdder,
+		l.HoldFinder,
+		l.HoldExpiredFinder,
+		l.HoldUpdater,
+		l.HeldUpdater,
+		l.TransactionAdder,
+		l.JournalPoster,
+		l.BalanceUpdater,
+	)
+
 	l.QontoService = service.NewQontoService(
-		usecase.NewTransactionBulk(
+		transactionBulk,
+		l.TransactionBulkJob,
+		usecase.NewLedgerCheck(
+			l.CtxdLogger(),
+			l.LedgerInvariantFinder,
+		),
+		usecase.NewTransactionQuery(
+			l.AccountIbanFinder,
+			l.TransactionFinder,
+			l.TransactionLister,
+		),
+		usecase.NewBankAccountQuery(
+			l.AccountIDFinder,
+			l.AccountIbanBicFinder,
+		),
+		l.StandingOrder,
+		usecase.NewTransactionReversal(
 			l.CtxdLogger(),
 			l.Storage,
-			l.AccountBalanceChecker,
+			l.Clock(),
+			l.AccountIbanFinder,
+			l.AccountLocker,
+			l.TransferBulkFinder,
+			l.TransactionFinder,
+			l.TransferBulkTransactionsFinder,
+			l.TransactionReversedSummer,
+			l.TransactionAdder,
+			l.JournalPoster,
 			l.BalanceUpdater,
+		),
+		usecase.NewInboundCredit(
+			l.CtxdLogger(),
+			l.Storage,
+			l.Clock(),
+			l.AccountIbanFinder,
+			l.AccountLocker,
+			l.TransactionReferenceFinder,
 			l.TransactionAdder,
+			l.JournalPoster,
+			l.BalanceUpdater,
+			l.RateProvider,
+		),
+		l.FundHold,
+		usecase.NewOverdraftLimit(
+			l.CtxdLogger(),
+			l.Storage,
+			l.AccountIbanFinder,
+			l.AccountLocker,
+			l.OverdraftLimitUpdater,
+		),
+		usecase.NewTransferLimits(
+			l.CtxdLogger(),
+			l.Clock(),
+			l.AccountIbanFinder,
+			l.TransferLimitSetter,
+		),
+		usecase.NewTransferBulkApproval(
+			l.CtxdLogger(),
+			l.Storage,
+			l.Clock(),
+			transactionBulk,
+			l.TransferBulkLocker,
+			l.TransferBulkCompleter,
+			l.TransferBulkDecisionAdder,
 		),
 	)
 
 	l.QontoRESTService = service.NewQontoRESTService(l.QontoService)
+
+	handler.AppendTransferBulkCSVHandlers(&l.Provider, l.QontoRESTService, l.CtxdLogger())
+
+	l.StatementExporter = statement.NewExporter(
+		usecase.NewStatementExport(
+			l.Storage,
+			l.Clock(),
+			l.AccountIbanFinder,
+			l.TransactionLister,
+			l.TransactionDebitSummer,
+		),
+	)
+
+	handler.AppendStatementHandlers(&l.Provider, l.StatementExporter, l.CtxdLogger())
 }
 
 // ZapLogger returns *zap.Logger that used in Logger.
@@ -20,6 +20,51 @@ type Config struct {
 	Environment    string   `envconfig:"ENVIRONMENT" default:"dev"`
 	PostgresDB     DBConfig `split_words:"true"`
 	Log            LoggerConfig
+	// FXRates are the static exchange rates by currency pair, e.g. "USD/EUR:0.8812,GBP/EUR:1.1689".
+	FXRates map[string]string `envconfig:"FX_RATES"`
+	// TransferFees are the static transfer fees by kind and bank account currency, e.g. "instant/EUR:0.50".
+	TransferFees map[string]string `envconfig:"TRANSFER_FEES"`
+	// ApprovalThresholds are the amounts by bank account currency, e.g. "EUR:50000.00", the transfer bulks debiting
+	// more than await approval by a second user.
+	ApprovalThresholds map[string]string `envconfig:"APPROVAL_THRESHOLDS"`
+	// TransferBulkJob configures the worker performing the asynchronous transfer bulks.
+	TransferBulkJob TransferBulkJobConfig
+	// StandingOrder configures the worker executing the standing orders.
+	StandingOrder StandingOrderConfig
+	// Hold configures the worker releasing the expired holds.
+	Hold HoldConfig
+	// Screening configures the screening of the counterparties against the sanctions and block lists.
+	Screening ScreeningConfig
+}
+
+// TransferBulkJobConfig represents the transfer bulk job worker configuration.
+type TransferBulkJobConfig struct {
+	// PollInterval is the time the worker waits when there is no pending job.
+	PollInterval time.Duration `envconfig:"TRANSFER_BULK_JOB_POLL_INTERVAL" default:"1s"`
+	// Timeout is the time after which a job still processing is processed again.
+	Timeout time.Duration `envconfig:"TRANSFER_BULK_JOB_TIMEOUT" default:"5m"`
+}
+
+// StandingOrderConfig represents the standing order worker configuration.
+type StandingOrderConfig struct {
+	// PollInterval is the time the worker waits when there is no standing order to execute.
+	PollInterval time.Duration `envconfig:"STANDING_ORDER_POLL_INTERVAL" default:"1m"`
+}
+
+// HoldConfig represents the hold worker configuration.
+type HoldConfig struct {
+	// PollInterval is the time the worker waits when there is no hold to expire.
+	PollInterval time.Duration `envconfig:"HOLD_POLL_INTERVAL" default:"1m"`
+}
+
+// ScreeningConfig represents the counterparty screening configuration.
+type ScreeningConfig struct {
+	// ListFile is the CSV file of the sanctions and block lists, nothing is screened when empty.
+	ListFile string `envconfig:"SCREENING_LIST_FILE"`
+	// Threshold is the score, from 0 to 1, the counterparty names match the entries from.
+	Threshold float64 `envconfig:"SCREENING_THRESHOLD" default:"0.85"`
+	// ReloadInterval is the time the worker waits before checking whether the list file changed.
+	ReloadInterval time.Duration `envconfig:"SCREENING_RELOAD_INTERVAL" default:"1m"`
 }
 
 // DBConfig represents the DB configuration fields and values.
@@ -27,6 +27,20 @@ var cfg = &config.Config{
 		Level:      zapcore.DebugLevel,
 		FieldNames: "true",
 	},
+	TransferBulkJob: config.TransferBulkJobConfig{
+		PollInterval: time.Second,
+		Timeout:      5 * time.Minute,
+	},
+	StandingOrder: config.StandingOrderConfig{
+		PollInterval: time.Minute,
+	},
+	Hold: config.HoldConfig{
+		PollInterval: time.Minute,
+	},
+	Screening: config.ScreeningConfig{
+		Threshold:      0.85,
+		ReloadInterval: time.Minute,
+	},
 }
 
 func TestGetConfig_EnvSuccessfully(t *testing.T) {
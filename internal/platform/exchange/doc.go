@@ -0,0 +1,2 @@
+// Package exchange is a directory of usecase exchange rate providers.
+package exchange
@@ -0,0 +1,50 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+)
+
+// StaticRates provides exchange rates from a static table.
+type StaticRates struct {
+	rates map[string]string
+}
+
+// NewStaticRates returns instance of StaticRates.
+//
+// The rates are keyed by currency pair, e.g. "USD/EUR": "0.8812" is the amount of EUR for one USD.
+func NewStaticRates(rates map[string]string) (*StaticRates, error) {
+	sr := StaticRates{
+		rates: make(map[string]string, len(rates)),
+	}
+
+	for pair, rate := range rates {
+		currencies := strings.Split(pair, "/")
+		if len(currencies) != 2 {
+			return nil, fmt.Errorf("invalid currency pair %q, expected FROM/TO", pair)
+		}
+
+		// converting an amount validates both currencies and the rate.
+		if _, err := model.ConvertCents(0, currencies[0], currencies[1], rate); err != nil {
+			return nil, fmt.Errorf("invalid exchange rate %s: %w", pair, err)
+		}
+
+		sr.rates[pair] = rate
+	}
+
+	return &sr, nil
+}
+
+// Rate returns the decimal amount of the currency to for one unit of the currency from.
+func (r *StaticRates) Rate(_ context.Context, from, to string) (string, error) {
+	rate, ok := r.rates[from+"/"+to]
+	if !ok {
+		return "", fmt.Errorf("%w: from %s to %s", usecase.ErrRateNotFound, from, to)
+	}
+
+	return rate, nil
+}
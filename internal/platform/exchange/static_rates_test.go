@@ -0,0 +1,41 @@
+package exchange_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/exchange"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticRates_Rate(t *testing.T) {
+	t.Parallel()
+
+	r, err := exchange.NewStaticRates(map[string]string{
+		"USD/EUR": "0.8812",
+	})
+	require.NoError(t, err)
+
+	rate, err := r.Rate(context.Background(), "USD", "EUR")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.8812", rate)
+
+	_, err = r.Rate(context.Background(), "EUR", "USD")
+	assert.ErrorIs(t, err, usecase.ErrRateNotFound)
+}
+
+func TestNewStaticRates(t *testing.T) {
+	t.Parallel()
+
+	_, err := exchange.NewStaticRates(map[string]string{"USDEUR": "0.8812"})
+	assert.Error(t, err)
+
+	_, err = exchange.NewStaticRates(map[string]string{"USD/EUR": "-1"})
+	assert.ErrorIs(t, err, model.ErrInvalidRate)
+
+	_, err = exchange.NewStaticRates(map[string]string{"USD/XXX": "1"})
+	assert.ErrorIs(t, err, model.ErrUnknownCurrency)
+}
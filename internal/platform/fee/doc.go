@@ -0,0 +1,2 @@
+// Package fee is a directory of usecase transfer fee calculators.
+package fee
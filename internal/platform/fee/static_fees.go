@@ -0,0 +1,57 @@
+package fee
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+)
+
+// StaticFees charges fixed fees by kind of credit transfer and bank account currency, from a static table.
+type StaticFees struct {
+	fees map[string]model.Cents
+}
+
+// NewStaticFees returns instance of StaticFees.
+//
+// The fees are keyed by kind of credit transfer and bank account currency, e.g. "instant/EUR": "0.50" charges 0.50 EUR
+// for an instant credit transfer from a bank account in EUR. The credit transfers without fee are not charged.
+func NewStaticFees(fees map[string]string) (*StaticFees, error) {
+	sf := StaticFees{
+		fees: make(map[string]model.Cents, len(fees)),
+	}
+
+	for key, amount := range fees {
+		parts := strings.Split(key, "/")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid transfer fee %q, expected KIND/CURRENCY", key)
+		}
+
+		switch model.TransferKind(parts[0]) {
+		case model.TransferKindSEPA, model.TransferKindInstant, model.TransferKindInternational, model.TransferKindInternal:
+		default:
+			return nil, fmt.Errorf("invalid transfer fee %q, unknown kind %q", key, parts[0])
+		}
+
+		// parsing the amount validates the currency too.
+		feeCents, err := model.ParseCents(amount, parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid transfer fee %s: %w", key, err)
+		}
+
+		if feeCents < 0 {
+			return nil, fmt.Errorf("invalid transfer fee %s: must not be negative", key)
+		}
+
+		sf.fees[key] = feeCents
+	}
+
+	return &sf, nil
+}
+
+// Fee returns the fee of the kind of the credit transfer in the bank account currency, 0 when there is none.
+func (f *StaticFees) Fee(_ context.Context, input usecase.FeeInput) (model.Cents, error) {
+	return f.fees[string(input.Kind)+"/"+input.BankAccount.Currency], nil
+}
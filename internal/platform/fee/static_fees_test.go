@@ -0,0 +1,53 @@
+package fee_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/fee"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticFees_Fee(t *testing.T) {
+	t.Parallel()
+
+	f, err := fee.NewStaticFees(map[string]string{
+		"instant/EUR":       "0.50",
+		"international/EUR": "5.00",
+	})
+	require.NoError(t, err)
+
+	account := model.BankAccount{BankAccountState: model.BankAccountState{Currency: "EUR"}}
+
+	feeCents, err := f.Fee(context.Background(), usecase.FeeInput{BankAccount: account, Kind: model.TransferKindInstant})
+	assert.NoError(t, err)
+	assert.Equal(t, model.Cents(50), feeCents)
+
+	feeCents, err = f.Fee(context.Background(), usecase.FeeInput{BankAccount: account, Kind: model.TransferKindSEPA})
+	assert.NoError(t, err)
+	assert.Equal(t, model.Cents(0), feeCents)
+
+	account.Currency = "USD"
+
+	feeCents, err = f.Fee(context.Background(), usecase.FeeInput{BankAccount: account, Kind: model.TransferKindInstant})
+	assert.NoError(t, err)
+	assert.Equal(t, model.Cents(0), feeCents)
+}
+
+func TestNewStaticFees_invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, fees := range []map[string]string{
+		{"instant": "0.50"},
+		{"express/EUR": "0.50"},
+		{"instant/XXX": "0.50"},
+		{"instant/EUR": "0.505"},
+		{"instant/EUR": "-0.50"},
+	} {
+		_, err := fee.NewStaticFees(fees)
+		assert.Error(t, err, fees)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/bool64/ctxd"
 	grpcRest "github.com/dohernandez/qonto/pkg/grpc/rest"
@@ -16,8 +17,10 @@ import (
 
 type Provider struct {
 	// Handlers contains non-api handlers to add to rest service.
-	Handlers         []grpcRest.HandlerPathOption
-	ResponseModifier func(context.Context, http.ResponseWriter, proto.Message) error
+	Handlers              []grpcRest.HandlerPathOption
+	ResponseModifier      func(context.Context, http.ResponseWriter, proto.Message) error
+	IncomingHeaderMatcher mux.HeaderMatcherFunc
+	Marshalers            map[string]mux.Marshaler
 }
 
 // AppendStandardHandlers registers non-api handlers.
@@ -119,3 +122,30 @@ func SetResponseModifier(p *Provider) {
 		return nil
 	}
 }
+
+// SetIncomingHeaderMatcher sets the matcher forwarding the http headers the service relies on as grpc metadata.
+func SetIncomingHeaderMatcher(p *Provider) {
+	p.IncomingHeaderMatcher = func(key string) (string, bool) {
+		if strings.EqualFold(key, "Idempotency-Key") {
+			return "idempotency-key", true
+		}
+
+		// the user id is asserted by a trusted proxy, e.g. the API gateway, replacing the header sent by the clients.
+		// The service does not verify it.
+		if strings.EqualFold(key, "X-User-Id") {
+			return "x-user-id", true
+		}
+
+		return mux.DefaultHeaderMatcher(key)
+	}
+}
+
+// SetRawBodyMarshalers sets the marshalers reading the XML request body as is, for the methods which body is a file.
+func SetRawBodyMarshalers(p *Provider) {
+	marshaler := grpcRest.NewRawBodyMarshaler()
+
+	p.Marshalers = map[string]mux.Marshaler{
+		"application/xml": marshaler,
+		"text/xml":        marshaler,
+	}
+}
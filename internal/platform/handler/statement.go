@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/statement"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	grpcRest "github.com/dohernandez/qonto/pkg/grpc/rest"
+)
+
+// defaultStatementFormat is the format of the statement when the request does not set one.
+const defaultStatementFormat = statement.FormatCSV
+
+// AppendStatementHandlers registers the handler downloading the account statements.
+//
+// GET /v1/accounts/{iban}/statement?from=YYYY-MM-DD&to=YYYY-MM-DD&format=camt053|mt940|csv|ofx
+func AppendStatementHandlers(p *Provider, exporter *statement.Exporter, logger ctxd.Logger) {
+	p.Handlers = append(p.Handlers,
+		grpcRest.HandlerPathOption{
+			Method:      http.MethodGet,
+			PathPattern: "/v1/accounts/{iban}/statement",
+			Handler: func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+				ctx := r.Context()
+				query := r.URL.Query()
+
+				format := defaultStatementFormat
+
+				if f := query.Get("format"); f != "" {
+					var err error
+
+					format, err = statement.ParseFormat(f)
+					if err != nil {
+						http.Error(w, err.Error(), http.StatusBadRequest)
+
+						return
+					}
+				}
+
+				req := statement.Request{
+					Iban:   params["iban"],
+					From:   query.Get("from"),
+					To:     query.Get("to"),
+					Format: format,
+				}
+
+				// the statement is rendered before writing, so that a failure can still be reported with its status.
+				var buf bytes.Buffer
+
+				if err := exporter.Export(ctx, &buf, req); err != nil {
+					switch {
+					case errors.Is(err, usecase.ErrInvalidInput):
+						http.Error(w, err.Error(), http.StatusBadRequest)
+					case errors.Is(err, storage.ErrNotFound):
+						http.Error(w, "bank account not found", http.StatusNotFound)
+					default:
+						logger.Error(ctx, "failed to export the statement", "error", err)
+
+						http.Error(w, "cannot export the statement", http.StatusInternalServerError)
+					}
+
+					return
+				}
+
+				w.Header().Set("Content-Type", format.ContentType())
+				w.Header().Set("Content-Disposition", "attachment; filename="+req.Filename())
+				w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+
+				if _, err := buf.WriteTo(w); err != nil {
+					logger.Error(ctx, "failed to write the statement", "error", err)
+				}
+			},
+		},
+	)
+}
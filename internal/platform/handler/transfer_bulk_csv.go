@@ -0,0 +1,333 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/bool64/ctxd"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/service"
+	"github.com/dohernandez/qonto/internal/platform/transfercsv"
+	grpcRest "github.com/dohernandez/qonto/pkg/grpc/rest"
+	api "github.com/dohernandez/qonto/pkg/proto"
+	mux "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxTransferBulkCSVSize is the maximum size of the multipart request uploading the CSV.
+	maxTransferBulkCSVSize = 10 << 20
+	// maxTransferBulkCSVMemory is the size of the upload kept in memory, the rest is stored in temporary files.
+	maxTransferBulkCSVMemory = 1 << 20
+)
+
+// TransferBulker performs transfer bulks.
+type TransferBulker interface {
+	TransferBulk(ctx context.Context, req *api.TransferBulkRequest) (*api.TransferBulkResponse, error)
+}
+
+// transferBulkCSVPreview is the outcome of validating the CSV without performing the transfers.
+type transferBulkCSVPreview struct {
+	TransferCount int                    `json:"transferCount"`
+	Totals        []transferBulkCSVTotal `json:"totals"`
+}
+
+// transferBulkCSVTotal is the total amount of the transfers in a currency.
+type transferBulkCSVTotal struct {
+	Currency    string      `json:"currency"`
+	AmountCents model.Cents `json:"amountCents,string"`
+	Amount      string      `json:"amount"`
+}
+
+// AppendTransferBulkCSVHandlers registers the handler performing the transfers uploaded as CSV.
+//
+// POST /v1/transfer/bulk/csv, a multipart form with the fields:
+//   - file: the CSV, with a header line naming the columns.
+//   - organization_name, organization_iban, organization_bic: the account the transfers are debited from.
+//   - mapping: the column names of the transfer fields, e.g. "amount=Montant,currency=Devise", the columns are named
+//     as the fields by default.
+//   - delimiter: the field delimiter, "," by default.
+//   - idempotency_key, async, execution_mode, requested_execution_date: as TransferBulk.
+//   - validate_only: only validates the CSV and responds the transfer count and the totals by currency.
+//
+// The violations of the credit transfers are responded by CSV line and column, e.g. "lines[3].Montant".
+func AppendTransferBulkCSVHandlers(p *Provider, transferBulker TransferBulker, logger ctxd.Logger) {
+	p.Handlers = append(p.Handlers,
+		grpcRest.HandlerPathOption{
+			Method:      http.MethodPost,
+			PathPattern: "/v1/transfer/bulk/csv",
+			Handler: func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+				ctx := r.Context()
+
+				r.Body = http.MaxBytesReader(w, r.Body, maxTransferBulkCSVSize)
+
+				if err := r.ParseMultipartForm(maxTransferBulkCSVMemory); err != nil {
+					writeStatus(ctx, w, logger, status.Errorf(codes.InvalidArgument, "invalid multipart form: %v", err))
+
+					return
+				}
+
+				defer r.MultipartForm.RemoveAll() // nolint: errcheck
+
+				parser, req, err := transferBulkCSVRequest(r)
+				if err != nil {
+					writeStatus(ctx, w, logger, err)
+
+					return
+				}
+
+				file, _, err := r.FormFile("file")
+				if err != nil {
+					writeStatus(ctx, w, logger, invalidField("file", err))
+
+					return
+				}
+
+				defer file.Close() // nolint: errcheck
+
+				transfers, lines, err := parser.Parse(file)
+				if err != nil {
+					writeStatus(ctx, w, logger, invalidInput(err))
+
+					return
+				}
+
+				validateOnly, _ := strconv.ParseBool(r.FormValue("validate_only")) // nolint: errcheck
+
+				if validateOnly {
+					preview, err := previewTransferBulkCSV(req, transfers)
+					if err != nil {
+						writeStatus(ctx, w, logger, lineViolations(invalidInput(err), parser, lines))
+
+						return
+					}
+
+					writeJSON(ctx, w, logger, http.StatusOK, preview)
+
+					return
+				}
+
+				req.CreditTransfers = make([]*api.TransferBulkRequest_CreditTransfersRow, len(transfers))
+
+				for i, t := range transfers {
+					req.CreditTransfers[i] = &api.TransferBulkRequest_CreditTransfersRow{
+						DecimalAmount:    t.Amount,
+						Currency:         t.Currency,
+						CounterpartyName: t.CounterpartyName,
+						CounterpartyBic:  t.CounterpartyBic,
+						CounterpartyIban: t.CounterpartyIban,
+						Description:      t.Description,
+					}
+				}
+
+				// the user id asserted by the trusted proxy is forwarded as the gateway does, the transfer bulk may
+				// require approval.
+				if user := r.Header.Get("X-User-Id"); user != "" {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-user-id", user))
+				}
+
+				resp, err := transferBulker.TransferBulk(ctx, req)
+				if err != nil {
+					writeStatus(ctx, w, logger, lineViolations(err, parser, lines))
+
+					return
+				}
+
+				code := http.StatusCreated
+				if resp.JobId != 0 || model.TransferBulkStatus(resp.Status).Pending() {
+					code = http.StatusAccepted
+				}
+
+				writeProto(ctx, w, logger, code, resp)
+			},
+		},
+	)
+}
+
+// transferBulkCSVRequest reads the form fields besides the file.
+func transferBulkCSVRequest(r *http.Request) (transfercsv.Parser, *api.TransferBulkRequest, error) {
+	var (
+		parser transfercsv.Parser
+		err    error
+	)
+
+	if parser.Mapping, err = transfercsv.ParseMapping(r.FormValue("mapping")); err != nil {
+		return parser, nil, invalidField("mapping", err)
+	}
+
+	if parser.Comma, err = transfercsv.ParseComma(r.FormValue("delimiter")); err != nil {
+		return parser, nil, invalidField("delimiter", err)
+	}
+
+	req := &api.TransferBulkRequest{
+		OrganizationName:       r.FormValue("organization_name"),
+		OrganizationIban:       r.FormValue("organization_iban"),
+		OrganizationBic:        r.FormValue("organization_bic"),
+		IdempotencyKey:         r.FormValue("idempotency_key"),
+		RequestedExecutionDate: r.FormValue("requested_execution_date"),
+	}
+
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = r.Header.Get("Idempotency-Key")
+	}
+
+	if v := r.FormValue("async"); v != "" {
+		if req.Async, err = strconv.ParseBool(v); err != nil {
+			return parser, nil, invalidField("async", err)
+		}
+	}
+
+	if v := r.FormValue("execution_mode"); v != "" {
+		mode, ok := api.TransferBulkRequest_ExecutionMode_value[v]
+		if !ok {
+			return parser, nil, invalidField("execution_mode", errors.New("must be EXECUTION_MODE_ATOMIC or EXECUTION_MODE_PARTIAL"))
+		}
+
+		req.ExecutionMode = api.TransferBulkRequest_ExecutionMode(mode)
+	}
+
+	return parser, req, nil
+}
+
+// previewTransferBulkCSV validates the transfers and returns their count and totals by currency.
+func previewTransferBulkCSV(req *api.TransferBulkRequest, transfers []usecase.TransactionBulkTransferInput) (*transferBulkCSVPreview, error) {
+	amountsCents, err := usecase.ValidateTransactionBulkInput(usecase.TransactionBulkInput{
+		OrganizationName: req.OrganizationName,
+		OrganizationIban: req.OrganizationIban,
+		OrganizationBic:  req.OrganizationBic,
+		CreditTransfers:  transfers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preview := transferBulkCSVPreview{
+		TransferCount: len(transfers),
+		Totals:        []transferBulkCSVTotal{},
+	}
+
+	totals := make(map[string]int)
+
+	for i, t := range transfers {
+		idx, ok := totals[t.Currency]
+		if !ok {
+			idx = len(preview.Totals)
+			totals[t.Currency] = idx
+
+			preview.Totals = append(preview.Totals, transferBulkCSVTotal{Currency: t.Currency})
+		}
+
+		preview.Totals[idx].AmountCents += amountsCents[i]
+	}
+
+	for i, total := range preview.Totals {
+		preview.Totals[i].Amount = model.FormatCents(total.AmountCents, total.Currency)
+	}
+
+	return &preview, nil
+}
+
+// invalidField returns the InvalidArgument status of the form field.
+func invalidField(field string, err error) error {
+	return invalidInput(&usecase.ValidationError{
+		Violations: []usecase.FieldViolation{{Field: field, Description: err.Error()}},
+	})
+}
+
+// invalidInput returns the InvalidArgument status of the validation error.
+func invalidInput(err error) error {
+	var verr *usecase.ValidationError
+	if errors.As(err, &verr) {
+		return service.InvalidArgumentStatus(verr).Err()
+	}
+
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// lineViolations rewrites the credit transfer field violations of the status as the lines of the CSV.
+func lineViolations(err error, parser transfercsv.Parser, lines []int) error {
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		return err
+	}
+
+	for _, detail := range st.Details() {
+		br, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+
+		violations := make([]usecase.FieldViolation, len(br.FieldViolations))
+
+		for i, v := range br.FieldViolations {
+			violations[i] = usecase.FieldViolation{Field: v.Field, Description: v.Description}
+		}
+
+		return invalidInput(&usecase.ValidationError{Violations: parser.LineViolations(violations, lines)})
+	}
+
+	return err
+}
+
+// writeStatus writes the error as the gateway does, the google.rpc.Status with the HTTP status of its code.
+func writeStatus(ctx context.Context, w http.ResponseWriter, logger ctxd.Logger, err error) {
+	code := http.StatusInternalServerError
+
+	var herr *mux.HTTPStatusError
+	if errors.As(err, &herr) {
+		code = herr.HTTPStatus
+		err = herr.Err
+	}
+
+	st := status.Convert(err)
+
+	if herr == nil {
+		code = mux.HTTPStatusFromCode(st.Code())
+	}
+
+	writeProto(ctx, w, logger, code, st.Proto())
+}
+
+func writeProto(ctx context.Context, w http.ResponseWriter, logger ctxd.Logger, code int, m proto.Message) {
+	body, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal the response", "error", err)
+
+		http.Error(w, "cannot marshal the response", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeBody(ctx, w, logger, code, body)
+}
+
+func writeJSON(ctx context.Context, w http.ResponseWriter, logger ctxd.Logger, code int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal the response", "error", err)
+
+		http.Error(w, "cannot marshal the response", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeBody(ctx, w, logger, code, body)
+}
+
+func writeBody(ctx context.Context, w http.ResponseWriter, logger ctxd.Logger, code int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if _, err := w.Write(body); err != nil {
+		logger.Error(ctx, "failed to write the response", "error", err)
+	}
+}
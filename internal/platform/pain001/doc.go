@@ -0,0 +1,2 @@
+// Package pain001 imports ISO 20022 pain.001 customer credit transfer initiation files.
+package pain001
@@ -0,0 +1,189 @@
+package pain001
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+)
+
+// Namespace is the XML namespace of the pain.001.001.03 documents.
+const Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"
+
+var (
+	// ErrInvalidDocument error represents when the file is not a pain.001.001.03 document.
+	ErrInvalidDocument = errors.New("invalid pain.001 document")
+	// ErrControlMismatch error represents when the number of transactions or the control sum declared by the document
+	// do not match its credit transfers.
+	ErrControlMismatch = errors.New("pain.001 control mismatch")
+	// ErrMultipleDebtorAccounts error represents when the payment information blocks debit different accounts.
+	ErrMultipleDebtorAccounts = errors.New("pain.001 debits more than one account")
+)
+
+// Document is the pain.001.001.03 customer credit transfer initiation.
+type Document struct {
+	XMLName xml.Name             `xml:"Document"`
+	GrpHdr  GroupHeader          `xml:"CstmrCdtTrfInitn>GrpHdr"`
+	PmtInf  []PaymentInformation `xml:"CstmrCdtTrfInitn>PmtInf"`
+}
+
+// GroupHeader is the set of characteristics shared by all the payments of the document.
+type GroupHeader struct {
+	MsgID      string `xml:"MsgId"`
+	CreDtTm    string `xml:"CreDtTm"`
+	NbOfTxs    string `xml:"NbOfTxs"`
+	CtrlSum    string `xml:"CtrlSum"`
+	InitgPtyNm string `xml:"InitgPty>Nm"`
+}
+
+// PaymentInformation is a set of credit transfers debited from the same account.
+type PaymentInformation struct {
+	PmtInfID    string                 `xml:"PmtInfId"`
+	PmtMtd      string                 `xml:"PmtMtd"`
+	NbOfTxs     string                 `xml:"NbOfTxs"`
+	CtrlSum     string                 `xml:"CtrlSum"`
+	DbtrNm      string                 `xml:"Dbtr>Nm"`
+	DbtrIBAN    string                 `xml:"DbtrAcct>Id>IBAN"`
+	DbtrBIC     string                 `xml:"DbtrAgt>FinInstnId>BIC"`
+	CdtTrfTxInf []CreditTransferTxInfo `xml:"CdtTrfTxInf"`
+}
+
+// CreditTransferTxInfo is a single credit transfer.
+type CreditTransferTxInfo struct {
+	EndToEndID string `xml:"PmtId>EndToEndId"`
+	InstdAmt   struct {
+		Ccy   string `xml:"Ccy,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"Amt>InstdAmt"`
+	CdtrBIC  string `xml:"CdtrAgt>FinInstnId>BIC"`
+	CdtrNm   string `xml:"Cdtr>Nm"`
+	CdtrIBAN string `xml:"CdtrAcct>Id>IBAN"`
+	Ustrd    string `xml:"RmtInf>Ustrd"`
+}
+
+// Parse reads the pain.001.001.03 document.
+func Parse(r io.Reader) (*Document, error) {
+	var doc Document
+
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+	}
+
+	if doc.XMLName.Space != Namespace {
+		return nil, fmt.Errorf("%w: unexpected namespace %q, expected %q", ErrInvalidDocument, doc.XMLName.Space, Namespace)
+	}
+
+	if len(doc.PmtInf) == 0 {
+		return nil, fmt.Errorf("%w: no payment information", ErrInvalidDocument)
+	}
+
+	return &doc, nil
+}
+
+// Validate checks the number of transactions and the control sums of the group header and of each payment
+// information block, and that all the blocks debit the same account.
+//
+// The control sums are optional, they are only checked when present.
+func (d Document) Validate() error {
+	var (
+		count int
+		sum   = new(big.Rat)
+	)
+
+	for i, pmtInf := range d.PmtInf {
+		path := fmt.Sprintf("PmtInf[%d]", i)
+
+		if pmtInf.DbtrIBAN != d.PmtInf[0].DbtrIBAN || pmtInf.DbtrBIC != d.PmtInf[0].DbtrBIC {
+			return fmt.Errorf("%w: %s debits %s, expected %s", ErrMultipleDebtorAccounts, path, pmtInf.DbtrIBAN,
+				d.PmtInf[0].DbtrIBAN)
+		}
+
+		pmtSum := new(big.Rat)
+
+		for j, tx := range pmtInf.CdtTrfTxInf {
+			amount, ok := new(big.Rat).SetString(strings.TrimSpace(tx.InstdAmt.Value))
+			if !ok {
+				return fmt.Errorf("%w: %s.CdtTrfTxInf[%d].Amt.InstdAmt: invalid amount %q", ErrInvalidDocument, path, j,
+					tx.InstdAmt.Value)
+			}
+
+			pmtSum.Add(pmtSum, amount)
+		}
+
+		if err := checkControls(path, pmtInf.NbOfTxs, pmtInf.CtrlSum, len(pmtInf.CdtTrfTxInf), pmtSum); err != nil {
+			return err
+		}
+
+		count += len(pmtInf.CdtTrfTxInf)
+		sum.Add(sum, pmtSum)
+	}
+
+	if d.GrpHdr.NbOfTxs == "" {
+		return fmt.Errorf("%w: GrpHdr.NbOfTxs: must not be empty", ErrInvalidDocument)
+	}
+
+	return checkControls("GrpHdr", d.GrpHdr.NbOfTxs, d.GrpHdr.CtrlSum, count, sum)
+}
+
+func checkControls(path, nbOfTxs, ctrlSum string, count int, sum *big.Rat) error {
+	if nbOfTxs != "" && strings.TrimSpace(nbOfTxs) != fmt.Sprint(count) {
+		return fmt.Errorf("%w: %s.NbOfTxs is %s, the credit transfers are %d", ErrControlMismatch, path, nbOfTxs, count)
+	}
+
+	if ctrlSum == "" {
+		return nil
+	}
+
+	declared, ok := new(big.Rat).SetString(strings.TrimSpace(ctrlSum))
+	if !ok {
+		return fmt.Errorf("%w: %s.CtrlSum: invalid amount %q", ErrInvalidDocument, path, ctrlSum)
+	}
+
+	if declared.Cmp(sum) != 0 {
+		return fmt.Errorf("%w: %s.CtrlSum is %s, the credit transfers sum %s", ErrControlMismatch, path, ctrlSum,
+			sum.FloatString(2))
+	}
+
+	return nil
+}
+
+// TransactionBulkInput validates the document and returns the transfer bulk debiting its account.
+//
+// The message id identifies the transfer bulk, it is the idempotency key.
+func (d Document) TransactionBulkInput() (usecase.TransactionBulkInput, error) {
+	if err := d.Validate(); err != nil {
+		return usecase.TransactionBulkInput{}, err
+	}
+
+	debtor := d.PmtInf[0]
+
+	input := usecase.TransactionBulkInput{
+		OrganizationName: debtor.DbtrNm,
+		OrganizationIban: debtor.DbtrIBAN,
+		OrganizationBic:  debtor.DbtrBIC,
+		IdempotencyKey:   d.GrpHdr.MsgID,
+	}
+
+	if input.OrganizationName == "" {
+		input.OrganizationName = d.GrpHdr.InitgPtyNm
+	}
+
+	for _, pmtInf := range d.PmtInf {
+		for _, tx := range pmtInf.CdtTrfTxInf {
+			input.CreditTransfers = append(input.CreditTransfers, usecase.TransactionBulkTransferInput{
+				Amount:           strings.TrimSpace(tx.InstdAmt.Value),
+				Currency:         tx.InstdAmt.Ccy,
+				CounterpartyName: tx.CdtrNm,
+				CounterpartyBic:  tx.CdtrBIC,
+				CounterpartyIban: tx.CdtrIBAN,
+				Description:      tx.Ustrd,
+			})
+		}
+	}
+
+	return input, nil
+}
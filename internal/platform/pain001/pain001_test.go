@@ -0,0 +1,181 @@
+package pain001_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/pain001"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPmtInf(iban, nbOfTxs, ctrlSum string, txs ...string) string {
+	return fmt.Sprintf(`<PmtInf>
+      <PmtInfId>PMT-%s</PmtInfId>
+      <PmtMtd>TRF</PmtMtd>
+      <NbOfTxs>%s</NbOfTxs>
+      <CtrlSum>%s</CtrlSum>
+      <Dbtr><Nm>ACME Corp</Nm></Dbtr>
+      <DbtrAcct><Id><IBAN>%s</IBAN></Id></DbtrAcct>
+      <DbtrAgt><FinInstnId><BIC>OIVUSCLQXXX</BIC></FinInstnId></DbtrAgt>
+      %s
+    </PmtInf>`, nbOfTxs, nbOfTxs, ctrlSum, iban, strings.Join(txs, "\n"))
+}
+
+func testCdtTrfTxInf(amount, name, iban, bic, description string) string {
+	return fmt.Sprintf(`<CdtTrfTxInf>
+        <PmtId><EndToEndId>E2E</EndToEndId></PmtId>
+        <Amt><InstdAmt Ccy="EUR">%s</InstdAmt></Amt>
+        <CdtrAgt><FinInstnId><BIC>%s</BIC></FinInstnId></CdtrAgt>
+        <Cdtr><Nm>%s</Nm></Cdtr>
+        <CdtrAcct><Id><IBAN>%s</IBAN></Id></CdtrAcct>
+        <RmtInf><Ustrd>%s</Ustrd></RmtInf>
+      </CdtTrfTxInf>`, amount, bic, name, iban, description)
+}
+
+func testDocument(nbOfTxs, ctrlSum string, pmtInfs ...string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.03">
+  <CstmrCdtTrfInitn>
+    <GrpHdr>
+      <MsgId>PAYROLL-2021-11</MsgId>
+      <CreDtTm>2021-11-30T09:00:00</CreDtTm>
+      <NbOfTxs>%s</NbOfTxs>
+      <CtrlSum>%s</CtrlSum>
+      <InitgPty><Nm>ACME Corp</Nm></InitgPty>
+    </GrpHdr>
+    %s
+  </CstmrCdtTrfInitn>
+</Document>`, nbOfTxs, ctrlSum, strings.Join(pmtInfs, "\n"))
+}
+
+const testIban = "FR10474608000002006107XXXXX"
+
+var (
+	testBipBip     = testCdtTrfTxInf("14.50", "Bip Bip", "EE303680981021245685", "CRLYFRPPTOU", "Wonderland/4410")
+	testBugsBunny  = testCdtTrfTxInf("999", "Bugs Bunny", "FR9810009380540930414023042", "RNJZNTMC", "GoldenCarrot")
+	testWileCoyote = testCdtTrfTxInf("61238.00", "Wile E Coyote", "DE44354208100362090817", "ZDRPLBQI", "Invoice/12")
+)
+
+func TestDocument_TransactionBulkInput(t *testing.T) {
+	t.Parallel()
+
+	doc, err := pain001.Parse(strings.NewReader(testDocument("3", "62251.5",
+		testPmtInf(testIban, "2", "61252.50", testBipBip, testWileCoyote),
+		testPmtInf(testIban, "1", "999.00", testBugsBunny),
+	)))
+	require.NoError(t, err)
+
+	input, err := doc.TransactionBulkInput()
+	require.NoError(t, err)
+
+	assert.Equal(t, usecase.TransactionBulkInput{
+		OrganizationName: "ACME Corp",
+		OrganizationIban: testIban,
+		OrganizationBic:  "OIVUSCLQXXX",
+		IdempotencyKey:   "PAYROLL-2021-11",
+		CreditTransfers: []usecase.TransactionBulkTransferInput{
+			{
+				Amount:           "14.50",
+				Currency:         "EUR",
+				CounterpartyName: "Bip Bip",
+				CounterpartyBic:  "CRLYFRPPTOU",
+				CounterpartyIban: "EE303680981021245685",
+				Description:      "Wonderland/4410",
+			},
+			{
+				Amount:           "61238.00",
+				Currency:         "EUR",
+				CounterpartyName: "Wile E Coyote",
+				CounterpartyBic:  "ZDRPLBQI",
+				CounterpartyIban: "DE44354208100362090817",
+				Description:      "Invoice/12",
+			},
+			{
+				Amount:           "999",
+				Currency:         "EUR",
+				CounterpartyName: "Bugs Bunny",
+				CounterpartyBic:  "RNJZNTMC",
+				CounterpartyIban: "FR9810009380540930414023042",
+				Description:      "GoldenCarrot",
+			},
+		},
+	}, input)
+}
+
+func TestDocument_TransactionBulkInput_invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		scenario string
+		document string
+		err      error
+	}{
+		{
+			scenario: "group header number of transactions mismatch",
+			document: testDocument("2", "", testPmtInf(testIban, "", "", testBipBip, testBugsBunny, testWileCoyote)),
+			err:      pain001.ErrControlMismatch,
+		},
+		{
+			scenario: "group header control sum mismatch",
+			document: testDocument("2", "1013.49", testPmtInf(testIban, "2", "", testBipBip, testBugsBunny)),
+			err:      pain001.ErrControlMismatch,
+		},
+		{
+			scenario: "payment information number of transactions mismatch",
+			document: testDocument("2", "", testPmtInf(testIban, "1", "", testBipBip, testBugsBunny)),
+			err:      pain001.ErrControlMismatch,
+		},
+		{
+			scenario: "payment information control sum mismatch",
+			document: testDocument("2", "", testPmtInf(testIban, "2", "1013.51", testBipBip, testBugsBunny)),
+			err:      pain001.ErrControlMismatch,
+		},
+		{
+			scenario: "missing group header number of transactions",
+			document: testDocument("", "", testPmtInf(testIban, "1", "", testBipBip)),
+			err:      pain001.ErrInvalidDocument,
+		},
+		{
+			scenario: "invalid amount",
+			document: testDocument("1", "", testPmtInf(testIban, "1", "",
+				testCdtTrfTxInf("14,50", "Bip Bip", "EE303680981021245685", "CRLYFRPPTOU", "Wonderland/4410"))),
+			err: pain001.ErrInvalidDocument,
+		},
+		{
+			scenario: "more than one debtor account",
+			document: testDocument("2", "",
+				testPmtInf(testIban, "1", "", testBipBip),
+				testPmtInf("FR1420041010050500013M02606", "1", "", testBugsBunny),
+			),
+			err: pain001.ErrMultipleDebtorAccounts,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := pain001.Parse(strings.NewReader(tc.document))
+			require.NoError(t, err)
+
+			_, err = doc.TransactionBulkInput()
+			assert.ErrorIsf(t, err, tc.err, "got %v", err)
+		})
+	}
+}
+
+func TestParse_invalid(t *testing.T) {
+	t.Parallel()
+
+	for scenario, document := range map[string]string{
+		"not xml":            "organization_name,organization_iban",
+		"unexpected version": `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.09"></Document>`,
+		"no payment":         testDocument("0", ""),
+	} {
+		_, err := pain001.Parse(strings.NewReader(document))
+		assert.ErrorIsf(t, err, pain001.ErrInvalidDocument, scenario)
+	}
+}
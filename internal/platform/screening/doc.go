@@ -0,0 +1,2 @@
+// Package screening is a directory of usecase counterparty screeners.
+package screening
@@ -0,0 +1,114 @@
+package screening
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Entry is an entry of the sanctions and block lists.
+type Entry struct {
+	ID   string
+	Name string
+	// Iban and Bic are matched exactly, empty when the entry is matched by name only.
+	Iban string
+	Bic  string
+}
+
+// list is the parsed sanctions and block lists, indexed for screening.
+type list struct {
+	ibans map[string]Entry
+	bics  map[string]Entry
+	names []nameEntry
+}
+
+// nameEntry is an entry matched by name, with its name tokens.
+type nameEntry struct {
+	Entry
+	tokens []string
+}
+
+// columns are the columns of the list file, in any order.
+var columns = []string{"id", "name", "iban", "bic"}
+
+// ParseList parses the sanctions and block lists from a CSV with a header line naming the columns id, name, iban and
+// bic. Each entry is matched by its name, iban and bic, the empty ones are not matched.
+func ParseList(r io.Reader) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("invalid header: %w", err)
+	}
+
+	positions := make(map[string]int, len(columns))
+
+	for i, column := range header {
+		positions[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+
+	for _, column := range columns {
+		if _, ok := positions[column]; !ok {
+			return nil, fmt.Errorf("invalid header: missing column %q", column)
+		}
+	}
+
+	var entries []Entry
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		entry := Entry{
+			ID:   strings.TrimSpace(record[positions["id"]]),
+			Name: strings.TrimSpace(record[positions["name"]]),
+			Iban: strings.TrimSpace(record[positions["iban"]]),
+			Bic:  strings.TrimSpace(record[positions["bic"]]),
+		}
+
+		if entry.Name == "" && entry.Iban == "" && entry.Bic == "" {
+			line, _ := reader.FieldPos(0)
+
+			return nil, fmt.Errorf("line %d: entry %q without name, iban nor bic", line, entry.ID)
+		}
+
+		entries = append(entries, entry)
+	}
+}
+
+// newList indexes the entries for screening.
+func newList(entries []Entry) *list {
+	l := list{
+		ibans: make(map[string]Entry),
+		bics:  make(map[string]Entry),
+	}
+
+	for _, entry := range entries {
+		if entry.Iban != "" {
+			l.ibans[normalizeIban(entry.Iban)] = entry
+		}
+
+		if entry.Bic != "" {
+			l.bics[normalizeBic(entry.Bic)] = entry
+		}
+
+		if tokens := nameTokens(entry.Name); len(tokens) > 0 {
+			l.names = append(l.names, nameEntry{Entry: entry, tokens: tokens})
+		}
+	}
+
+	return &l
+}
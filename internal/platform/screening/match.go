@@ -0,0 +1,135 @@
+package screening
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// noiseTokens are the tokens left out of the names, e.g. the legal forms, they do not tell the names apart.
+var noiseTokens = map[string]bool{
+	"ag": true, "and": true, "co": true, "company": true, "corp": true, "corporation": true, "gmbh": true,
+	"inc": true, "limited": true, "llc": true, "ltd": true, "of": true, "plc": true, "sa": true, "sarl": true,
+	"sas": true, "the": true,
+}
+
+// normalizeIban returns the iban upper case without spaces.
+func normalizeIban(iban string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(iban), ""))
+}
+
+// normalizeBic returns the bic upper case without spaces, the 8 characters bics with the primary office branch code.
+func normalizeBic(bic string) string {
+	bic = normalizeIban(bic)
+
+	if len(bic) == 8 {
+		bic += "XXX"
+	}
+
+	return bic
+}
+
+// nameTokens returns the words of the name, lower case without diacritics nor punctuation, the noise tokens left out
+// unless the name is made of noise tokens only.
+func nameTokens(name string) []string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), name)
+	if err != nil {
+		folded = name
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(folded), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words))
+
+	for _, word := range words {
+		if !noiseTokens[word] {
+			tokens = append(tokens, word)
+		}
+	}
+
+	if len(tokens) == 0 {
+		return words
+	}
+
+	return tokens
+}
+
+// nameScore returns how much of the entry name the counterparty name covers, from 0 to 1.
+//
+// Each token of the entry is paired with the most similar token of the counterparty, by edit distance, regardless of
+// the order. The score is the average similarity of the pairs, the extra tokens of the counterparty, e.g. "Holdings
+// International", are ignored so that they do not let the counterparty escape screening.
+func nameScore(entry, counterparty []string) float64 {
+	if len(entry) == 0 || len(counterparty) == 0 {
+		return 0
+	}
+
+	return tokensScore(entry, counterparty)
+}
+
+// tokensScore returns the average similarity of the tokens of a with their most similar token of b.
+func tokensScore(a, b []string) float64 {
+	var total float64
+
+	for _, ta := range a {
+		var best float64
+
+		for _, tb := range b {
+			if s := similarity(ta, tb); s > best {
+				best = s
+			}
+		}
+
+		total += best
+	}
+
+	return total / float64(len(a))
+}
+
+// similarity returns 1 minus the edit distance of the words relative to the longest, from 0 to 1.
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein returns the number of single rune insertions, deletions or substitutions turning a into b.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
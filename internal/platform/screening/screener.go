@@ -0,0 +1,138 @@
+package screening
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+)
+
+// ListScreener screens the counterparties against the sanctions and block lists of a local file, reloaded when the
+// file changes.
+type ListScreener struct {
+	path      string
+	threshold float64
+
+	mu      sync.RWMutex
+	list    *list
+	modTime time.Time
+}
+
+// NewListScreener returns instance of ListScreener, loading the lists of the file, see ParseList.
+//
+// The counterparty names match the entries with a score of at least the threshold, from 0 to 1. Nothing is matched
+// when the path is empty.
+func NewListScreener(path string, threshold float64) (*ListScreener, error) {
+	if threshold <= 0 || threshold > 1 {
+		return nil, fmt.Errorf("invalid screening threshold %v, expected more than 0 up to 1", threshold)
+	}
+
+	ls := ListScreener{
+		path:      path,
+		threshold: threshold,
+		list:      newList(nil),
+	}
+
+	if _, err := ls.Reload(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return &ls, nil
+}
+
+// Reload loads the lists again when the file changed since loaded.
+//
+// Returns whether the lists were reloaded. The lists loaded are kept when the file is not valid.
+func (s *ListScreener) Reload(_ context.Context) (bool, error) {
+	if s.path == "" {
+		return false, nil
+	}
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to load screening list: %w", err)
+	}
+
+	s.mu.RLock()
+	changed := !info.ModTime().Equal(s.modTime)
+	s.mu.RUnlock()
+
+	if !changed {
+		return false, nil
+	}
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to load screening list: %w", err)
+	}
+
+	defer f.Close() // nolint: errcheck
+
+	entries, err := ParseList(f)
+	if err != nil {
+		return false, fmt.Errorf("failed to load screening list %s: %w", s.path, err)
+	}
+
+	l := newList(entries)
+
+	s.mu.Lock()
+	s.list = l
+	s.modTime = info.ModTime()
+	s.mu.Unlock()
+
+	return true, nil
+}
+
+// Screen returns the entry the counterparty matches best, nil when none matches.
+//
+// The iban and the bic match exactly, scoring 1, the name matches fuzzily.
+func (s *ListScreener) Screen(_ context.Context, input usecase.ScreeningInput) (*model.ScreeningHit, error) {
+	s.mu.RLock()
+	l := s.list
+	s.mu.RUnlock()
+
+	if input.Iban != "" {
+		if entry, ok := l.ibans[normalizeIban(input.Iban)]; ok {
+			return hit(entry, model.ScreeningIban, 1), nil
+		}
+	}
+
+	if input.Bic != "" {
+		if entry, ok := l.bics[normalizeBic(input.Bic)]; ok {
+			return hit(entry, model.ScreeningBic, 1), nil
+		}
+	}
+
+	tokens := nameTokens(input.Name)
+
+	var (
+		best      *nameEntry
+		bestScore float64
+	)
+
+	for i := range l.names {
+		if score := nameScore(l.names[i].tokens, tokens); score > bestScore {
+			best, bestScore = &l.names[i], score
+		}
+	}
+
+	if best == nil || bestScore < s.threshold {
+		return nil, nil
+	}
+
+	return hit(best.Entry, model.ScreeningName, bestScore), nil
+}
+
+// hit returns the hit of the entry, the score rounded to 2 decimals.
+func hit(entry Entry, matchedOn model.ScreeningField, score float64) *model.ScreeningHit {
+	return &model.ScreeningHit{
+		EntryID:   entry.ID,
+		EntryName: entry.Name,
+		MatchedOn: matchedOn,
+		Score:     float64(int(score*100+0.5)) / 100,
+	}
+}
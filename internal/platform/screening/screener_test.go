@@ -0,0 +1,181 @@
+package screening_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/screening"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sanctionsList = `id,name,iban,bic
+SDN-1,Evil Corp Ltd,,
+SDN-2,Józef Wąsowski,,
+SDN-3,,DE89370400440532013000,
+SDN-4,Shady Bank,,SHDYRUMM
+SDN-5,Ivan Petrov,,
+`
+
+// writeList writes the lists into a temporary file, returns the file path.
+func writeList(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "sanctions.csv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestListScreener_Screen(t *testing.T) {
+	t.Parallel()
+
+	s, err := screening.NewListScreener(writeList(t, sanctionsList), 0.85)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		input usecase.ScreeningInput
+		want  *model.ScreeningHit
+	}{
+		{
+			name:  "iban matching exactly",
+			input: usecase.ScreeningInput{Name: "Bip Bip", Iban: "de89 3704 0044 0532 0130 00"},
+			want:  &model.ScreeningHit{EntryID: "SDN-3", MatchedOn: model.ScreeningIban, Score: 1},
+		},
+		{
+			name:  "bic matching exactly, with the primary office branch code",
+			input: usecase.ScreeningInput{Name: "Bip Bip", Iban: "EE303680981021245685", Bic: "SHDYRUMMXXX"},
+			want:  &model.ScreeningHit{EntryID: "SDN-4", EntryName: "Shady Bank", MatchedOn: model.ScreeningBic, Score: 1},
+		},
+		{
+			name:  "name matching without legal form nor punctuation",
+			input: usecase.ScreeningInput{Name: "EVIL-CORP"},
+			want:  &model.ScreeningHit{EntryID: "SDN-1", EntryName: "Evil Corp Ltd", MatchedOn: model.ScreeningName, Score: 1},
+		},
+		{
+			name:  "name matching in other order, without diacritics",
+			input: usecase.ScreeningInput{Name: "Wasowski, Jozef"},
+			want:  &model.ScreeningHit{EntryID: "SDN-2", EntryName: "Józef Wąsowski", MatchedOn: model.ScreeningName, Score: 1},
+		},
+		{
+			name:  "name matching with a typo",
+			input: usecase.ScreeningInput{Name: "Jozef Wasowsky"},
+			want:  &model.ScreeningHit{EntryID: "SDN-2", EntryName: "Józef Wąsowski", MatchedOn: model.ScreeningName, Score: 0.94},
+		},
+		{
+			name:  "name matching with extra words",
+			input: usecase.ScreeningInput{Name: "Ivan Petrov Holdings International"},
+			want:  &model.ScreeningHit{EntryID: "SDN-5", EntryName: "Ivan Petrov", MatchedOn: model.ScreeningName, Score: 1},
+		},
+		{
+			name:  "name matching with extra words and a typo",
+			input: usecase.ScreeningInput{Name: "Global Trading of Petrow Ivan Europe"},
+			want:  &model.ScreeningHit{EntryID: "SDN-5", EntryName: "Ivan Petrov", MatchedOn: model.ScreeningName, Score: 0.92},
+		},
+		{
+			name:  "name not matching, missing words of the entry",
+			input: usecase.ScreeningInput{Name: "Ivan Holdings"},
+		},
+		{
+			name:  "name not matching, below the threshold",
+			input: usecase.ScreeningInput{Name: "Jozef Kowalski"},
+		},
+		{
+			name:  "counterparty not matching",
+			input: usecase.ScreeningInput{Name: "Bugs Bunny", Iban: "FR9810009380540930414023042", Bic: "RNJZNTMC"},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := s.Screen(context.Background(), tc.input)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestListScreener_Reload(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := writeList(t, sanctionsList)
+
+	s, err := screening.NewListScreener(path, 0.85)
+	require.NoError(t, err)
+
+	reloaded, err := s.Reload(ctx)
+	require.NoError(t, err)
+	assert.False(t, reloaded, "Reload() the file did not change")
+
+	input := usecase.ScreeningInput{Name: "Bugs Bunny"}
+
+	got, err := s.Screen(ctx, input)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	require.NoError(t, os.WriteFile(path, []byte(sanctionsList+"BL-1,Bugs Bunny,,\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+
+	reloaded, err = s.Reload(ctx)
+	require.NoError(t, err)
+	assert.True(t, reloaded, "Reload() the file changed")
+
+	got, err = s.Screen(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, &model.ScreeningHit{EntryID: "BL-1", EntryName: "Bugs Bunny", MatchedOn: model.ScreeningName, Score: 1}, got)
+
+	// an invalid file keeps the lists loaded.
+	require.NoError(t, os.WriteFile(path, []byte("id,name\nBL-2,Daffy Duck\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+
+	_, err = s.Reload(ctx)
+	assert.Error(t, err)
+
+	got, err = s.Screen(ctx, input)
+	require.NoError(t, err)
+	assert.NotNil(t, got)
+}
+
+func TestNewListScreener_withoutList(t *testing.T) {
+	t.Parallel()
+
+	s, err := screening.NewListScreener("", 0.85)
+	require.NoError(t, err)
+
+	got, err := s.Screen(context.Background(), usecase.ScreeningInput{Name: "Evil Corp"})
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = screening.NewListScreener("", 0)
+	assert.Error(t, err)
+
+	_, err = screening.NewListScreener(filepath.Join(t.TempDir(), "missing.csv"), 0.85)
+	assert.Error(t, err)
+}
+
+func TestParseList_invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := screening.ParseList(strings.NewReader("id,name,iban\nSDN-1,Evil Corp,\n"))
+	assert.Error(t, err, "missing column")
+
+	_, err = screening.ParseList(strings.NewReader("id,name,iban,bic\nSDN-1,,,\n"))
+	assert.Error(t, err, "entry without name, iban nor bic")
+
+	entries, err := screening.ParseList(strings.NewReader(""))
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -1,30 +1,82 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"strconv"
+	"time"
 
+	"github.com/dohernandez/qonto/internal/domain/model"
 	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/pain001"
 	"github.com/dohernandez/qonto/internal/platform/storage"
 	api "github.com/dohernandez/qonto/pkg/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// idempotencyKeyMetadata is the metadata key carrying the idempotency key, forwarded from the `Idempotency-Key`
+	// header.
+	idempotencyKeyMetadata = "idempotency-key"
+	// userIDMetadata is the metadata key carrying the user id asserted by the caller, forwarded from the `X-User-Id`
+	// header. The service does not verify it, the four-eyes approval of the transfer bulks relies on a trusted proxy,
+	// e.g. the API gateway, authenticating the user and replacing the header sent by the clients.
+	userIDMetadata = "x-user-id"
+	// dateLayout is the layout of the dates, e.g. the requested execution date.
+	dateLayout = "2006-01-02"
 )
 
 // QontoService is the server that manages transfers.
 type QontoService struct {
-	transactionBulk usecase.TransactionBulk
+	transactionBulk    usecase.TransactionBulk
+	transactionBulkJob usecase.TransactionBulkJob
+	ledgerCheck        usecase.LedgerCheck
+	transactionQuery   usecase.TransactionQuery
+	bankAccountQuery   usecase.BankAccountQuery
+	standingOrder      usecase.StandingOrder
+	reversal           usecase.TransactionReversal
+	inboundCredit      usecase.InboundCredit
+	fundHold           usecase.FundHold
+	overdraftLimit     usecase.OverdraftLimit
+	transferLimits     usecase.TransferLimits
+	approval           usecase.TransferBulkApproval
 
 	api.UnimplementedQontoServiceServer
 }
 
 // NewQontoService creates an instance of QontoService.
-func NewQontoService(transactionBulk usecase.TransactionBulk) *QontoService {
+func NewQontoService(
+	transactionBulk usecase.TransactionBulk,
+	transactionBulkJob usecase.TransactionBulkJob,
+	ledgerCheck usecase.LedgerCheck,
+	transactionQuery usecase.TransactionQuery,
+	bankAccountQuery usecase.BankAccountQuery,
+	standingOrder usecase.StandingOrder,
+	reversal usecase.TransactionReversal,
+	inboundCredit usecase.InboundCredit,
+	fundHold usecase.FundHold,
+	overdraftLimit usecase.OverdraftLimit,
+	transferLimits usecase.TransferLimits,
+	approval usecase.TransferBulkApproval,
+) *QontoService {
 	return &QontoService{
-		transactionBulk: transactionBulk,
+		transactionBulk:    transactionBulk,
+		transactionBulkJob: transactionBulkJob,
+		ledgerCheck:        ledgerCheck,
+		transactionQuery:   transactionQuery,
+		bankAccountQuery:   bankAccountQuery,
+		standingOrder:      standingOrder,
+		reversal:           reversal,
+		inboundCredit:      inboundCredit,
+		fundHold:           fundHold,
+		overdraftLimit:     overdraftLimit,
+		transferLimits:     transferLimits,
+		approval:           approval,
 	}
 }
 
@@ -33,41 +85,1097 @@ func NewQontoService(transactionBulk usecase.TransactionBulk) *QontoService {
 // Receives a request with bulk of transfer to perform. Responses whether the transfer were done successfully or not, due to:
 // - account not found
 // - not enough funds in the account
+// - transfer limit exceeded, with the limit as details
+// - idempotency key already used with a different request
+// - invalid request, with the field violations as details
 // - internal server.
-func (s *QontoService) TransferBulk(ctx context.Context, req *api.TransferBulkRequest) (*emptypb.Empty, error) {
-	input := usecase.TransactionBulkInput{
+//
+// Transfer bulks debiting more than the approval threshold are stored awaiting approval, and those with counterparties
+// matching the sanctions and block lists are held for review, nothing is debited until approved by another user, see
+// ApproveTransferBulk.
+//
+// Asynchronous requests are validated and accepted as a job, the transfers are performed in background. Requests with
+// an execution date are accepted as a job scheduled on that date.
+//
+// Validate-only requests are responded with the outcome the transfers would have, without performing them.
+func (s *QontoService) TransferBulk(ctx context.Context, req *api.TransferBulkRequest) (*api.TransferBulkResponse, error) {
+	return s.submitTransferBulk(ctx, req, transactionBulkInput(ctx, req))
+}
+
+// ImportTransferBulk performs the transfers of a SEPA pain.001.001.03 credit transfer initiation file.
+//
+// Responses as TransferBulk, besides the file not being a valid pain.001 document or its control sums not matching
+// the credit transfers.
+func (s *QontoService) ImportTransferBulk(ctx context.Context, req *api.ImportTransferBulkRequest) (*api.TransferBulkResponse, error) {
+	doc, err := pain001.Parse(bytes.NewReader(req.Document))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	input, err := doc.TransactionBulkInput()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return s.submitTransferBulk(ctx, req, withRequestOptions(ctx, req, input))
+}
+
+// submitTransferBulk accepts the transfers as a job when the request is asynchronous or scheduled, otherwise performs
+// them.
+func (s *QontoService) submitTransferBulk(
+	ctx context.Context,
+	req transferBulkRequest,
+	input usecase.TransactionBulkInput,
+) (*api.TransferBulkResponse, error) {
+	if (req.GetAsync() || req.GetRequestedExecutionDate() != "") && !req.GetValidateOnly() {
+		return s.enqueueTransferBulk(ctx, input)
+	}
+
+	return s.performTransferBulk(ctx, input)
+}
+
+// performTransferBulk performs the transfers.
+func (s *QontoService) performTransferBulk(ctx context.Context, input usecase.TransactionBulkInput) (*api.TransferBulkResponse, error) {
+	output, err := s.transactionBulk.TransactionBulk(ctx, input)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "bank account not found")
+		}
+
+		return nil, transferBulkStatus(err)
+	}
+
+	// validate-only transfers are not performed, nothing is created. Transfer bulks awaiting approval or held for
+	// review are accepted, performed once approved.
+	httpCode := "201"
+
+	switch {
+	case input.ValidateOnly:
+		httpCode = "200"
+	case output.Status.Pending():
+		httpCode = "202"
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", httpCode)) // nolint: errcheck
+
+	return transferBulkResponse(*output), nil
+}
+
+// transferBulkResponse returns the API representation of the processed transfer bulk.
+func transferBulkResponse(output usecase.TransactionBulkOutput) *api.TransferBulkResponse {
+	resp := &api.TransferBulkResponse{
+		TransferBulkId: int64(output.TransferBulkID),
+		TransactionIds: make([]int64, len(output.TransactionIDs)),
+		DebitedCents:   int64(output.DebitedCents),
+		FeesCents:      int64(output.FeesCents),
+		BalanceCents:   int64(output.BalanceCents),
+		Currency:       output.Currency,
+		Rows:           make([]*api.TransferBulkResponse_Row, len(output.Rows)),
+		Status:         string(output.Status),
+	}
+
+	for i, id := range output.TransactionIDs {
+		resp.TransactionIds[i] = int64(id)
+	}
+
+	for i, row := range output.Rows {
+		resp.Rows[i] = &api.TransferBulkResponse_Row{
+			Row:           int32(i),
+			Status:        string(row.Status),
+			TransactionId: int64(row.TransactionID),
+			Reason:        row.Reason,
+		}
+	}
+
+	return resp
+}
+
+// transferBulkStatus returns the status of the error performing the transfers.
+func transferBulkStatus(err error) error {
+	if errors.Is(err, storage.ErrNotEnoughBalance) {
+		return status.Errorf(codes.FailedPrecondition, "bank account not enough balance")
+	}
+
+	if errors.Is(err, storage.ErrOverdraftExceeded) {
+		return status.Errorf(codes.FailedPrecondition, "bank account overdraft limit exceeded")
+	}
+
+	var lerr *model.TransferLimitError
+	if errors.As(err, &lerr) {
+		return ResourceExhaustedStatus(lerr).Err()
+	}
+
+	if errors.Is(err, usecase.ErrIdempotencyKeyMismatch) {
+		return status.Errorf(codes.InvalidArgument, "idempotency key already used with a different request")
+	}
+
+	// the transfer bulk requiring approval is refused without the user submitting it.
+	if errors.Is(err, model.ErrUnknownSubmitter) {
+		return status.Errorf(codes.Unauthenticated, "%v", model.ErrUnknownSubmitter)
+	}
+
+	var verr *usecase.ValidationError
+	if errors.As(err, &verr) {
+		return InvalidArgumentStatus(verr).Err()
+	}
+
+	return status.Errorf(codes.Internal, "cannot process the transaction: %v", err)
+}
+
+// enqueueTransferBulk accepts the transfers as a job performed in background.
+func (s *QontoService) enqueueTransferBulk(ctx context.Context, input usecase.TransactionBulkInput) (*api.TransferBulkResponse, error) {
+	job, err := s.transactionBulkJob.Enqueue(ctx, input)
+	if err != nil {
+		if errors.Is(err, usecase.ErrIdempotencyKeyMismatch) {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key already used with a different request")
+		}
+
+		var verr *usecase.ValidationError
+		if errors.As(err, &verr) {
+			return nil, InvalidArgumentStatus(verr).Err()
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot accept the transaction: %v", err)
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "202")) // nolint: errcheck
+
+	return &api.TransferBulkResponse{
+		JobId:     int64(job.ID),
+		JobStatus: string(job.Status),
+	}, nil
+}
+
+// GetTransferBulkJob returns the transfer bulk job.
+//
+// Responses the job status and the outcome of each credit transfer once processed.
+func (s *QontoService) GetTransferBulkJob(ctx context.Context, req *api.GetTransferBulkJobRequest) (*api.GetTransferBulkJobResponse, error) {
+	job, err := s.transactionBulkJob.Job(ctx, model.TransferBulkJobID(req.Id))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer bulk job not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot get the transfer bulk job: %v", err)
+	}
+
+	return transferBulkJobResponse(*job), nil
+}
+
+// ListScheduledTransferBulks lists the transfer bulks scheduled with a requested execution date and not yet performed.
+func (s *QontoService) ListScheduledTransferBulks(ctx context.Context, req *api.ListScheduledTransferBulksRequest) (*api.ListScheduledTransferBulksResponse, error) {
+	jobs, err := s.transactionBulkJob.ListScheduled(ctx, req.OrganizationIban)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list the scheduled transfer bulks: %v", err)
+	}
+
+	resp := &api.ListScheduledTransferBulksResponse{
+		Jobs: make([]*api.GetTransferBulkJobResponse, len(jobs)),
+	}
+
+	for i, job := range jobs {
+		resp.Jobs[i] = transferBulkJobResponse(job)
+	}
+
+	return resp, nil
+}
+
+// CancelScheduledTransferBulk cancels the scheduled transfer bulk, its transfers are not performed.
+//
+// Responses the cancelled job, or whether the job was not found or is no longer scheduled.
+func (s *QontoService) CancelScheduledTransferBulk(ctx context.Context, req *api.CancelScheduledTransferBulkRequest) (*api.GetTransferBulkJobResponse, error) {
+	job, err := s.transactionBulkJob.Cancel(ctx, model.TransferBulkJobID(req.Id))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer bulk job not found")
+		}
+
+		if errors.Is(err, usecase.ErrTransferBulkJobNotScheduled) {
+			return nil, status.Errorf(codes.FailedPrecondition, "transfer bulk job is not scheduled")
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot cancel the transfer bulk job: %v", err)
+	}
+
+	return transferBulkJobResponse(*job), nil
+}
+
+// ReverseTransferBulk reverses the transactions performed by the transfer bulk.
+//
+// Responses the reversals, or whether the transfer bulk was not found or has no transactions left to reverse.
+func (s *QontoService) ReverseTransferBulk(ctx context.Context, req *api.ReverseTransferBulkRequest) (*api.ReversalResponse, error) {
+	output, err := s.reversal.ReverseTransferBulk(ctx, usecase.ReverseTransferBulkInput{
+		TransferBulkID: model.TransferBulkID(req.Id),
+		Reason:         model.ReversalReason(req.Reason),
+	})
+	if err != nil {
+		return nil, reversalStatus(err, "transfer bulk")
+	}
+
+	return reversalResponse(*output), nil
+}
+
+// ApproveTransferBulk performs the transfers of the transfer bulk awaiting approval, or held for review.
+//
+// Responses the performed transfer bulk, as TransferBulk, besides whether the user is not identified, the user
+// submitted the transfer bulk, or the transfer bulk was not found or is no longer awaiting approval.
+func (s *QontoService) ApproveTransferBulk(ctx context.Context, req *api.ApproveTransferBulkRequest) (*api.TransferBulkResponse, error) {
+	userID := assertedUserID(ctx)
+	if userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user not identified, the X-User-Id header is missing")
+	}
+
+	output, err := s.approval.Approve(ctx, usecase.TransferBulkDecisionInput{
+		TransferBulkID: model.TransferBulkID(req.Id),
+		UserID:         userID,
+		Reason:         req.Reason,
+	})
+	if err != nil {
+		return nil, approvalStatus(err)
+	}
+
+	return transferBulkResponse(*output), nil
+}
+
+// RejectTransferBulk rejects the transfer bulk awaiting approval, or held for review, its transfers are not performed.
+//
+// Responses the rejected transfer bulk, or whether the user is not identified, the user submitted the transfer
+// bulk, or the transfer bulk was not found or is no longer awaiting approval.
+func (s *QontoService) RejectTransferBulk(ctx context.Context, req *api.RejectTransferBulkRequest) (*api.TransferBulkResponse, error) {
+	userID := assertedUserID(ctx)
+	if userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user not identified, the X-User-Id header is missing")
+	}
+
+	output, err := s.approval.Reject(ctx, usecase.TransferBulkDecisionInput{
+		TransferBulkID: model.TransferBulkID(req.Id),
+		UserID:         userID,
+		Reason:         req.Reason,
+	})
+	if err != nil {
+		return nil, approvalStatus(err)
+	}
+
+	return transferBulkResponse(*output), nil
+}
+
+// CreateStandingOrder creates a standing order, a credit transfer performed repeatedly.
+func (s *QontoService) CreateStandingOrder(ctx context.Context, req *api.CreateStandingOrderRequest) (*api.StandingOrder, error) {
+	order, err := s.standingOrder.Create(ctx, usecase.StandingOrderInput{
 		OrganizationName: req.OrganizationName,
 		OrganizationIban: req.OrganizationIban,
 		OrganizationBic:  req.OrganizationBic,
+		Transfer:         standingOrderTransferInput(req.Transfer),
+		Frequency:        model.StandingOrderFrequency(req.Frequency),
+		DayOfMonth:       int(req.DayOfMonth),
+		StartDate:        req.StartDate,
+		EndDate:          req.EndDate,
+		Count:            int(req.Count),
+		CreatedBy:        assertedUserID(ctx),
+	})
+	if err != nil {
+		return nil, standingOrderStatus(err, "create")
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "201")) // nolint: errcheck
+
+	return standingOrderResponse(*order), nil
+}
+
+// GetStandingOrder returns the standing order.
+func (s *QontoService) GetStandingOrder(ctx context.Context, req *api.GetStandingOrderRequest) (*api.StandingOrder, error) {
+	order, err := s.standingOrder.StandingOrder(ctx, model.StandingOrderID(req.Id))
+	if err != nil {
+		return nil, standingOrderStatus(err, "get")
+	}
+
+	return standingOrderResponse(*order), nil
+}
+
+// ListStandingOrders lists the standing orders of the bank account, but the deleted ones.
+func (s *QontoService) ListStandingOrders(ctx context.Context, req *api.ListStandingOrdersRequest) (*api.ListStandingOrdersResponse, error) {
+	orders, err := s.standingOrder.List(ctx, req.OrganizationIban)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list the standing orders: %v", err)
 	}
 
+	resp := &api.ListStandingOrdersResponse{
+		StandingOrders: make([]*api.StandingOrder, len(orders)),
+	}
+
+	for i, order := range orders {
+		resp.StandingOrders[i] = standingOrderResponse(order)
+	}
+
+	return resp, nil
+}
+
+// UpdateStandingOrder replaces the credit transfer and the end of the recurrence of the standing order.
+func (s *QontoService) UpdateStandingOrder(ctx context.Context, req *api.UpdateStandingOrderRequest) (*api.StandingOrder, error) {
+	order, err := s.standingOrder.Update(ctx, usecase.StandingOrderUpdateInput{
+		ID:       model.StandingOrderID(req.Id),
+		Transfer: standingOrderTransferInput(req.Transfer),
+		EndDate:  req.EndDate,
+		Count:    int(req.Count),
+	})
+	if err != nil {
+		return nil, standingOrderStatus(err, "update")
+	}
+
+	return standingOrderResponse(*order), nil
+}
+
+// DeleteStandingOrder cancels the standing order, it is no longer executed. Its executions are kept.
+func (s *QontoService) DeleteStandingOrder(ctx context.Context, req *api.DeleteStandingOrderRequest) (*api.StandingOrder, error) {
+	order, err := s.standingOrder.Cancel(ctx, model.StandingOrderID(req.Id))
+	if err != nil {
+		return nil, standingOrderStatus(err, "delete")
+	}
+
+	return standingOrderResponse(*order), nil
+}
+
+// PauseStandingOrder pauses the standing order until resumed.
+func (s *QontoService) PauseStandingOrder(ctx context.Context, req *api.PauseStandingOrderRequest) (*api.StandingOrder, error) {
+	order, err := s.standingOrder.Pause(ctx, model.StandingOrderID(req.Id))
+	if err != nil {
+		return nil, standingOrderStatus(err, "pause")
+	}
+
+	return standingOrderResponse(*order), nil
+}
+
+// ResumeStandingOrder resumes the paused standing order.
+func (s *QontoService) ResumeStandingOrder(ctx context.Context, req *api.ResumeStandingOrderRequest) (*api.StandingOrder, error) {
+	order, err := s.standingOrder.Resume(ctx, model.StandingOrderID(req.Id))
+	if err != nil {
+		return nil, standingOrderStatus(err, "resume")
+	}
+
+	return standingOrderResponse(*order), nil
+}
+
+// SkipStandingOrder skips the next execution of the standing order.
+func (s *QontoService) SkipStandingOrder(ctx context.Context, req *api.SkipStandingOrderRequest) (*api.StandingOrder, error) {
+	order, err := s.standingOrder.Skip(ctx, model.StandingOrderID(req.Id))
+	if err != nil {
+		return nil, standingOrderStatus(err, "skip")
+	}
+
+	return standingOrderResponse(*order), nil
+}
+
+// ListStandingOrderExecutions lists the executions of the standing order by execution date.
+func (s *QontoService) ListStandingOrderExecutions(ctx context.Context, req *api.ListStandingOrderExecutionsRequest) (*api.ListStandingOrderExecutionsResponse, error) {
+	executions, err := s.standingOrder.Executions(ctx, model.StandingOrderID(req.Id))
+	if err != nil {
+		return nil, standingOrderStatus(err, "list the executions of")
+	}
+
+	resp := &api.ListStandingOrderExecutionsResponse{
+		Executions: make([]*api.ListStandingOrderExecutionsResponse_Execution, len(executions)),
+	}
+
+	for i, execution := range executions {
+		resp.Executions[i] = &api.ListStandingOrderExecutionsResponse_Execution{
+			Id:             int64(execution.ID),
+			ExecutionDate:  execution.ExecutionDate.Format(dateLayout),
+			Status:         string(execution.Status),
+			JobId:          int64(execution.TransferBulkJobID),
+			JobStatus:      string(execution.JobStatus),
+			TransferBulkId: int64(execution.TransferBulkID),
+			Error:          execution.Error,
+		}
+	}
+
+	return resp, nil
+}
+
+// CheckLedger checks the ledger invariants.
+//
+// Responses the bank accounts which balance differs from the sum of their postings and the journal entries which
+// postings do not balance.
+func (s *QontoService) CheckLedger(ctx context.Context, _ *api.CheckLedgerRequest) (*api.CheckLedgerResponse, error) {
+	output, err := s.ledgerCheck.LedgerCheck(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot check the ledger: %v", err)
+	}
+
+	resp := &api.CheckLedgerResponse{
+		Consistent:                output.Consistent(),
+		BalanceDiscrepancies:      make([]*api.CheckLedgerResponse_BalanceDiscrepancy, len(output.BalanceDiscrepancies)),
+		UnbalancedJournalEntryIds: make([]int64, len(output.UnbalancedJournalEntries)),
+	}
+
+	for i, d := range output.BalanceDiscrepancies {
+		resp.BalanceDiscrepancies[i] = &api.CheckLedgerResponse_BalanceDiscrepancy{
+			BankAccountId: int64(d.BankAccountID),
+			BalanceCents:  int64(d.BalanceCents),
+			PostingsCents: int64(d.PostingsCents),
+		}
+	}
+
+	for i, id := range output.UnbalancedJournalEntries {
+		resp.UnbalancedJournalEntryIds[i] = int64(id)
+	}
+
+	return resp, nil
+}
+
+// ListTransactions lists the transactions of the bank account.
+//
+// Responses a page of the transactions matching the filters and the token of the next page.
+func (s *QontoService) ListTransactions(ctx context.Context, req *api.ListTransactionsRequest) (*api.ListTransactionsResponse, error) {
+	input := usecase.ListTransactionsInput{
+		Iban:         req.Iban,
+		From:         req.From,
+		To:           req.To,
+		Counterparty: req.Counterparty,
+		Sort:         model.TransactionSortNewest,
+		PageSize:     int(req.PageSize),
+		PageToken:    req.PageToken,
+	}
+
+	if req.MinAmountCents != nil {
+		minAmount := model.Cents(*req.MinAmountCents)
+		input.MinAmountCents = &minAmount
+	}
+
+	if req.MaxAmountCents != nil {
+		maxAmount := model.Cents(*req.MaxAmountCents)
+		input.MaxAmountCents = &maxAmount
+	}
+
+	if req.Sort == api.ListTransactionsRequest_SORT_OLDEST_FIRST {
+		input.Sort = model.TransactionSortOldest
+	}
+
+	output, err := s.transactionQuery.ListTransactions(ctx, input)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "bank account not found")
+		}
+
+		var verr *usecase.ValidationError
+		if errors.As(err, &verr) {
+			return nil, InvalidArgumentStatus(verr).Err()
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot list the transactions: %v", err)
+	}
+
+	resp := &api.ListTransactionsResponse{
+		Transactions:  make([]*api.Transaction, len(output.Transactions)),
+		NextPageToken: output.NextPageToken,
+	}
+
+	for i, transaction := range output.Transactions {
+		resp.Transactions[i] = transactionResponse(transaction)
+	}
+
+	return resp, nil
+}
+
+// GetTransaction returns the transaction of the bank account.
+func (s *QontoService) GetTransaction(ctx context.Context, req *api.GetTransactionRequest) (*api.Transaction, error) {
+	transaction, err := s.transactionQuery.Transaction(ctx, req.Iban, model.TransactionID(req.Id))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transaction not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot get the transaction: %v", err)
+	}
+
+	return transactionResponse(*transaction), nil
+}
+
+// ReverseTransaction reverses the transaction of the bank account, totally or partially.
+//
+// Responses the reversal, or whether the transaction was not found, is not reversible or the amount exceeds the
+// amount not reversed yet.
+func (s *QontoService) ReverseTransaction(ctx context.Context, req *api.ReverseTransactionRequest) (*api.ReversalResponse, error) {
+	output, err := s.reversal.ReverseTransaction(ctx, usecase.ReverseTransactionInput{
+		Iban:          req.Iban,
+		TransactionID: model.TransactionID(req.Id),
+		AmountCents:   model.Cents(req.AmountCents),
+		Reason:        model.ReversalReason(req.Reason),
+	})
+	if err != nil {
+		return nil, reversalStatus(err, "transaction")
+	}
+
+	return reversalResponse(*output), nil
+}
+
+// CreateInboundCredit books a credit transfer received by the bank account.
+//
+// Sending again a credit transfer already booked, identified by its external reference, responses the transaction
+// already booked.
+func (s *QontoService) CreateInboundCredit(ctx context.Context, req *api.InboundCreditRequest) (*api.InboundCreditResponse, error) {
+	output, err := s.inboundCredit.Credit(ctx, usecase.InboundCreditInput{
+		Iban:              req.Iban,
+		Amount:            req.Amount,
+		Currency:          req.Currency,
+		CounterpartyName:  req.CounterpartyName,
+		CounterpartyIban:  req.CounterpartyIban,
+		CounterpartyBic:   req.CounterpartyBic,
+		Description:       req.Description,
+		ExternalReference: req.ExternalReference,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "bank account not found")
+		}
+
+		if errors.Is(err, usecase.ErrIdempotencyKeyMismatch) {
+			return nil, status.Errorf(codes.InvalidArgument, "external reference already used with a different credit transfer")
+		}
+
+		var verr *usecase.ValidationError
+		if errors.As(err, &verr) {
+			return nil, InvalidArgumentStatus(verr).Err()
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot book the credit transfer: %v", err)
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "201")) // nolint: errcheck
+
+	return &api.InboundCreditResponse{
+		Transaction:  transactionResponse(output.Transaction),
+		BalanceCents: int64(output.BalanceCents),
+		Currency:     output.Currency,
+	}, nil
+}
+
+// PlaceHold reserves an amount of the bank account.
+//
+// Responses the hold placed and the account balances, or an error due to:
+// - account not found
+// - available balance not covering the amount
+// - invalid request, with the field violations as details
+// - internal server.
+func (s *QontoService) PlaceHold(ctx context.Context, req *api.PlaceHoldRequest) (*api.HoldResponse, error) {
+	output, err := s.fundHold.Place(ctx, usecase.PlaceHoldInput{
+		Iban:             req.Iban,
+		AmountCents:      model.Cents(req.AmountCents),
+		CounterpartyName: req.CounterpartyName,
+		Description:      req.Description,
+		ExpiresAt:        req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, holdStatus(err, "place")
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "201")) // nolint: errcheck
+
+	return holdResponse(*output), nil
+}
+
+// CaptureHold debits the held amount, or part of it, from the bank account.
+func (s *QontoService) CaptureHold(ctx context.Context, req *api.CaptureHoldRequest) (*api.HoldResponse, error) {
+	output, err := s.fundHold.Capture(ctx, usecase.CaptureHoldInput{
+		Iban:        req.Iban,
+		HoldID:      model.HoldID(req.Id),
+		AmountCents: model.Cents(req.AmountCents),
+	})
+	if err != nil {
+		return nil, holdStatus(err, "capture")
+	}
+
+	return holdResponse(*output), nil
+}
+
+// ReleaseHold releases the held amount without debiting the bank account.
+func (s *QontoService) ReleaseHold(ctx context.Context, req *api.ReleaseHoldRequest) (*api.HoldResponse, error) {
+	output, err := s.fundHold.Release(ctx, usecase.ReleaseHoldInput{
+		Iban:   req.Iban,
+		HoldID: model.HoldID(req.Id),
+	})
+	if err != nil {
+		return nil, holdStatus(err, "release")
+	}
+
+	return holdResponse(*output), nil
+}
+
+// GetBankAccount returns the bank account details and balances.
+//
+// The bank account is looked up by id, or by iban and bic.
+func (s *QontoService) GetBankAccount(ctx context.Context, req *api.GetBankAccountRequest) (*api.BankAccount, error) {
+	output, err := s.bankAccountQuery.BankAccount(ctx, usecase.BankAccountInput{
+		ID:   model.BankAccountID(req.Id),
+		Iban: req.Iban,
+		Bic:  req.Bic,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "bank account not found")
+		}
+
+		var verr *usecase.ValidationError
+		if errors.As(err, &verr) {
+			return nil, InvalidArgumentStatus(verr).Err()
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot get the bank account: %v", err)
+	}
+
+	return bankAccountResponse(*output), nil
+}
+
+// SetOverdraftLimit sets the agreed overdraft facility of the bank account.
+func (s *QontoService) SetOverdraftLimit(ctx context.Context, req *api.SetOverdraftLimitRequest) (*api.BankAccount, error) {
+	output, err := s.overdraftLimit.SetLimit(ctx, usecase.OverdraftLimitInput{
+		Iban:       req.Iban,
+		LimitCents: model.Cents(req.LimitCents),
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "bank account not found")
+		}
+
+		var verr *usecase.ValidationError
+		if errors.As(err, &verr) {
+			return nil, InvalidArgumentStatus(verr).Err()
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot set the overdraft limit: %v", err)
+	}
+
+	return bankAccountResponse(*output), nil
+}
+
+// SetTransferLimits sets the limits of the credit transfers of the bank account, or of the organization.
+func (s *QontoService) SetTransferLimits(ctx context.Context, req *api.SetTransferLimitsRequest) (*api.TransferLimits, error) {
+	limit, err := s.transferLimits.SetLimits(ctx, usecase.TransferLimitsInput{
+		Iban:             req.Iban,
+		OrganizationName: req.OrganizationName,
+		MaxTransferCents: model.Cents(req.MaxTransferCents),
+		MaxBatchCents:    model.Cents(req.MaxBatchCents),
+		DailyCents:       model.Cents(req.DailyCents),
+		MonthlyCents:     model.Cents(req.MonthlyCents),
+		MaxTransfers:     req.MaxTransfers,
+		WindowSeconds:    req.WindowSeconds,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "bank account not found")
+		}
+
+		var verr *usecase.ValidationError
+		if errors.As(err, &verr) {
+			return nil, InvalidArgumentStatus(verr).Err()
+		}
+
+		return nil, status.Errorf(codes.Internal, "cannot set the transfer limits: %v", err)
+	}
+
+	return &api.TransferLimits{
+		Id:               int64(limit.ID),
+		BankAccountId:    int64(limit.BankAccountID),
+		OrganizationName: limit.OrganizationName,
+		MaxTransferCents: int64(limit.MaxTransferCents),
+		MaxBatchCents:    int64(limit.MaxBatchCents),
+		DailyCents:       int64(limit.DailyCents),
+		MonthlyCents:     int64(limit.MonthlyCents),
+		MaxTransfers:     limit.MaxTransfers,
+		WindowSeconds:    limit.WindowSeconds,
+		UpdatedAt:        limit.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}, nil
+}
+
+// transactionBulkInput returns the use case input of the request.
+func transactionBulkInput(ctx context.Context, req *api.TransferBulkRequest) usecase.TransactionBulkInput {
+	input := withRequestOptions(ctx, req, usecase.TransactionBulkInput{
+		OrganizationName: req.OrganizationName,
+		OrganizationIban: req.OrganizationIban,
+		OrganizationBic:  req.OrganizationBic,
+	})
+
 	input.CreditTransfers = make([]usecase.TransactionBulkTransferInput, len(req.CreditTransfers))
 
 	for i, transfer := range req.CreditTransfers {
 		input.CreditTransfers[i] = usecase.TransactionBulkTransferInput{
-			Amount:           transfer.Amount,
+			Amount:           transferAmount(transfer),
 			Currency:         transfer.Currency,
 			CounterpartyName: transfer.CounterpartyName,
 			CounterpartyBic:  transfer.CounterpartyBic,
 			CounterpartyIban: transfer.CounterpartyIban,
 			Description:      transfer.Description,
+			Instant:          transfer.Instant,
 		}
 	}
 
-	err := s.transactionBulk.TransactionBulk(ctx, input)
+	return input
+}
+
+// transferBulkRequest is the request of a transfer bulk, its credit transfers either sent as json or as a pain.001
+// document.
+type transferBulkRequest interface {
+	GetIdempotencyKey() string
+	GetAsync() bool
+	GetExecutionMode() api.TransferBulkRequest_ExecutionMode
+	GetValidateOnly() bool
+	GetRequestedExecutionDate() string
+}
+
+// withRequestOptions returns the input with the options of the request and its submitter.
+//
+// The idempotency key of the request, or else of the metadata, overrides the one of the input, if any.
+func withRequestOptions(
+	ctx context.Context,
+	req transferBulkRequest,
+	input usecase.TransactionBulkInput,
+) usecase.TransactionBulkInput {
+	if key := req.GetIdempotencyKey(); key != "" {
+		input.IdempotencyKey = key
+	} else if key := idempotencyKeyFromMetadata(ctx); key != "" {
+		input.IdempotencyKey = key
+	}
+
+	if req.GetExecutionMode() == api.TransferBulkRequest_EXECUTION_MODE_PARTIAL {
+		input.Mode = model.TransferBulkPartial
+	}
+
+	input.ValidateOnly = req.GetValidateOnly()
+	input.RequestedExecutionDate = req.GetRequestedExecutionDate()
+	input.SubmittedBy = assertedUserID(ctx)
+
+	return input
+}
+
+// idempotencyKeyFromMetadata returns the idempotency key sent as metadata, if any.
+func idempotencyKeyFromMetadata(ctx context.Context) string {
+	return fromMetadata(ctx, idempotencyKeyMetadata)
+}
+
+// assertedUserID returns the user id asserted by the trusted proxy, sent as metadata, if any. It is not verified.
+func assertedUserID(ctx context.Context) string {
+	return fromMetadata(ctx, userIDMetadata)
+}
+
+// fromMetadata returns the first value of the metadata key, if any.
+func fromMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if vals := md.Get(key); len(vals) > 0 {
+		return vals[0]
+	}
+
+	return ""
+}
+
+// transferAmount returns the decimal amount of the transfer.
+//
+// Falls back to the deprecated float amount, rounded to the currency minor unit, when the decimal amount is not set.
+func transferAmount(transfer *api.TransferBulkRequest_CreditTransfersRow) string {
+	if transfer.DecimalAmount != "" {
+		return transfer.DecimalAmount
+	}
+
+	amount := transfer.Amount // nolint: staticcheck // deprecated amount is still supported.
+
+	cents, err := model.FloatToCents(amount, transfer.Currency)
 	if err != nil {
-		if errors.Is(err, storage.ErrNotFound) {
-			return nil, status.Errorf(codes.NotFound, "bank account not found")
+		// left to the use case validation to report.
+		return strconv.FormatFloat(amount, 'f', -1, 64)
+	}
+
+	return model.FormatCents(cents, transfer.Currency)
+}
+
+// transferBulkJobResponse returns the API representation of the transfer bulk job.
+func transferBulkJobResponse(job model.TransferBulkJob) *api.GetTransferBulkJobResponse {
+	resp := &api.GetTransferBulkJobResponse{
+		Id:             int64(job.ID),
+		Status:         string(job.Status),
+		TotalRows:      int32(job.TotalRows),
+		ProcessedRows:  int32(job.ProcessedRows),
+		Rows:           make([]*api.GetTransferBulkJobResponse_Row, len(job.Rows)),
+		TransferBulkId: int64(job.TransferBulkID),
+		DebitedCents:   int64(job.DebitedCents),
+		BalanceCents:   int64(job.BalanceCents),
+		Currency:       job.Currency,
+		Error:          job.Error,
+		FeesCents:      int64(job.FeesCents),
+	}
+
+	if job.ExecutionDate != nil {
+		resp.RequestedExecutionDate = job.ExecutionDate.Format(dateLayout)
+	}
+
+	for i, row := range job.Rows {
+		resp.Rows[i] = &api.GetTransferBulkJobResponse_Row{
+			Row:           int32(i),
+			Status:        string(row.Status),
+			TransactionId: int64(row.TransactionID),
+			Error:         row.Error,
 		}
+	}
+
+	return resp
+}
 
-		if errors.Is(err, storage.ErrNotEnoughBalance) {
-			return nil, status.Errorf(codes.FailedPrecondition, "bank account not enough balance")
+// transactionResponse returns the API representation of the transaction.
+func transactionResponse(transaction model.Transaction) *api.Transaction {
+	return &api.Transaction{
+		Id:               int64(transaction.ID),
+		CounterpartyName: transaction.CounterpartyName,
+		CounterpartyIban: transaction.CounterpartyIban,
+		CounterpartyBic:  transaction.CounterpartyBic,
+		AmountCents:      int64(transaction.AmountCents),
+		AmountCurrency:   transaction.AmountCurrency,
+		DebitedCents:     int64(transaction.DebitedCents),
+		DebitedCurrency:  transaction.DebitedCurrency,
+		ExchangeRate:     transaction.ExchangeRate,
+		Description:      transaction.Description,
+		TransferBulkId:   int64(transaction.TransferBulkID),
+		CreatedAt:        transaction.CreatedAt.UTC().Format(time.RFC3339Nano),
+
+		ReversedTransactionId: int64(transaction.ReversedTransactionID),
+		ReversalReason:        string(transaction.ReversalReason),
+		ExternalReference:     transaction.ExternalReference,
+		Internal:              transaction.Internal,
+		ChargedTransactionId:  int64(transaction.ChargedTransactionID),
+	}
+}
+
+// reversalResponse returns the response of the reversed transactions.
+func reversalResponse(output usecase.ReversalOutput) *api.ReversalResponse {
+	resp := &api.ReversalResponse{
+		Transactions:  make([]*api.Transaction, len(output.Transactions)),
+		ReversedCents: int64(output.ReversedCents),
+		BalanceCents:  int64(output.BalanceCents),
+		Currency:      output.Currency,
+	}
+
+	for i, transaction := range output.Transactions {
+		resp.Transactions[i] = transactionResponse(transaction)
+	}
+
+	return resp
+}
+
+// reversalStatus returns the status of the reversal error, of the transaction or the transfer bulk.
+func reversalStatus(err error, reversed string) error {
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "%s not found", reversed)
+	}
+
+	for _, target := range []error{
+		model.ErrTransactionNotReversible,
+		model.ErrTransactionAlreadyReversed,
+		model.ErrReversalExceedsAmount,
+	} {
+		if errors.Is(err, target) {
+			return status.Errorf(codes.FailedPrecondition, "%v", target)
 		}
+	}
 
-		return nil, status.Errorf(codes.Internal, "cannot process the transaction: %v", err)
+	var verr *usecase.ValidationError
+	if errors.As(err, &verr) {
+		return InvalidArgumentStatus(verr).Err()
 	}
 
-	_ = grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "201")) // nolint: errcheck
+	return status.Errorf(codes.Internal, "cannot reverse the %s: %v", reversed, err)
+}
+
+// approvalStatus returns the status of the error deciding on the transfer bulk awaiting approval.
+func approvalStatus(err error) error {
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "transfer bulk not found")
+	}
+
+	if errors.Is(err, model.ErrSelfApproval) {
+		return status.Errorf(codes.PermissionDenied, "%v", model.ErrSelfApproval)
+	}
+
+	// nobody may decide on the transfer bulk submitted without user.
+	if errors.Is(err, model.ErrUnknownSubmitter) {
+		return status.Errorf(codes.PermissionDenied, "%v", model.ErrUnknownSubmitter)
+	}
+
+	// conflicts with the decision already taken, unlike the failed preconditions of the transfers.
+	if errors.Is(err, usecase.ErrTransferBulkNotAwaitingApproval) {
+		return status.Errorf(codes.Aborted, "%v", usecase.ErrTransferBulkNotAwaitingApproval)
+	}
+
+	return transferBulkStatus(err)
+}
+
+// bankAccountResponse returns the API representation of the bank account and its balances.
+func bankAccountResponse(output usecase.BankAccountOutput) *api.BankAccount {
+	return &api.BankAccount{
+		Id:                    int64(output.ID),
+		OrganizationName:      output.OrganizationName,
+		Iban:                  output.Iban,
+		Bic:                   output.Bic,
+		Currency:              output.Currency,
+		BalanceCents:          int64(output.BalanceCents),
+		AvailableBalanceCents: int64(output.AvailableBalanceCents),
+		OverdraftLimitCents:   int64(output.OverdraftLimitCents),
+		OverdraftUsedCents:    int64(output.OverdraftUsedCents),
+	}
+}
+
+// holdResponse returns the response of the hold and the account balances.
+func holdResponse(output usecase.HoldOutput) *api.HoldResponse {
+	hold := output.Hold
+
+	return &api.HoldResponse{
+		Hold: &api.Hold{
+			Id:               int64(hold.ID),
+			AmountCents:      int64(hold.AmountCents),
+			Currency:         hold.Currency,
+			CounterpartyName: hold.CounterpartyName,
+			Description:      hold.Description,
+			Status:           string(hold.Status),
+			CapturedCents:    int64(hold.CapturedCents),
+			TransactionId:    int64(hold.TransactionID),
+			ExpiresAt:        hold.ExpiresAt.UTC().Format(time.RFC3339Nano),
+			CreatedAt:        hold.CreatedAt.UTC().Format(time.RFC3339Nano),
+		},
+		BalanceCents:          int64(output.BalanceCents),
+		AvailableBalanceCents: int64(output.AvailableBalanceCents),
+		Currency:              output.Currency,
+	}
+}
+
+// holdStatus returns the status of the error placing, capturing or releasing the hold.
+func holdStatus(err error, action string) error {
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "bank account or hold not found")
+	}
+
+	for _, target := range []error{
+		model.ErrNotEnoughAvailableBalance,
+		model.ErrHoldNotActive,
+		model.ErrCaptureExceedsHold,
+	} {
+		if errors.Is(err, target) {
+			return status.Errorf(codes.FailedPrecondition, "%v", target)
+		}
+	}
+
+	var verr *usecase.ValidationError
+	if errors.As(err, &verr) {
+		return InvalidArgumentStatus(verr).Err()
+	}
+
+	return status.Errorf(codes.Internal, "cannot %s the hold: %v", action, err)
+}
+
+// standingOrderTransferInput returns the use case input of the standing order credit transfer.
+func standingOrderTransferInput(transfer *api.StandingOrderTransfer) usecase.TransactionBulkTransferInput {
+	return usecase.TransactionBulkTransferInput{
+		Amount:           transfer.GetAmount(),
+		Currency:         transfer.GetCurrency(),
+		CounterpartyName: transfer.GetCounterpartyName(),
+		CounterpartyBic:  transfer.GetCounterpartyBic(),
+		CounterpartyIban: transfer.GetCounterpartyIban(),
+		Description:      transfer.GetDescription(),
+	}
+}
+
+// standingOrderResponse returns the API representation of the standing order.
+func standingOrderResponse(order model.StandingOrder) *api.StandingOrder {
+	resp := &api.StandingOrder{
+		Id:               int64(order.ID),
+		OrganizationName: order.OrganizationName,
+		OrganizationBic:  order.OrganizationBic,
+		OrganizationIban: order.OrganizationIban,
+		Transfer: &api.StandingOrderTransfer{
+			Amount:           model.FormatCents(order.AmountCents, order.Currency),
+			Currency:         order.Currency,
+			CounterpartyName: order.CounterpartyName,
+			CounterpartyBic:  order.CounterpartyBic,
+			CounterpartyIban: order.CounterpartyIban,
+			Description:      order.Description,
+		},
+		Frequency:      string(order.Frequency),
+		DayOfMonth:     int32(order.DayOfMonth),
+		StartDate:      order.StartDate.Format(dateLayout),
+		Count:          int32(order.Count),
+		Status:         string(order.Status),
+		ExecutionCount: int32(order.ExecutionCount),
+		CreatedAt:      order.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+
+	if order.EndDate != nil {
+		resp.EndDate = order.EndDate.Format(dateLayout)
+	}
+
+	if order.NextExecutionDate != nil {
+		resp.NextExecutionDate = order.NextExecutionDate.Format(dateLayout)
+	}
+
+	return resp
+}
+
+// standingOrderStatus returns the status of the error of the standing order use case.
+func standingOrderStatus(err error, action string) error {
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "standing order not found")
+	}
+
+	if errors.Is(err, model.ErrStandingOrderStatus) {
+		return status.Errorf(codes.FailedPrecondition, "%v", model.ErrStandingOrderStatus)
+	}
+
+	var verr *usecase.ValidationError
+	if errors.As(err, &verr) {
+		return InvalidArgumentStatus(verr).Err()
+	}
+
+	return status.Errorf(codes.Internal, "cannot %s the standing order: %v", action, err)
+}
+
+// InvalidArgumentStatus returns the InvalidArgument status with the field violations as google.rpc.BadRequest details.
+func InvalidArgumentStatus(verr *usecase.ValidationError) *status.Status {
+	st := status.New(codes.InvalidArgument, verr.Error())
+
+	br := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, len(verr.Violations)),
+	}
+
+	for i, v := range verr.Violations {
+		br.FieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		}
+	}
+
+	dst, err := st.WithDetails(br)
+	if err != nil {
+		return st
+	}
+
+	return dst
+}
+
+// ResourceExhaustedStatus returns the ResourceExhausted status naming the transfer limit breached, with the limit as
+// google.rpc.QuotaFailure details.
+func ResourceExhaustedStatus(lerr *model.TransferLimitError) *status.Status {
+	st := status.New(codes.ResourceExhausted, "transfer limit exceeded: "+lerr.Error())
+
+	qf := &errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{
+			{
+				Subject:     string(lerr.Limit),
+				Description: lerr.Error(),
+			},
+		},
+	}
+
+	dst, err := st.WithDetails(qf)
+	if err != nil {
+		return st
+	}
 
-	return &emptypb.Empty{}, nil
+	return dst
 }
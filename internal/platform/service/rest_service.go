@@ -8,7 +8,6 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // QontoRESTService Wrapper on top of the GRPC server to be able to use the interceptor for
@@ -27,7 +26,7 @@ func NewQontoRESTService(service *QontoService) *QontoRESTService {
 }
 
 // TransferBulk is wrapper on the unary RPC to performs given transfers for REST calls.
-func (s *QontoRESTService) TransferBulk(ctx context.Context, req *api.TransferBulkRequest) (*emptypb.Empty, error) {
+func (s *QontoRESTService) TransferBulk(ctx context.Context, req *api.TransferBulkRequest) (*api.TransferBulkResponse, error) {
 	info := &grpc.UnaryServerInfo{
 		Server:     s.QontoService,
 		FullMethod: "/api.qonto/TransferBulk",
@@ -49,5 +48,610 @@ func (s *QontoRESTService) TransferBulk(ctx context.Context, req *api.TransferBu
 		}
 	}
 
-	return resp.(*emptypb.Empty), err
+	return resp.(*api.TransferBulkResponse), err
+}
+
+// ImportTransferBulk is wrapper on the unary RPC to performs the transfers of a pain.001 file for REST calls.
+func (s *QontoRESTService) ImportTransferBulk(ctx context.Context, req *api.ImportTransferBulkRequest) (*api.TransferBulkResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/ImportTransferBulk",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.ImportTransferBulk(ctx, req.(*api.ImportTransferBulkRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 422,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.TransferBulkResponse), nil
+}
+
+// GetTransferBulkJob is wrapper on the unary RPC to get the transfer bulk job for REST calls.
+func (s *QontoRESTService) GetTransferBulkJob(ctx context.Context, req *api.GetTransferBulkJobRequest) (*api.GetTransferBulkJobResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/GetTransferBulkJob",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.GetTransferBulkJob(ctx, req.(*api.GetTransferBulkJobRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.GetTransferBulkJobResponse), nil
+}
+
+// ListScheduledTransferBulks is wrapper on the unary RPC to list the scheduled transfer bulks for REST calls.
+func (s *QontoRESTService) ListScheduledTransferBulks(ctx context.Context, req *api.ListScheduledTransferBulksRequest) (*api.ListScheduledTransferBulksResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/ListScheduledTransferBulks",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.ListScheduledTransferBulks(ctx, req.(*api.ListScheduledTransferBulksRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.ListScheduledTransferBulksResponse), nil
+}
+
+// CancelScheduledTransferBulk is wrapper on the unary RPC to cancel the scheduled transfer bulk for REST calls.
+func (s *QontoRESTService) CancelScheduledTransferBulk(ctx context.Context, req *api.CancelScheduledTransferBulkRequest) (*api.GetTransferBulkJobResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/CancelScheduledTransferBulk",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.CancelScheduledTransferBulk(ctx, req.(*api.CancelScheduledTransferBulkRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.GetTransferBulkJobResponse), nil
+}
+
+// ReverseTransferBulk is wrapper on the unary RPC to reverse the transfer bulk for REST calls.
+func (s *QontoRESTService) ReverseTransferBulk(ctx context.Context, req *api.ReverseTransferBulkRequest) (*api.ReversalResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/ReverseTransferBulk",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.ReverseTransferBulk(ctx, req.(*api.ReverseTransferBulkRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.ReversalResponse), nil
+}
+
+// ApproveTransferBulk is wrapper on the unary RPC to approve the transfer bulk awaiting approval for REST calls.
+func (s *QontoRESTService) ApproveTransferBulk(ctx context.Context, req *api.ApproveTransferBulkRequest) (*api.TransferBulkResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/ApproveTransferBulk",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.ApproveTransferBulk(ctx, req.(*api.ApproveTransferBulkRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 422,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.TransferBulkResponse), nil
+}
+
+// RejectTransferBulk is wrapper on the unary RPC to reject the transfer bulk awaiting approval for REST calls.
+func (s *QontoRESTService) RejectTransferBulk(ctx context.Context, req *api.RejectTransferBulkRequest) (*api.TransferBulkResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/RejectTransferBulk",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.RejectTransferBulk(ctx, req.(*api.RejectTransferBulkRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.TransferBulkResponse), nil
+}
+
+// CreateStandingOrder is wrapper on the unary RPC to create the standing order for REST calls.
+func (s *QontoRESTService) CreateStandingOrder(ctx context.Context, req *api.CreateStandingOrderRequest) (*api.StandingOrder, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/CreateStandingOrder",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.CreateStandingOrder(ctx, req.(*api.CreateStandingOrderRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.StandingOrder), nil
+}
+
+// GetStandingOrder is wrapper on the unary RPC to get the standing order for REST calls.
+func (s *QontoRESTService) GetStandingOrder(ctx context.Context, req *api.GetStandingOrderRequest) (*api.StandingOrder, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/GetStandingOrder",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.GetStandingOrder(ctx, req.(*api.GetStandingOrderRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.StandingOrder), nil
+}
+
+// ListStandingOrders is wrapper on the unary RPC to list the standing orders for REST calls.
+func (s *QontoRESTService) ListStandingOrders(ctx context.Context, req *api.ListStandingOrdersRequest) (*api.ListStandingOrdersResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/ListStandingOrders",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.ListStandingOrders(ctx, req.(*api.ListStandingOrdersRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.ListStandingOrdersResponse), nil
+}
+
+// UpdateStandingOrder is wrapper on the unary RPC to update the standing order for REST calls.
+func (s *QontoRESTService) UpdateStandingOrder(ctx context.Context, req *api.UpdateStandingOrderRequest) (*api.StandingOrder, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/UpdateStandingOrder",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.UpdateStandingOrder(ctx, req.(*api.UpdateStandingOrderRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.StandingOrder), nil
+}
+
+// DeleteStandingOrder is wrapper on the unary RPC to delete the standing order for REST calls.
+func (s *QontoRESTService) DeleteStandingOrder(ctx context.Context, req *api.DeleteStandingOrderRequest) (*api.StandingOrder, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/DeleteStandingOrder",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.DeleteStandingOrder(ctx, req.(*api.DeleteStandingOrderRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.StandingOrder), nil
+}
+
+// PauseStandingOrder is wrapper on the unary RPC to pause the standing order for REST calls.
+func (s *QontoRESTService) PauseStandingOrder(ctx context.Context, req *api.PauseStandingOrderRequest) (*api.StandingOrder, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/PauseStandingOrder",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.PauseStandingOrder(ctx, req.(*api.PauseStandingOrderRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.StandingOrder), nil
+}
+
+// ResumeStandingOrder is wrapper on the unary RPC to resume the standing order for REST calls.
+func (s *QontoRESTService) ResumeStandingOrder(ctx context.Context, req *api.ResumeStandingOrderRequest) (*api.StandingOrder, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/ResumeStandingOrder",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.ResumeStandingOrder(ctx, req.(*api.ResumeStandingOrderRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.StandingOrder), nil
+}
+
+// SkipStandingOrder is wrapper on the unary RPC to skip the next execution of the standing order for REST calls.
+func (s *QontoRESTService) SkipStandingOrder(ctx context.Context, req *api.SkipStandingOrderRequest) (*api.StandingOrder, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/SkipStandingOrder",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.SkipStandingOrder(ctx, req.(*api.SkipStandingOrderRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.StandingOrder), nil
+}
+
+// ListStandingOrderExecutions is wrapper on the unary RPC to list the executions of the standing order for REST calls.
+func (s *QontoRESTService) ListStandingOrderExecutions(ctx context.Context, req *api.ListStandingOrderExecutionsRequest) (*api.ListStandingOrderExecutionsResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/ListStandingOrderExecutions",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.ListStandingOrderExecutions(ctx, req.(*api.ListStandingOrderExecutionsRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.ListStandingOrderExecutionsResponse), nil
+}
+
+// CheckLedger is wrapper on the unary RPC to check the ledger invariants for REST calls.
+func (s *QontoRESTService) CheckLedger(ctx context.Context, req *api.CheckLedgerRequest) (*api.CheckLedgerResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/CheckLedger",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.CheckLedger(ctx, req.(*api.CheckLedgerRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.CheckLedgerResponse), nil
+}
+
+// ListTransactions is wrapper on the unary RPC to list the transactions of the bank account for REST calls.
+func (s *QontoRESTService) ListTransactions(ctx context.Context, req *api.ListTransactionsRequest) (*api.ListTransactionsResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/ListTransactions",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.ListTransactions(ctx, req.(*api.ListTransactionsRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.ListTransactionsResponse), nil
+}
+
+// GetTransaction is wrapper on the unary RPC to get the transaction of the bank account for REST calls.
+func (s *QontoRESTService) GetTransaction(ctx context.Context, req *api.GetTransactionRequest) (*api.Transaction, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/GetTransaction",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.GetTransaction(ctx, req.(*api.GetTransactionRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.Transaction), nil
+}
+
+// ReverseTransaction is wrapper on the unary RPC to reverse the transaction for REST calls.
+func (s *QontoRESTService) ReverseTransaction(ctx context.Context, req *api.ReverseTransactionRequest) (*api.ReversalResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/ReverseTransaction",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.ReverseTransaction(ctx, req.(*api.ReverseTransactionRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.ReversalResponse), nil
+}
+
+// CreateInboundCredit is wrapper on the unary RPC to book the credit transfer for REST calls.
+func (s *QontoRESTService) CreateInboundCredit(ctx context.Context, req *api.InboundCreditRequest) (*api.InboundCreditResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/CreateInboundCredit",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.CreateInboundCredit(ctx, req.(*api.InboundCreditRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.InboundCreditResponse), nil
+}
+
+// PlaceHold is wrapper on the unary RPC to place the hold for REST calls.
+func (s *QontoRESTService) PlaceHold(ctx context.Context, req *api.PlaceHoldRequest) (*api.HoldResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/PlaceHold",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.PlaceHold(ctx, req.(*api.PlaceHoldRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.HoldResponse), nil
+}
+
+// CaptureHold is wrapper on the unary RPC to capture the hold for REST calls.
+func (s *QontoRESTService) CaptureHold(ctx context.Context, req *api.CaptureHoldRequest) (*api.HoldResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/CaptureHold",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.CaptureHold(ctx, req.(*api.CaptureHoldRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.HoldResponse), nil
+}
+
+// ReleaseHold is wrapper on the unary RPC to release the hold for REST calls.
+func (s *QontoRESTService) ReleaseHold(ctx context.Context, req *api.ReleaseHoldRequest) (*api.HoldResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/ReleaseHold",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.ReleaseHold(ctx, req.(*api.ReleaseHoldRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			err = &runtime.HTTPStatusError{
+				HTTPStatus: 409,
+				Err:        err,
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp.(*api.HoldResponse), nil
+}
+
+// GetBankAccount is wrapper on the unary RPC to get the bank account for REST calls.
+func (s *QontoRESTService) GetBankAccount(ctx context.Context, req *api.GetBankAccountRequest) (*api.BankAccount, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/GetBankAccount",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.GetBankAccount(ctx, req.(*api.GetBankAccountRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.BankAccount), nil
+}
+
+// SetOverdraftLimit is wrapper on the unary RPC to set the overdraft limit of the bank account for REST calls.
+func (s *QontoRESTService) SetOverdraftLimit(ctx context.Context, req *api.SetOverdraftLimitRequest) (*api.BankAccount, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/SetOverdraftLimit",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.SetOverdraftLimit(ctx, req.(*api.SetOverdraftLimitRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.BankAccount), nil
+}
+
+// SetTransferLimits is wrapper on the unary RPC to set the transfer limits of the bank account, or of the organization,
+// for REST calls.
+func (s *QontoRESTService) SetTransferLimits(ctx context.Context, req *api.SetTransferLimitsRequest) (*api.TransferLimits, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.QontoService,
+		FullMethod: "/api.qonto/SetTransferLimits",
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.QontoService.SetTransferLimits(ctx, req.(*api.SetTransferLimitsRequest))
+	}
+
+	resp, err := s.unaryInt(ctx, req, info, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*api.TransferLimits), nil
 }
@@ -0,0 +1,291 @@
+package statement
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const (
+	camtNamespace      = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+	camtDateLayout     = "2006-01-02"
+	camtDateTimeLayout = "2006-01-02T15:04:05"
+	camtCredit         = "CRDT"
+	camtDebit          = "DBIT"
+	camtOpeningCode    = "OPBD"
+	camtClosingCode    = "CLBD"
+	camtBookedStatus   = "BOOK"
+)
+
+type camtDocument struct {
+	XMLName xml.Name      `xml:"Document"`
+	Xmlns   string        `xml:"xmlns,attr"`
+	Stmt    camtBkToCstmr `xml:"BkToCstmrStmt"`
+}
+
+type camtBkToCstmr struct {
+	GrpHdr camtGrpHdr `xml:"GrpHdr"`
+	Stmt   camtStmt   `xml:"Stmt"`
+}
+
+type camtGrpHdr struct {
+	MsgID   string `xml:"MsgId"`
+	CreDtTm string `xml:"CreDtTm"`
+}
+
+type camtStmt struct {
+	ID      string      `xml:"Id"`
+	CreDtTm string      `xml:"CreDtTm"`
+	FrToDt  camtFrToDt  `xml:"FrToDt"`
+	Acct    camtAcct    `xml:"Acct"`
+	Bal     []camtBal   `xml:"Bal"`
+	Ntry    []camtEntry `xml:"Ntry"`
+}
+
+type camtFrToDt struct {
+	FrDtTm string `xml:"FrDtTm"`
+	ToDtTm string `xml:"ToDtTm"`
+}
+
+type camtAcct struct {
+	ID   camtAcctID   `xml:"Id"`
+	Ccy  string       `xml:"Ccy"`
+	Ownr camtParty    `xml:"Ownr"`
+	Svcr camtFinInstn `xml:"Svcr"`
+}
+
+type camtAcctID struct {
+	IBAN string `xml:"IBAN"`
+}
+
+type camtParty struct {
+	Nm string `xml:"Nm"`
+}
+
+type camtFinInstn struct {
+	FinInstnID camtFinInstnID `xml:"FinInstnId"`
+}
+
+type camtFinInstnID struct {
+	BIC string `xml:"BIC"`
+}
+
+type camtAmt struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+type camtDt struct {
+	Dt   string `xml:"Dt,omitempty"`
+	DtTm string `xml:"DtTm,omitempty"`
+}
+
+type camtBal struct {
+	Tp        camtBalTp `xml:"Tp"`
+	Amt       camtAmt   `xml:"Amt"`
+	CdtDbtInd string    `xml:"CdtDbtInd"`
+	Dt        camtDt    `xml:"Dt"`
+}
+
+type camtBalTp struct {
+	CdOrPrtry struct {
+		Cd string `xml:"Cd"`
+	} `xml:"CdOrPrtry"`
+}
+
+type camtEntry struct {
+	NtryRef     string      `xml:"NtryRef"`
+	Amt         camtAmt     `xml:"Amt"`
+	CdtDbtInd   string      `xml:"CdtDbtInd"`
+	Sts         string      `xml:"Sts"`
+	BookgDt     camtDt      `xml:"BookgDt"`
+	ValDt       camtDt      `xml:"ValDt"`
+	AcctSvcrRef string      `xml:"AcctSvcrRef"`
+	BkTxCd      camtBkTxCd  `xml:"BkTxCd"`
+	NtryDtls    camtNtryDtl `xml:"NtryDtls"`
+}
+
+type camtBkTxCd struct {
+	Domn struct {
+		Cd   string `xml:"Cd"`
+		Fmly struct {
+			Cd        string `xml:"Cd"`
+			SubFmlyCd string `xml:"SubFmlyCd"`
+		} `xml:"Fmly"`
+	} `xml:"Domn"`
+}
+
+type camtNtryDtl struct {
+	TxDtls camtTxDtls `xml:"TxDtls"`
+}
+
+type camtTxDtls struct {
+	Refs      camtRefs      `xml:"Refs"`
+	AmtDtls   *camtAmtDtls  `xml:"AmtDtls,omitempty"`
+	RltdPties camtRltdPties `xml:"RltdPties"`
+	RltdAgts  camtRltdAgts  `xml:"RltdAgts"`
+	RmtInf    camtRmtInf    `xml:"RmtInf"`
+}
+
+type camtRefs struct {
+	AcctSvcrRef string `xml:"AcctSvcrRef"`
+}
+
+type camtAmtDtls struct {
+	InstdAmt struct {
+		Amt     camtAmt     `xml:"Amt"`
+		CcyXchg camtCcyXchg `xml:"CcyXchg"`
+	} `xml:"InstdAmt"`
+}
+
+type camtCcyXchg struct {
+	SrcCcy   string `xml:"SrcCcy"`
+	TrgtCcy  string `xml:"TrgtCcy"`
+	XchgRate string `xml:"XchgRate"`
+}
+
+type camtRltdPties struct {
+	Dbtr     *camtParty  `xml:"Dbtr,omitempty"`
+	DbtrAcct *camtCdAcct `xml:"DbtrAcct,omitempty"`
+	Cdtr     *camtParty  `xml:"Cdtr,omitempty"`
+	CdtrAcct *camtCdAcct `xml:"CdtrAcct,omitempty"`
+}
+
+type camtCdAcct struct {
+	ID camtAcctID `xml:"Id"`
+}
+
+type camtRltdAgts struct {
+	DbtrAgt *camtFinInstn `xml:"DbtrAgt,omitempty"`
+	CdtrAgt *camtFinInstn `xml:"CdtrAgt,omitempty"`
+}
+
+type camtRmtInf struct {
+	Ustrd string `xml:"Ustrd"`
+}
+
+// renderCAMT053 renders the statement as ISO 20022 camt.053.001.02 XML.
+func renderCAMT053(w io.Writer, st model.Statement) error {
+	account := st.BankAccount
+	id := "STMT-" + account.Iban + "-" + st.From.Format("20060102")
+	createdAt := st.CreatedAt.UTC().Format(camtDateTimeLayout)
+
+	doc := camtDocument{
+		Xmlns: camtNamespace,
+		Stmt: camtBkToCstmr{
+			GrpHdr: camtGrpHdr{MsgID: id, CreDtTm: createdAt},
+			Stmt: camtStmt{
+				ID:      id,
+				CreDtTm: createdAt,
+				FrToDt: camtFrToDt{
+					FrDtTm: st.From.Format(camtDateTimeLayout),
+					ToDtTm: st.To.Add(-1).Format(camtDateTimeLayout),
+				},
+				Acct: camtAcct{
+					ID:   camtAcctID{IBAN: account.Iban},
+					Ccy:  account.Currency,
+					Ownr: camtParty{Nm: account.OrganizationName},
+					Svcr: camtFinInstn{FinInstnID: camtFinInstnID{BIC: account.Bic}},
+				},
+				Bal: []camtBal{
+					camtBalance(camtOpeningCode, st.OpeningBalanceCents, account.Currency, st.From),
+					camtBalance(camtClosingCode, st.ClosingBalanceCents, account.Currency, lastDay(st)),
+				},
+				Ntry: make([]camtEntry, len(st.Transactions)),
+			},
+		},
+	}
+
+	for i, transaction := range st.Transactions {
+		doc.Stmt.Stmt.Ntry[i] = camtTransaction(transaction, account.Currency)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+func camtBalance(code string, balance model.Cents, currency string, day time.Time) camtBal {
+	bal := camtBal{
+		Amt:       camtAmt{Ccy: currency, Value: model.FormatCents(abs(balance), currency)},
+		CdtDbtInd: camtIndicator(balance),
+		Dt:        camtDt{Dt: day.Format(camtDateLayout)},
+	}
+
+	bal.Tp.CdOrPrtry.Cd = code
+
+	return bal
+}
+
+func camtTransaction(transaction model.Transaction, currency string) camtEntry {
+	amount := entryCents(transaction)
+	ref := strconv.FormatInt(int64(transaction.ID), 10)
+
+	entry := camtEntry{
+		NtryRef:     ref,
+		Amt:         camtAmt{Ccy: currency, Value: model.FormatCents(abs(amount), currency)},
+		CdtDbtInd:   camtIndicator(amount),
+		Sts:         camtBookedStatus,
+		BookgDt:     camtDt{DtTm: transaction.CreatedAt.UTC().Format(camtDateTimeLayout)},
+		ValDt:       camtDt{Dt: transaction.CreatedAt.UTC().Format(camtDateLayout)},
+		AcctSvcrRef: ref,
+	}
+
+	entry.BkTxCd.Domn.Cd = "PMNT"
+	entry.BkTxCd.Domn.Fmly.Cd = "ICDT"
+	entry.BkTxCd.Domn.Fmly.SubFmlyCd = "ESCT"
+
+	details := &entry.NtryDtls.TxDtls
+	details.Refs.AcctSvcrRef = ref
+	details.RmtInf.Ustrd = transaction.Description
+
+	counterparty := &camtParty{Nm: transaction.CounterpartyName}
+	counterpartyAcct := &camtCdAcct{ID: camtAcctID{IBAN: transaction.CounterpartyIban}}
+	counterpartyAgt := &camtFinInstn{FinInstnID: camtFinInstnID{BIC: transaction.CounterpartyBic}}
+
+	if amount < 0 {
+		details.RltdPties.Cdtr, details.RltdPties.CdtrAcct = counterparty, counterpartyAcct
+		details.RltdAgts.CdtrAgt = counterpartyAgt
+	} else {
+		entry.BkTxCd.Domn.Fmly.Cd = "RCDT"
+		details.RltdPties.Dbtr, details.RltdPties.DbtrAcct = counterparty, counterpartyAcct
+		details.RltdAgts.DbtrAgt = counterpartyAgt
+	}
+
+	if transaction.AmountCurrency != "" && transaction.AmountCurrency != currency {
+		details.AmtDtls = &camtAmtDtls{}
+		details.AmtDtls.InstdAmt.Amt = camtAmt{
+			Ccy:   transaction.AmountCurrency,
+			Value: model.FormatCents(abs(transaction.AmountCents), transaction.AmountCurrency),
+		}
+		details.AmtDtls.InstdAmt.CcyXchg = camtCcyXchg{
+			SrcCcy:   transaction.AmountCurrency,
+			TrgtCcy:  currency,
+			XchgRate: transaction.ExchangeRate,
+		}
+	}
+
+	return entry
+}
+
+func camtIndicator(amount model.Cents) string {
+	if amount < 0 {
+		return camtDebit
+	}
+
+	return camtCredit
+}
@@ -0,0 +1,66 @@
+package statement
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const csvDateLayout = "2006-01-02"
+
+var csvHeader = []string{
+	"type", "date", "transaction_id", "counterparty_name", "counterparty_iban", "counterparty_bic", "description",
+	"amount", "currency", "original_amount", "original_currency", "exchange_rate", "balance",
+}
+
+// renderCSV renders the statement as CSV, the opening balance row, one row per entry with the running balance, and
+// the closing balance row. The fees charged for the transfers are rows of type fee.
+func renderCSV(w io.Writer, st model.Statement) error {
+	currency := st.BankAccount.Currency
+	cw := csv.NewWriter(w)
+
+	balanceRow := func(kind, day string, balance model.Cents) []string {
+		formatted := model.FormatCents(balance, currency)
+
+		return []string{kind, day, "", "", "", "", "", formatted, currency, "", "", "", formatted}
+	}
+
+	rows := [][]string{
+		csvHeader,
+		balanceRow("opening_balance", st.From.Format(csvDateLayout), st.OpeningBalanceCents),
+	}
+
+	balance := st.OpeningBalanceCents
+
+	for _, transaction := range st.Transactions {
+		amount := entryCents(transaction)
+		balance += amount
+
+		kind := "entry"
+		if transaction.ChargedTransactionID != 0 {
+			kind = "fee"
+		}
+
+		rows = append(rows, []string{
+			kind,
+			transaction.CreatedAt.UTC().Format(csvDateLayout),
+			strconv.FormatInt(int64(transaction.ID), 10),
+			transaction.CounterpartyName,
+			transaction.CounterpartyIban,
+			transaction.CounterpartyBic,
+			transaction.Description,
+			model.FormatCents(amount, currency),
+			currency,
+			model.FormatCents(-transaction.AmountCents, transaction.AmountCurrency),
+			transaction.AmountCurrency,
+			transaction.ExchangeRate,
+			model.FormatCents(balance, currency),
+		})
+	}
+
+	rows = append(rows, balanceRow("closing_balance", lastDay(st).Format(csvDateLayout), st.ClosingBalanceCents))
+
+	return cw.WriteAll(rows)
+}
@@ -0,0 +1,2 @@
+// Package statement renders account statements in the formats accounting tools import.
+package statement
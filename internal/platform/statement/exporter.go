@@ -0,0 +1,53 @@
+package statement
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+)
+
+// Request is the statement to export.
+type Request struct {
+	Iban string
+	// From and To are the first and the last day of the statement, as YYYY-MM-DD dates.
+	From   string
+	To     string
+	Format Format
+}
+
+// Filename returns the name of the statement file.
+func (r Request) Filename() string {
+	return fmt.Sprintf("statement-%s-%s-%s.%s", r.Iban, r.From, r.To, r.Format.Extension())
+}
+
+// Exporter exports account statements.
+type Exporter struct {
+	statementExport usecase.StatementExport
+}
+
+// NewExporter returns instance of Exporter.
+func NewExporter(statementExport usecase.StatementExport) *Exporter {
+	return &Exporter{
+		statementExport: statementExport,
+	}
+}
+
+// Export builds the statement and renders it in the requested format into w.
+func (e *Exporter) Export(ctx context.Context, w io.Writer, req Request) error {
+	if _, err := ParseFormat(string(req.Format)); err != nil {
+		return err
+	}
+
+	st, err := e.statementExport.Statement(ctx, usecase.StatementInput{
+		Iban: req.Iban,
+		From: req.From,
+		To:   req.To,
+	})
+	if err != nil {
+		return err
+	}
+
+	return Render(w, req.Format, *st)
+}
@@ -0,0 +1,99 @@
+package statement
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+// ErrUnknownFormat error represents when the statement format is not supported.
+var ErrUnknownFormat = errors.New("unknown statement format")
+
+// Format is the format a statement is rendered in.
+type Format string
+
+const (
+	// FormatCAMT053 is the ISO 20022 camt.053 bank to customer statement XML.
+	FormatCAMT053 Format = "camt053"
+	// FormatMT940 is the SWIFT MT940 customer statement.
+	FormatMT940 Format = "mt940"
+	// FormatCSV is a comma separated values statement, one row per balance and entry.
+	FormatCSV Format = "csv"
+	// FormatOFX is the Open Financial Exchange 2.2 bank statement.
+	FormatOFX Format = "ofx"
+)
+
+// ParseFormat parses the format name, case-insensitively.
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(strings.ReplaceAll(strings.ToLower(name), ".", "")); f {
+	case FormatCAMT053, FormatMT940, FormatCSV, FormatOFX:
+		return f, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownFormat, name)
+	}
+}
+
+// ContentType returns the media type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCAMT053:
+		return "application/xml"
+	case FormatCSV:
+		return "text/csv"
+	case FormatOFX:
+		return "application/x-ofx"
+	default:
+		return "text/plain"
+	}
+}
+
+// Extension returns the file extension of the format.
+func (f Format) Extension() string {
+	switch f {
+	case FormatCAMT053:
+		return "xml"
+	case FormatMT940:
+		return "sta"
+	default:
+		return string(f)
+	}
+}
+
+// Render renders the statement in the format into w.
+func Render(w io.Writer, f Format, st model.Statement) error {
+	switch f {
+	case FormatCAMT053:
+		return renderCAMT053(w, st)
+	case FormatMT940:
+		return renderMT940(w, st)
+	case FormatCSV:
+		return renderCSV(w, st)
+	case FormatOFX:
+		return renderOFX(w, st)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownFormat, f)
+	}
+}
+
+// entryCents returns the amount the transaction credits the account, negative when it debits the account.
+func entryCents(transaction model.Transaction) model.Cents {
+	return -transaction.DebitedCents
+}
+
+// lastDay returns the last day of the statement period.
+func lastDay(st model.Statement) time.Time {
+	return st.To.AddDate(0, 0, -1)
+}
+
+// abs returns the absolute amount.
+func abs(c model.Cents) model.Cents {
+	if c < 0 {
+		return -c
+	}
+
+	return c
+}
@@ -0,0 +1,118 @@
+package statement
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const (
+	mt940DateLayout = "060102"
+	// mt940LineLength and mt940MaxLines bound the :86: information to the account owner field.
+	mt940LineLength = 65
+	mt940MaxLines   = 6
+)
+
+// renderMT940 renders the statement as a SWIFT MT940 customer statement, without the SWIFT message blocks.
+func renderMT940(w io.Writer, st model.Statement) error {
+	account := st.BankAccount
+
+	var b strings.Builder
+
+	field := func(tag, value string) {
+		b.WriteString(":" + tag + ":" + value + "\r\n")
+	}
+
+	field("20", "STMT"+st.From.Format(mt940DateLayout))
+	field("25", account.Iban)
+	field("28C", "1/1")
+	field("60F", mt940Balance(st.OpeningBalanceCents, account.Currency, st.From))
+
+	for _, transaction := range st.Transactions {
+		amount := entryCents(transaction)
+		day := transaction.CreatedAt.UTC()
+
+		field("61", fmt.Sprintf(
+			"%s%s%s%sNTRF%d//%d",
+			day.Format(mt940DateLayout),
+			day.Format("0102"),
+			mt940Mark(amount),
+			mt940Amount(amount, account.Currency),
+			transaction.ID,
+			transaction.ID,
+		))
+
+		field("86", mt940Information(
+			transaction.CounterpartyName+" "+transaction.CounterpartyIban+" "+transaction.Description,
+		))
+	}
+
+	field("62F", mt940Balance(st.ClosingBalanceCents, account.Currency, lastDay(st)))
+	b.WriteString("-\r\n")
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func mt940Balance(balance model.Cents, currency string, day time.Time) string {
+	return mt940Mark(balance) + day.Format(mt940DateLayout) + currency + mt940Amount(balance, currency)
+}
+
+// mt940Mark returns the credit or debit mark of the amount.
+func mt940Mark(amount model.Cents) string {
+	if amount < 0 {
+		return "D"
+	}
+
+	return "C"
+}
+
+// mt940Amount formats the absolute amount with a decimal comma, always present.
+func mt940Amount(amount model.Cents, currency string) string {
+	s := strings.Replace(model.FormatCents(abs(amount), currency), ".", ",", 1)
+
+	if !strings.Contains(s, ",") {
+		s += ","
+	}
+
+	return s
+}
+
+// mt940Information formats the information to the account owner in the SWIFT character set, wrapped in lines.
+func mt940Information(s string) string {
+	runes := []rune(strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune("/-?:().,'+ ", r):
+			return r
+		default:
+			return '.'
+		}
+	}, s))
+
+	var lines []string
+
+	for len(runes) > 0 && len(lines) < mt940MaxLines {
+		n := mt940LineLength
+		if n > len(runes) {
+			n = len(runes)
+		}
+
+		line := runes[:n]
+
+		// a line starting with ':' or '-' would be read as a new field or the end of the statement.
+		if line[0] == ':' || line[0] == '-' {
+			line[0] = '.'
+		}
+
+		lines = append(lines, string(line))
+		runes = runes[n:]
+	}
+
+	return strings.Join(lines, "\r\n")
+}
@@ -0,0 +1,147 @@
+package statement
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const (
+	ofxHeader     = `<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n"
+	ofxDateLayout = "20060102150405"
+	// ofxNameLength is the maximum number of characters of the payee name.
+	ofxNameLength = 32
+)
+
+type ofxDocument struct {
+	XMLName xml.Name   `xml:"OFX"`
+	Signon  ofxSignon  `xml:"SIGNONMSGSRSV1>SONRS"`
+	Bank    ofxStmtTrn `xml:"BANKMSGSRSV1>STMTTRNRS"`
+}
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxSignon struct {
+	Status   ofxStatus `xml:"STATUS"`
+	DTServer string    `xml:"DTSERVER"`
+	Language string    `xml:"LANGUAGE"`
+}
+
+type ofxStmtTrn struct {
+	TrnUID string    `xml:"TRNUID"`
+	Status ofxStatus `xml:"STATUS"`
+	StmtRs ofxStmtRs `xml:"STMTRS"`
+}
+
+type ofxStmtRs struct {
+	CurDef       string      `xml:"CURDEF"`
+	BankAcctFrom ofxBankAcct `xml:"BANKACCTFROM"`
+	TranList     ofxTranList `xml:"BANKTRANLIST"`
+	LedgerBal    ofxBalance  `xml:"LEDGERBAL"`
+}
+
+type ofxBankAcct struct {
+	BankID   string `xml:"BANKID"`
+	AcctID   string `xml:"ACCTID"`
+	AcctType string `xml:"ACCTTYPE"`
+}
+
+type ofxTranList struct {
+	DTStart string       `xml:"DTSTART"`
+	DTEnd   string       `xml:"DTEND"`
+	StmtTrn []ofxStmtTrx `xml:"STMTTRN"`
+}
+
+type ofxStmtTrx struct {
+	TrnType  string `xml:"TRNTYPE"`
+	DTPosted string `xml:"DTPOSTED"`
+	TrnAmt   string `xml:"TRNAMT"`
+	FitID    string `xml:"FITID"`
+	Name     string `xml:"NAME"`
+	Memo     string `xml:"MEMO,omitempty"`
+}
+
+type ofxBalance struct {
+	BalAmt string `xml:"BALAMT"`
+	DTAsOf string `xml:"DTASOF"`
+}
+
+// renderOFX renders the statement as an OFX 2.2 bank statement response.
+//
+// OFX has no opening balance, the ledger balance is the closing balance.
+func renderOFX(w io.Writer, st model.Statement) error {
+	account := st.BankAccount
+	ok := ofxStatus{Code: 0, Severity: "INFO"}
+
+	doc := ofxDocument{
+		Signon: ofxSignon{
+			Status:   ok,
+			DTServer: st.CreatedAt.UTC().Format(ofxDateLayout),
+			Language: "ENG",
+		},
+		Bank: ofxStmtTrn{
+			TrnUID: "STMT-" + account.Iban + "-" + st.From.Format("20060102"),
+			Status: ok,
+			StmtRs: ofxStmtRs{
+				CurDef: account.Currency,
+				BankAcctFrom: ofxBankAcct{
+					BankID:   account.Bic,
+					AcctID:   account.Iban,
+					AcctType: "CHECKING",
+				},
+				TranList: ofxTranList{
+					DTStart: st.From.Format(ofxDateLayout),
+					DTEnd:   st.To.Format(ofxDateLayout),
+					StmtTrn: make([]ofxStmtTrx, len(st.Transactions)),
+				},
+				LedgerBal: ofxBalance{
+					BalAmt: model.FormatCents(st.ClosingBalanceCents, account.Currency),
+					DTAsOf: st.To.Format(ofxDateLayout),
+				},
+			},
+		},
+	}
+
+	for i, transaction := range st.Transactions {
+		amount := entryCents(transaction)
+
+		trnType := "CREDIT"
+		if amount < 0 {
+			trnType = "DEBIT"
+		}
+
+		name := []rune(transaction.CounterpartyName)
+		if len(name) > ofxNameLength {
+			name = name[:ofxNameLength]
+		}
+
+		doc.Bank.StmtRs.TranList.StmtTrn[i] = ofxStmtTrx{
+			TrnType:  trnType,
+			DTPosted: transaction.CreatedAt.UTC().Format(ofxDateLayout),
+			TrnAmt:   model.FormatCents(amount, account.Currency),
+			FitID:    strconv.FormatInt(int64(transaction.ID), 10),
+			Name:     string(name),
+			Memo:     transaction.Description,
+		}
+	}
+
+	if _, err := io.WriteString(w, `<?xml version="1.0" encoding="UTF-8" standalone="no"?>`+"\n"+ofxHeader); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
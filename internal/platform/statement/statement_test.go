@@ -0,0 +1,220 @@
+package statement_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/platform/statement"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStatement() model.Statement {
+	return model.Statement{
+		BankAccount: model.BankAccount{
+			ID: 1,
+			BankAccountState: model.BankAccountState{
+				OrganizationName: "ACME Corp",
+				BalanceCents:     9888650,
+				Iban:             "FR10474608000002006107XXXXX",
+				Bic:              "OIVUSCLQXXX",
+				Currency:         "EUR",
+			},
+		},
+		From:                time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+		To:                  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		OpeningBalanceCents: 10000000,
+		ClosingBalanceCents: 9888650,
+		Transactions: []model.Transaction{
+			{
+				ID: 1,
+				TransactionState: model.TransactionState{
+					CounterpartyName: "Bip Bip",
+					CounterpartyIban: "EE303680981021245685",
+					CounterpartyBic:  "CRLYFRPPTOU",
+					AmountCents:      10000,
+					AmountCurrency:   "USD",
+					BankAccountID:    1,
+					Description:      "Wonderland/4410",
+					DebitedCents:     8812,
+					DebitedCurrency:  "EUR",
+					ExchangeRate:     "0.8812",
+					CreatedAt:        time.Date(2021, 12, 2, 9, 30, 0, 0, time.UTC),
+				},
+			},
+			{
+				ID: 2,
+				TransactionState: model.TransactionState{
+					CounterpartyName: "Bugs Bunny",
+					CounterpartyIban: "FR9810009380540930414023042",
+					CounterpartyBic:  "RNJZNTMC",
+					AmountCents:      102538,
+					AmountCurrency:   "EUR",
+					BankAccountID:    1,
+					Description:      "2020 09 24/GoldenCarrot_[refund]",
+					DebitedCents:     102538,
+					DebitedCurrency:  "EUR",
+					ExchangeRate:     "1",
+					CreatedAt:        time.Date(2021, 12, 3, 10, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		CreatedAt: time.Date(2022, 1, 3, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	for name, want := range map[string]statement.Format{
+		"camt053":  statement.FormatCAMT053,
+		"camt.053": statement.FormatCAMT053,
+		"MT940":    statement.FormatMT940,
+		"csv":      statement.FormatCSV,
+		"ofx":      statement.FormatOFX,
+	} {
+		got, err := statement.ParseFormat(name)
+
+		assert.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+
+	_, err := statement.ParseFormat("pdf")
+	assert.ErrorIs(t, err, statement.ErrUnknownFormat)
+}
+
+func TestRender_mt940(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, statement.Render(&buf, statement.FormatMT940, testStatement()))
+
+	assert.Equal(t, ":20:STMT211201\r\n"+
+		":25:FR10474608000002006107XXXXX\r\n"+
+		":28C:1/1\r\n"+
+		":60F:C211201EUR100000,00\r\n"+
+		":61:2112021202D88,12NTRF1//1\r\n"+
+		":86:Bip Bip EE303680981021245685 Wonderland/4410\r\n"+
+		":61:2112031203D1025,38NTRF2//2\r\n"+
+		":86:Bugs Bunny FR9810009380540930414023042 2020 09 24/GoldenCarrot..r\r\n"+
+		"efund.\r\n"+
+		":62F:C211231EUR98886,50\r\n"+
+		"-\r\n", buf.String())
+}
+
+func TestRender_csv(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, statement.Render(&buf, statement.FormatCSV, testStatement()))
+
+	assert.Equal(t, `type,date,transaction_id,counterparty_name,counterparty_iban,counterparty_bic,description,amount,currency,original_amount,original_currency,exchange_rate,balance
+opening_balance,2021-12-01,,,,,,100000.00,EUR,,,,100000.00
+entry,2021-12-02,1,Bip Bip,EE303680981021245685,CRLYFRPPTOU,Wonderland/4410,-88.12,EUR,-100.00,USD,0.8812,99911.88
+entry,2021-12-03,2,Bugs Bunny,FR9810009380540930414023042,RNJZNTMC,2020 09 24/GoldenCarrot_[refund],-1025.38,EUR,-1025.38,EUR,1,98886.50
+closing_balance,2021-12-31,,,,,,98886.50,EUR,,,,98886.50
+`, buf.String())
+}
+
+func TestRender_csvFee(t *testing.T) {
+	t.Parallel()
+
+	st := testStatement()
+	transfer := st.Transactions[0]
+
+	fee := model.Transaction{ID: 3, TransactionState: transfer.Fee(500, model.TransferKindInternational)}
+	fee.CreatedAt = transfer.CreatedAt
+
+	st.Transactions = []model.Transaction{transfer, fee}
+	st.ClosingBalanceCents = 9990688
+
+	var buf bytes.Buffer
+
+	require.NoError(t, statement.Render(&buf, statement.FormatCSV, st))
+
+	assert.Equal(t, `type,date,transaction_id,counterparty_name,counterparty_iban,counterparty_bic,description,amount,currency,original_amount,original_currency,exchange_rate,balance
+opening_balance,2021-12-01,,,,,,100000.00,EUR,,,,100000.00
+entry,2021-12-02,1,Bip Bip,EE303680981021245685,CRLYFRPPTOU,Wonderland/4410,-88.12,EUR,-100.00,USD,0.8812,99911.88
+fee,2021-12-02,3,Bip Bip,EE303680981021245685,CRLYFRPPTOU,Fee international transfer: Wonderland/4410,-5.00,EUR,-5.00,EUR,1,99906.88
+closing_balance,2021-12-31,,,,,,99906.88,EUR,,,,99906.88
+`, buf.String())
+}
+
+func TestRender_camt053(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, statement.Render(&buf, statement.FormatCAMT053, testStatement()))
+
+	var doc struct {
+		XMLName xml.Name `xml:"urn:iso:std:iso:20022:tech:xsd:camt.053.001.02 Document"`
+		Stmt    struct {
+			Acct struct {
+				IBAN string `xml:"Id>IBAN"`
+			} `xml:"Acct"`
+			Bal []struct {
+				Cd        string `xml:"Tp>CdOrPrtry>Cd"`
+				Amt       string `xml:"Amt"`
+				CdtDbtInd string `xml:"CdtDbtInd"`
+			} `xml:"Bal"`
+			Ntry []struct {
+				Amt       string `xml:"Amt"`
+				CdtDbtInd string `xml:"CdtDbtInd"`
+				Cdtr      string `xml:"NtryDtls>TxDtls>RltdPties>Cdtr>Nm"`
+				InstdAmt  string `xml:"NtryDtls>TxDtls>AmtDtls>InstdAmt>Amt"`
+			} `xml:"Ntry"`
+		} `xml:"BkToCstmrStmt>Stmt"`
+	}
+
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+
+	assert.Equal(t, "FR10474608000002006107XXXXX", doc.Stmt.Acct.IBAN)
+	require.Len(t, doc.Stmt.Bal, 2)
+	assert.Equal(t, "OPBD", doc.Stmt.Bal[0].Cd)
+	assert.Equal(t, "100000.00", doc.Stmt.Bal[0].Amt)
+	assert.Equal(t, "CLBD", doc.Stmt.Bal[1].Cd)
+	assert.Equal(t, "98886.50", doc.Stmt.Bal[1].Amt)
+	assert.Equal(t, "CRDT", doc.Stmt.Bal[1].CdtDbtInd)
+	require.Len(t, doc.Stmt.Ntry, 2)
+	assert.Equal(t, "88.12", doc.Stmt.Ntry[0].Amt)
+	assert.Equal(t, "DBIT", doc.Stmt.Ntry[0].CdtDbtInd)
+	assert.Equal(t, "Bip Bip", doc.Stmt.Ntry[0].Cdtr)
+	assert.Equal(t, "100.00", doc.Stmt.Ntry[0].InstdAmt)
+	assert.Empty(t, doc.Stmt.Ntry[1].InstdAmt)
+}
+
+func TestRender_ofx(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, statement.Render(&buf, statement.FormatOFX, testStatement()))
+
+	var doc struct {
+		XMLName xml.Name `xml:"OFX"`
+		StmtRs  struct {
+			AcctID  string `xml:"BANKACCTFROM>ACCTID"`
+			StmtTrn []struct {
+				TrnType string `xml:"TRNTYPE"`
+				TrnAmt  string `xml:"TRNAMT"`
+				FitID   string `xml:"FITID"`
+			} `xml:"BANKTRANLIST>STMTTRN"`
+			BalAmt string `xml:"LEDGERBAL>BALAMT"`
+		} `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS"`
+	}
+
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+
+	assert.Equal(t, "FR10474608000002006107XXXXX", doc.StmtRs.AcctID)
+	require.Len(t, doc.StmtRs.StmtTrn, 2)
+	assert.Equal(t, "DEBIT", doc.StmtRs.StmtTrn[0].TrnType)
+	assert.Equal(t, "-88.12", doc.StmtRs.StmtTrn[0].TrnAmt)
+	assert.Equal(t, "2", doc.StmtRs.StmtTrn[1].FitID)
+	assert.Equal(t, "98886.50", doc.StmtRs.BalAmt)
+}
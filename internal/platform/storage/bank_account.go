@@ -13,8 +13,14 @@ import (
 
 const bankAccountTable = "bank_accounts"
 
-// ErrNotEnoughBalance error represents when the account does not have enough balance.
-var ErrNotEnoughBalance = errors.New("not enough balance")
+var (
+	// ErrNotEnoughBalance error represents when the account does not have enough balance.
+	ErrNotEnoughBalance = errors.New("not enough balance")
+	// ErrOverdraftExceeded error represents when the account has an overdraft facility not covering the amount.
+	ErrOverdraftExceeded = errors.New("overdraft limit exceeded")
+	// ErrNegativeHeld error represents when releasing more than the amount held on the account.
+	ErrNegativeHeld = errors.New("negative held amount")
+)
 
 // BankAccount represents a BankAccount repository.
 type BankAccount struct {
@@ -25,6 +31,8 @@ type BankAccount struct {
 	colBalanceCents     string
 	colIban             string
 	colBic              string
+	colHeldCents        string
+	colOverdraftLimit   string
 }
 
 // NewBankAccount returns instance of BankAccount.
@@ -38,12 +46,177 @@ func NewBankAccount(storage *sqluct.Storage) *BankAccount {
 		colBalanceCents:     storage.Mapper.Col(&bankAccount, &bankAccount.BalanceCents),
 		colIban:             storage.Mapper.Col(&bankAccount, &bankAccount.Iban),
 		colBic:              storage.Mapper.Col(&bankAccount, &bankAccount.Bic),
+		colHeldCents:        storage.Mapper.Col(&bankAccount, &bankAccount.HeldCents),
+		colOverdraftLimit:   storage.Mapper.Col(&bankAccount, &bankAccount.OverdraftLimitCents),
+	}
+}
+
+// Find finds the bank account from a storage.
+func (r *BankAccount) Find(ctx context.Context, accountState model.BankAccountState) (*model.BankAccount, error) {
+	errMsg := "storage.BankAccount: failed to find account"
+
+	var bankAccount model.BankAccount
+
+	q := r.storage.SelectStmt(bankAccountTable, bankAccount).
+		Where(squirrel.Eq{r.colOrganizationName: accountState.OrganizationName}).
+		Where(squirrel.Eq{r.colIban: accountState.Iban}).
+		Where(squirrel.Eq{r.colBic: accountState.Bic})
+
+	err := r.storage.Select(ctx, q, &bankAccount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &bankAccount, nil
+}
+
+// FindByID finds the bank account of the id from a storage.
+func (r *BankAccount) FindByID(ctx context.Context, id model.BankAccountID) (*model.BankAccount, error) {
+	errMsg := "storage.BankAccount: failed to find account by id"
+
+	var bankAccount model.BankAccount
+
+	q := r.storage.SelectStmt(bankAccountTable, bankAccount).
+		Where(squirrel.Eq{r.colID: id})
+
+	err := r.storage.Select(ctx, q, &bankAccount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &bankAccount, nil
+}
+
+// Lock finds the bank account of the id from a storage and locks it against concurrent balance updates until the
+// transaction ends.
+func (r *BankAccount) Lock(ctx context.Context, id model.BankAccountID) (*model.BankAccount, error) {
+	errMsg := "storage.BankAccount: failed to lock account"
+
+	var bankAccount model.BankAccount
+
+	q := r.storage.SelectStmt(bankAccountTable, bankAccount).
+		Where(squirrel.Eq{r.colID: id}).
+		Suffix("FOR UPDATE")
+
+	err := r.storage.Select(ctx, q, &bankAccount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &bankAccount, nil
+}
+
+// FindByIbanBic finds the bank account of the iban and bic from a storage.
+func (r *BankAccount) FindByIbanBic(ctx context.Context, iban, bic string) (*model.BankAccount, error) {
+	errMsg := "storage.BankAccount: failed to find account by iban and bic"
+
+	var bankAccount model.BankAccount
+
+	q := r.storage.SelectStmt(bankAccountTable, bankAccount).
+		Where(squirrel.Eq{r.colIban: iban}).
+		Where(squirrel.Eq{r.colBic: bic})
+
+	err := r.storage.Select(ctx, q, &bankAccount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &bankAccount, nil
+}
+
+// FindByIban finds the bank account of the iban from a storage.
+//
+// Within a transaction, the account is locked against concurrent balance updates until the transaction ends.
+func (r *BankAccount) FindByIban(ctx context.Context, iban string) (*model.BankAccount, error) {
+	errMsg := "storage.BankAccount: failed to find account by iban"
+
+	var bankAccount model.BankAccount
+
+	q := r.storage.SelectStmt(bankAccountTable, bankAccount).
+		Where(squirrel.Eq{r.colIban: iban})
+
+	if tx := sqluct.TxFromContext(ctx); tx != nil {
+		q = q.Suffix("FOR SHARE")
+	}
+
+	err := r.storage.Select(ctx, q, &bankAccount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &bankAccount, nil
+}
+
+// FindByIbans finds the bank accounts of the ibans from a storage, ordered by id.
+//
+// The ibans without bank account are left out, the accounts are not locked.
+func (r *BankAccount) FindByIbans(ctx context.Context, ibans []string) ([]model.BankAccount, error) {
+	errMsg := "storage.BankAccount: failed to find accounts by ibans"
+
+	var bankAccounts []model.BankAccount
+
+	q := r.storage.SelectStmt(bankAccountTable, model.BankAccount{}).
+		Where(squirrel.Eq{r.colIban: ibans}).
+		OrderBy(r.colID)
+
+	err := r.storage.Select(ctx, q, &bankAccounts)
+	if err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
 	}
+
+	return bankAccounts, nil
 }
 
 // BalanceCheck checks whether the account has enough balance or not from a storage.
 //
-// Returns the bank account detail when ever the account has enough balance, otherwise error.
+// The amount is checked against the available balance, the amounts held by the active holds cannot be spent, plus
+// the overdraft limit, see model.BankAccountState.Shortfall. Returns the bank account detail when ever the account
+// has enough balance, otherwise ErrNotEnoughBalance when the account has no overdraft facility to use, or
+// ErrOverdraftExceeded when the available balance plus the overdraft limit is lower than the amount.
 func (r *BankAccount) BalanceCheck(ctx context.Context, accountState model.BankAccountState, amount model.Cents) (*model.BankAccount, error) {
 	errMsg := "storage.BankAccount: failed to check account balance"
 
@@ -71,19 +244,81 @@ func (r *BankAccount) BalanceCheck(ctx context.Context, accountState model.BankA
 		)
 	}
 
-	if bankAccount.BalanceCents < amount {
+	switch bankAccount.Shortfall(amount) {
+	case model.BalanceExceeded:
 		return nil, ctxd.WrapError(ctx, ErrNotEnoughBalance, errMsg)
+	case model.OverdraftExceeded:
+		return nil, ctxd.WrapError(ctx, ErrOverdraftExceeded, errMsg)
+	case model.NoShortfall:
 	}
 
 	return &bankAccount, nil
 }
 
-// BalanceUpdate updates the account balance from a storage.
-func (r *BankAccount) BalanceUpdate(ctx context.Context, accountID model.BankAccountID, amount model.Cents) error {
+// BalanceAdd adds the amount to the account balance from a storage, a negative amount debits the account.
+//
+// The balance is updated by the database, it moves by the amount posted into the ledger whatever the balance read.
+func (r *BankAccount) BalanceAdd(ctx context.Context, accountID model.BankAccountID, amount model.Cents) error {
 	errMsg := "storage.BankAccount: failed to update account balance"
 
 	q := r.storage.UpdateStmt(bankAccountTable, nil).
-		Set(r.colBalanceCents, amount).
+		Set(r.colBalanceCents, squirrel.Expr(r.colBalanceCents+" + ?", amount)).
+		Where(squirrel.Eq{r.colID: accountID})
+
+	if _, err := r.storage.Exec(ctx, q); err != nil {
+		return ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return nil
+}
+
+// HeldAdd adds the amount to the amount held on the account from a storage, a negative amount releases it.
+//
+// The held amount is updated by the database, whatever the held amount read, and never goes below zero: releasing
+// more than held returns ErrNegativeHeld.
+func (r *BankAccount) HeldAdd(ctx context.Context, accountID model.BankAccountID, amount model.Cents) error {
+	errMsg := "storage.BankAccount: failed to update account held amount"
+
+	q := r.storage.UpdateStmt(bankAccountTable, nil).
+		Set(r.colHeldCents, squirrel.Expr(r.colHeldCents+" + ?", amount)).
+		Where(squirrel.Eq{r.colID: accountID}).
+		Where(squirrel.Expr(r.colHeldCents+" + ? >= 0", amount))
+
+	res, err := r.storage.Exec(ctx, q)
+	if err != nil {
+		return ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	if affected == 0 {
+		return ctxd.WrapError(ctx, ErrNegativeHeld, errMsg, "amount_cents", amount)
+	}
+
+	return nil
+}
+
+// OverdraftLimitUpdate updates the overdraft limit of the account from a storage.
+func (r *BankAccount) OverdraftLimitUpdate(ctx context.Context, accountID model.BankAccountID, limit model.Cents) error {
+	errMsg := "storage.BankAccount: failed to update account overdraft limit"
+
+	q := r.storage.UpdateStmt(bankAccountTable, nil).
+		Set(r.colOverdraftLimit, limit).
 		Where(squirrel.Eq{r.colID: accountID})
 
 	if _, err := r.storage.Exec(ctx, q); err != nil {
@@ -53,6 +53,7 @@ func TestBankAccount_BalanceCheck(t *testing.T) {
 						BalanceCents:     1000000,
 						Iban:             "Iban",
 						Bic:              "Bic",
+						Currency:         "EUR",
 					},
 				},
 			},
@@ -63,6 +64,7 @@ func TestBankAccount_BalanceCheck(t *testing.T) {
 					BalanceCents:     1000000,
 					Iban:             "Iban",
 					Bic:              "Bic",
+					Currency:         "EUR",
 				},
 			},
 			wantErr: false,
@@ -103,6 +105,7 @@ func TestBankAccount_BalanceCheck(t *testing.T) {
 						BalanceCents:     1000,
 						Iban:             "Iban",
 						Bic:              "Bic",
+						Currency:         "EUR",
 					},
 				},
 			},
@@ -111,6 +114,115 @@ func TestBankAccount_BalanceCheck(t *testing.T) {
 			pgxErr:  nil,
 			err:     ctxd.WrapError(context.Background(), storage.ErrNotEnoughBalance, "storage.BankAccount: failed to check account balance"),
 		},
+		{
+			name: "not enough available balance, funds held",
+			args: args{
+				amount: 10000,
+				accountState: model.BankAccountState{
+					OrganizationName: "Organization",
+					BalanceCents:     0,
+					Iban:             "Iban",
+					Bic:              "Bic",
+				},
+				pgxResult: &model.BankAccount{
+					ID: 1,
+					BankAccountState: model.BankAccountState{
+						OrganizationName: "OrganizationName",
+						BalanceCents:     15000,
+						Iban:             "Iban",
+						Bic:              "Bic",
+						Currency:         "EUR",
+						HeldCents:        6000,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			pgxErr:  nil,
+			err:     ctxd.WrapError(context.Background(), storage.ErrNotEnoughBalance, "storage.BankAccount: failed to check account balance"),
+		},
+		{
+			name: "overdraft facility covering the amount",
+			args: args{
+				amount: 10000,
+				accountState: model.BankAccountState{
+					OrganizationName: "OrganizationName",
+					Iban:             "Iban",
+					Bic:              "Bic",
+				},
+				pgxResult: &model.BankAccount{
+					ID: 1,
+					BankAccountState: model.BankAccountState{
+						OrganizationName:    "OrganizationName",
+						BalanceCents:        5000,
+						Iban:                "Iban",
+						Bic:                 "Bic",
+						Currency:            "EUR",
+						OverdraftLimitCents: 5000,
+					},
+				},
+			},
+			want: &model.BankAccount{
+				ID: 1,
+				BankAccountState: model.BankAccountState{
+					OrganizationName:    "OrganizationName",
+					BalanceCents:        5000,
+					Iban:                "Iban",
+					Bic:                 "Bic",
+					Currency:            "EUR",
+					OverdraftLimitCents: 5000,
+				},
+			},
+		},
+		{
+			name: "overdraft limit exceeded",
+			args: args{
+				amount: 10001,
+				accountState: model.BankAccountState{
+					OrganizationName: "OrganizationName",
+					Iban:             "Iban",
+					Bic:              "Bic",
+				},
+				pgxResult: &model.BankAccount{
+					ID: 1,
+					BankAccountState: model.BankAccountState{
+						OrganizationName:    "OrganizationName",
+						BalanceCents:        5000,
+						Iban:                "Iban",
+						Bic:                 "Bic",
+						Currency:            "EUR",
+						OverdraftLimitCents: 5000,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			err:     ctxd.WrapError(context.Background(), storage.ErrOverdraftExceeded, "storage.BankAccount: failed to check account balance"),
+		},
+		{
+			name: "not enough balance, overdraft facility removed while used",
+			args: args{
+				amount: 1,
+				accountState: model.BankAccountState{
+					OrganizationName: "OrganizationName",
+					Iban:             "Iban",
+					Bic:              "Bic",
+				},
+				pgxResult: &model.BankAccount{
+					ID: 1,
+					BankAccountState: model.BankAccountState{
+						OrganizationName: "OrganizationName",
+						BalanceCents:     -3000,
+						Iban:             "Iban",
+						Bic:              "Bic",
+						Currency:         "EUR",
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			err:     ctxd.WrapError(context.Background(), storage.ErrNotEnoughBalance, "storage.BankAccount: failed to check account balance"),
+		},
 		{
 			name: "db error when account balance check",
 			args: args{
@@ -139,7 +251,7 @@ func TestBankAccount_BalanceCheck(t *testing.T) {
 			require.NoError(t, err)
 
 			meQuery := mock.ExpectQuery(`
-				SELECT id, organization_name, balance_cents, iban, bic 
+				SELECT id, organization_name, balance_cents, iban, bic, currency, held_cents, overdraft_limit_cents 
 				FROM bank_accounts  
 				WHERE organization_name = $1 AND iban = $2 AND bic = $3
 			`).
@@ -147,11 +259,11 @@ func TestBankAccount_BalanceCheck(t *testing.T) {
 
 			if tc.args.pgxResult != nil {
 				rows := sqlmock.NewRows([]string{
-					"id", "organization_name", "balance_cents", "iban", "bic",
+					"id", "organization_name", "balance_cents", "iban", "bic", "currency", "held_cents", "overdraft_limit_cents",
 				})
 
 				rows.AddRow(
-					tc.args.pgxResult.ID, tc.args.pgxResult.OrganizationName, tc.args.pgxResult.BalanceCents, tc.args.pgxResult.Iban, tc.args.pgxResult.Bic,
+					tc.args.pgxResult.ID, tc.args.pgxResult.OrganizationName, tc.args.pgxResult.BalanceCents, tc.args.pgxResult.Iban, tc.args.pgxResult.Bic, tc.args.pgxResult.Currency, tc.args.pgxResult.HeldCents, tc.args.pgxResult.OverdraftLimitCents,
 				)
 
 				meQuery.WillReturnRows(rows)
@@ -183,7 +295,121 @@ func TestBankAccount_BalanceCheck(t *testing.T) {
 	}
 }
 
-func TestBankAccount_BalanceUpdate(t *testing.T) {
+func TestBankAccount_Find(t *testing.T) {
+	t.Parallel()
+
+	accountState := model.BankAccountState{
+		OrganizationName: "OrganizationName",
+		Iban:             "Iban",
+		Bic:              "Bic",
+	}
+
+	tests := []struct {
+		name      string
+		pgxResult *model.BankAccount
+		want      *model.BankAccount
+		wantErr   bool
+		pgxErr    error
+		err       error
+	}{
+		{
+			name: "account found",
+			pgxResult: &model.BankAccount{
+				ID: 1,
+				BankAccountState: model.BankAccountState{
+					OrganizationName: "OrganizationName",
+					BalanceCents:     1000000,
+					Iban:             "Iban",
+					Bic:              "Bic",
+					Currency:         "USD",
+				},
+			},
+			want: &model.BankAccount{
+				ID: 1,
+				BankAccountState: model.BankAccountState{
+					OrganizationName: "OrganizationName",
+					BalanceCents:     1000000,
+					Iban:             "Iban",
+					Bic:              "Bic",
+					Currency:         "USD",
+				},
+			},
+			wantErr: false,
+			pgxErr:  nil,
+			err:     nil,
+		},
+		{
+			name:      "account does not exists",
+			pgxResult: nil,
+			want:      nil,
+			wantErr:   true,
+			pgxErr:    sql.ErrNoRows,
+			err:       ctxd.WrapError(context.Background(), storage.ErrNotFound, "storage.BankAccount: failed to find account"),
+		},
+		{
+			name:      "db error when finding account",
+			pgxResult: nil,
+			want:      nil,
+			wantErr:   true,
+			pgxErr:    errRowsClosed,
+			err:       ctxd.WrapError(context.Background(), errRowsClosed, "storage.BankAccount: failed to find account"),
+		},
+	}
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, organization_name, balance_cents, iban, bic, currency, held_cents, overdraft_limit_cents 
+				FROM bank_accounts  
+				WHERE organization_name = $1 AND iban = $2 AND bic = $3
+			`).
+				WithArgs(accountState.OrganizationName, accountState.Iban, accountState.Bic)
+
+			if tc.pgxResult != nil {
+				rows := sqlmock.NewRows([]string{
+					"id", "organization_name", "balance_cents", "iban", "bic", "currency", "held_cents", "overdraft_limit_cents",
+				})
+
+				rows.AddRow(
+					tc.pgxResult.ID, tc.pgxResult.OrganizationName, tc.pgxResult.BalanceCents, tc.pgxResult.Iban, tc.pgxResult.Bic, tc.pgxResult.Currency, tc.pgxResult.HeldCents, tc.pgxResult.OverdraftLimitCents,
+				)
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewBankAccount(st)
+
+			got, err := r.Find(context.Background(), accountState)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Find() error = %v, wantErr %v", err, tc.wantErr)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Find() got = %v, want %v", got, tc.want)
+			}
+
+			assert.ErrorIsf(t, tc.err, err, "Find() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Find() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestBankAccount_BalanceAdd(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
@@ -199,17 +425,17 @@ func TestBankAccount_BalanceUpdate(t *testing.T) {
 		err     error
 	}{
 		{
-			name: "balance didactic successfully",
+			name: "balance added successfully",
 			args: args{
 				accountID: 1,
-				amount:    10000,
+				amount:    -10000,
 			},
 			wantErr: false,
 			pgxErr:  nil,
 			err:     nil,
 		},
 		{
-			name: "balance didactic successfully",
+			name: "db error when adding balance",
 			args: args{
 				accountID: 1,
 				amount:    10000,
@@ -231,7 +457,7 @@ func TestBankAccount_BalanceUpdate(t *testing.T) {
 
 			meQuery := mock.ExpectExec(`
 				UPDATE bank_accounts  
-				SET balance_cents = $1
+				SET balance_cents = balance_cents + $1
 				WHERE id = $2
 			`).
 				WithArgs(tc.args.amount, tc.args.accountID)
@@ -246,19 +472,110 @@ func TestBankAccount_BalanceUpdate(t *testing.T) {
 
 			r := storage.NewBankAccount(st)
 
-			if err = r.BalanceUpdate(context.Background(), tc.args.accountID, tc.args.amount); (err != nil) != tc.wantErr {
-				t.Errorf("BalanceUpdate() error = %v, wantErr %v", err, tc.wantErr)
+			if err = r.BalanceAdd(context.Background(), tc.args.accountID, tc.args.amount); (err != nil) != tc.wantErr {
+				t.Errorf("BalanceAdd() error = %v, wantErr %v", err, tc.wantErr)
 			}
 
-			assert.ErrorIsf(t, tc.err, err, "BalanceUpdate() err got = %v, want %v", err, tc.err)
+			assert.ErrorIsf(t, tc.err, err, "BalanceAdd() err got = %v, want %v", err, tc.err)
 
 			if err = mock.ExpectationsWereMet(); err != nil {
-				t.Errorf("BalanceUpdate() expectations were not met = %v", err)
+				t.Errorf("BalanceAdd() expectations were not met = %v", err)
 			}
 		})
 	}
 }
 
+func TestBankAccount_HeldAdd(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		amount   model.Cents
+		affected int64
+		pgxErr   error
+		err      error
+	}{
+		{
+			name:     "held amount added",
+			amount:   6000,
+			affected: 1,
+		},
+		{
+			name:     "held amount released",
+			amount:   -6000,
+			affected: 1,
+		},
+		{
+			name:   "held amount released more than held",
+			amount: -6000,
+			err:    storage.ErrNegativeHeld,
+		},
+		{
+			name:   "db error when updating held amount",
+			amount: 6000,
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectExec(`
+				UPDATE bank_accounts SET held_cents = held_cents + $1 WHERE id = $2 AND held_cents + $3 >= 0
+			`).
+				WithArgs(tc.amount, 1, tc.amount)
+
+			if tc.pgxErr == nil {
+				meQuery.WillReturnResult(sqlmock.NewResult(0, tc.affected))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewBankAccount(st)
+
+			err = r.HeldAdd(context.Background(), 1, tc.amount)
+			assert.ErrorIsf(t, err, tc.err, "HeldAdd() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("HeldAdd() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestBankAccount_OverdraftLimitUpdate(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+
+	mock.ExpectExec(`
+		UPDATE bank_accounts SET overdraft_limit_cents = $1 WHERE id = $2
+	`).
+		WithArgs(50000, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	r := storage.NewBankAccount(st)
+
+	err = r.OverdraftLimitUpdate(context.Background(), 1, 50000)
+	assert.NoError(t, err, "OverdraftLimitUpdate() got error = %v", err)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("OverdraftLimitUpdate() expectations were not met = %v", err)
+	}
+}
+
 func TestBankAccount_BalanceCheck_ForUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -275,7 +592,7 @@ func TestBankAccount_BalanceCheck_ForUpdate(t *testing.T) {
 	mock.ExpectBegin()
 
 	meQuery := mock.ExpectQuery(`
-				SELECT id, organization_name, balance_cents, iban, bic 
+				SELECT id, organization_name, balance_cents, iban, bic, currency, held_cents, overdraft_limit_cents 
 				FROM bank_accounts  
 				WHERE organization_name = $1 AND iban = $2 AND bic = $3
 				FOR UPDATE
@@ -283,11 +600,11 @@ func TestBankAccount_BalanceCheck_ForUpdate(t *testing.T) {
 		WithArgs(accountState.OrganizationName, accountState.Iban, accountState.Bic)
 
 	rows := sqlmock.NewRows([]string{
-		"id", "organization_name", "balance_cents", "iban", "bic",
+		"id", "organization_name", "balance_cents", "iban", "bic", "currency", "held_cents", "overdraft_limit_cents",
 	})
 
 	rows.AddRow(
-		1, accountState.OrganizationName, 100000, accountState.Iban, accountState.Bic,
+		1, accountState.OrganizationName, 100000, accountState.Iban, accountState.Bic, "EUR", 0, 0,
 	)
 
 	meQuery.WillReturnRows(rows)
@@ -309,3 +626,401 @@ func TestBankAccount_BalanceCheck_ForUpdate(t *testing.T) {
 		t.Errorf("BalanceCheck() expectations were not met = %v", err)
 	}
 }
+
+func TestBankAccount_FindByIban(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		pgxResult *model.BankAccount
+		pgxErr    error
+		err       error
+	}{
+		{
+			name: "account found",
+			pgxResult: &model.BankAccount{
+				ID: 1,
+				BankAccountState: model.BankAccountState{
+					OrganizationName: "OrganizationName",
+					BalanceCents:     1000000,
+					Iban:             "Iban",
+					Bic:              "Bic",
+					Currency:         "EUR",
+				},
+			},
+		},
+		{
+			name:   "account does not exists",
+			pgxErr: sql.ErrNoRows,
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:   "db error when finding account",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, organization_name, balance_cents, iban, bic, currency, held_cents, overdraft_limit_cents 
+				FROM bank_accounts  
+				WHERE iban = $1
+			`).
+				WithArgs("Iban")
+
+			if tc.pgxResult != nil {
+				rows := sqlmock.NewRows([]string{
+					"id", "organization_name", "balance_cents", "iban", "bic", "currency", "held_cents", "overdraft_limit_cents",
+				})
+
+				rows.AddRow(
+					tc.pgxResult.ID, tc.pgxResult.OrganizationName, tc.pgxResult.BalanceCents, tc.pgxResult.Iban, tc.pgxResult.Bic, tc.pgxResult.Currency, tc.pgxResult.HeldCents, tc.pgxResult.OverdraftLimitCents,
+				)
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewBankAccount(st)
+
+			got, err := r.FindByIban(context.Background(), "Iban")
+
+			assert.Equal(t, tc.pgxResult, got, "FindByIban() got = %v, want %v", got, tc.pgxResult)
+			assert.ErrorIsf(t, err, tc.err, "FindByIban() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("FindByIban() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestBankAccount_FindByIbans(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		pgxResult []model.BankAccount
+		pgxErr    error
+		err       error
+	}{
+		{
+			name: "accounts found",
+			pgxResult: []model.BankAccount{
+				{
+					ID: 1,
+					BankAccountState: model.BankAccountState{
+						OrganizationName: "OrganizationName",
+						BalanceCents:     1000000,
+						Iban:             "Iban",
+						Bic:              "Bic",
+						Currency:         "EUR",
+					},
+				},
+			},
+		},
+		{
+			name: "no account found",
+		},
+		{
+			name:   "db error when finding accounts",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, organization_name, balance_cents, iban, bic, currency, held_cents, overdraft_limit_cents 
+				FROM bank_accounts  
+				WHERE iban IN ($1,$2) 
+				ORDER BY id
+			`).
+				WithArgs("Iban", "Iban2")
+
+			if tc.pgxErr == nil {
+				rows := sqlmock.NewRows([]string{
+					"id", "organization_name", "balance_cents", "iban", "bic", "currency", "held_cents", "overdraft_limit_cents",
+				})
+
+				for _, account := range tc.pgxResult {
+					rows.AddRow(
+						account.ID, account.OrganizationName, account.BalanceCents, account.Iban, account.Bic, account.Currency, account.HeldCents, account.OverdraftLimitCents,
+					)
+				}
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewBankAccount(st)
+
+			got, err := r.FindByIbans(context.Background(), []string{"Iban", "Iban2"})
+
+			assert.Equal(t, tc.pgxResult, got, "FindByIbans() got = %v, want %v", got, tc.pgxResult)
+			assert.ErrorIsf(t, err, tc.err, "FindByIbans() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("FindByIbans() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestBankAccount_FindByID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		pgxResult *model.BankAccount
+		pgxErr    error
+		err       error
+	}{
+		{
+			name: "account found",
+			pgxResult: &model.BankAccount{
+				ID: 1,
+				BankAccountState: model.BankAccountState{
+					OrganizationName: "OrganizationName",
+					BalanceCents:     1000000,
+					Iban:             "Iban",
+					Bic:              "Bic",
+					Currency:         "EUR",
+				},
+			},
+		},
+		{
+			name:   "account does not exists",
+			pgxErr: sql.ErrNoRows,
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:   "db error when finding account",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, organization_name, balance_cents, iban, bic, currency, held_cents, overdraft_limit_cents 
+				FROM bank_accounts  
+				WHERE id = $1
+			`).
+				WithArgs(model.BankAccountID(1))
+
+			if tc.pgxResult != nil {
+				rows := sqlmock.NewRows([]string{
+					"id", "organization_name", "balance_cents", "iban", "bic", "currency", "held_cents", "overdraft_limit_cents",
+				})
+
+				rows.AddRow(
+					tc.pgxResult.ID, tc.pgxResult.OrganizationName, tc.pgxResult.BalanceCents, tc.pgxResult.Iban, tc.pgxResult.Bic, tc.pgxResult.Currency, tc.pgxResult.HeldCents, tc.pgxResult.OverdraftLimitCents,
+				)
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewBankAccount(st)
+
+			got, err := r.FindByID(context.Background(), 1)
+
+			assert.Equal(t, tc.pgxResult, got, "FindByID() got = %v, want %v", got, tc.pgxResult)
+			assert.ErrorIsf(t, err, tc.err, "FindByID() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("FindByID() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestBankAccount_FindByIbanBic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		pgxResult *model.BankAccount
+		pgxErr    error
+		err       error
+	}{
+		{
+			name: "account found",
+			pgxResult: &model.BankAccount{
+				ID: 1,
+				BankAccountState: model.BankAccountState{
+					OrganizationName: "OrganizationName",
+					BalanceCents:     1000000,
+					Iban:             "Iban",
+					Bic:              "Bic",
+					Currency:         "EUR",
+				},
+			},
+		},
+		{
+			name:   "account does not exists",
+			pgxErr: sql.ErrNoRows,
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:   "db error when finding account",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, organization_name, balance_cents, iban, bic, currency, held_cents, overdraft_limit_cents 
+				FROM bank_accounts  
+				WHERE iban = $1 AND bic = $2
+			`).
+				WithArgs("Iban", "Bic")
+
+			if tc.pgxResult != nil {
+				rows := sqlmock.NewRows([]string{
+					"id", "organization_name", "balance_cents", "iban", "bic", "currency", "held_cents", "overdraft_limit_cents",
+				})
+
+				rows.AddRow(
+					tc.pgxResult.ID, tc.pgxResult.OrganizationName, tc.pgxResult.BalanceCents, tc.pgxResult.Iban, tc.pgxResult.Bic, tc.pgxResult.Currency, tc.pgxResult.HeldCents, tc.pgxResult.OverdraftLimitCents,
+				)
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewBankAccount(st)
+
+			got, err := r.FindByIbanBic(context.Background(), "Iban", "Bic")
+
+			assert.Equal(t, tc.pgxResult, got, "FindByIbanBic() got = %v, want %v", got, tc.pgxResult)
+			assert.ErrorIsf(t, err, tc.err, "FindByIbanBic() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("FindByIbanBic() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestBankAccount_Lock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		pgxResult *model.BankAccount
+		pgxErr    error
+		err       error
+	}{
+		{
+			name: "account locked",
+			pgxResult: &model.BankAccount{
+				ID: 1,
+				BankAccountState: model.BankAccountState{
+					OrganizationName: "OrganizationName",
+					BalanceCents:     1000000,
+					Iban:             "Iban",
+					Bic:              "Bic",
+					Currency:         "EUR",
+				},
+			},
+		},
+		{
+			name:   "account does not exists",
+			pgxErr: sql.ErrNoRows,
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:   "db error when locking account",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, organization_name, balance_cents, iban, bic, currency, held_cents, overdraft_limit_cents 
+				FROM bank_accounts  
+				WHERE id = $1 FOR UPDATE
+			`).
+				WithArgs(model.BankAccountID(1))
+
+			if tc.pgxResult != nil {
+				rows := sqlmock.NewRows([]string{
+					"id", "organization_name", "balance_cents", "iban", "bic", "currency", "held_cents", "overdraft_limit_cents",
+				})
+
+				rows.AddRow(
+					tc.pgxResult.ID, tc.pgxResult.OrganizationName, tc.pgxResult.BalanceCents, tc.pgxResult.Iban, tc.pgxResult.Bic, tc.pgxResult.Currency, tc.pgxResult.HeldCents, tc.pgxResult.OverdraftLimitCents,
+				)
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewBankAccount(st)
+
+			got, err := r.Lock(context.Background(), 1)
+
+			assert.Equal(t, tc.pgxResult, got, "Lock() got = %v, want %v", got, tc.pgxResult)
+			assert.ErrorIsf(t, err, tc.err, "Lock() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Lock() expectations were not met = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const holdTable = "holds"
+
+// Hold represents a Hold repository.
+type Hold struct {
+	storage *sqluct.Storage
+
+	colID            string
+	colBankAccountID string
+	colStatus        string
+	colCapturedCents string
+	colTransactionID string
+	colExpiresAt     string
+	colUpdatedAt     string
+}
+
+// NewHold returns instance of Hold.
+func NewHold(storage *sqluct.Storage) *Hold {
+	var hold model.Hold
+
+	return &Hold{
+		storage:          storage,
+		colID:            storage.Mapper.Col(&hold, &hold.ID),
+		colBankAccountID: storage.Mapper.Col(&hold, &hold.BankAccountID),
+		colStatus:        storage.Mapper.Col(&hold, &hold.Status),
+		colCapturedCents: storage.Mapper.Col(&hold, &hold.CapturedCents),
+		colTransactionID: storage.Mapper.Col(&hold, &hold.TransactionID),
+		colExpiresAt:     storage.Mapper.Col(&hold, &hold.ExpiresAt),
+		colUpdatedAt:     storage.Mapper.Col(&hold, &hold.UpdatedAt),
+	}
+}
+
+// Add adds the hold to the storage.
+func (r *Hold) Add(ctx context.Context, holdState model.HoldState) (*model.Hold, error) {
+	errMsg := "storage.Hold: failed to add hold"
+
+	hold := model.Hold{
+		HoldState: holdState,
+	}
+
+	q := r.storage.InsertStmt(holdTable, hold, sqluct.SkipZeroValues).
+		Suffix("RETURNING " + r.colID)
+
+	if err := r.storage.Select(ctx, q, &hold.ID); err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &hold, nil
+}
+
+// Find finds the hold of the bank account from a storage.
+//
+// Within a transaction, the hold is locked until the transaction ends.
+func (r *Hold) Find(ctx context.Context, bankAccountID model.BankAccountID, id model.HoldID) (*model.Hold, error) {
+	errMsg := "storage.Hold: failed to find hold"
+
+	var hold model.Hold
+
+	q := r.storage.SelectStmt(holdTable, hold).
+		Where(squirrel.Eq{r.colBankAccountID: bankAccountID}).
+		Where(squirrel.Eq{r.colID: id})
+
+	if tx := sqluct.TxFromContext(ctx); tx != nil {
+		q = q.Suffix("FOR UPDATE")
+	}
+
+	err := r.storage.Select(ctx, q, &hold)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg, "hold_id", id)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"hold_id", id,
+		)
+	}
+
+	return &hold, nil
+}
+
+// FindExpired finds the active hold which expiry is the earliest, reached at the time.
+//
+// Returns nil when there is no expired hold. The hold is not locked, the bank account is to be locked first.
+func (r *Hold) FindExpired(ctx context.Context, now time.Time) (*model.Hold, error) {
+	errMsg := "storage.Hold: failed to find expired hold"
+
+	var hold model.Hold
+
+	q := r.storage.SelectStmt(holdTable, hold).
+		Where(squirrel.Eq{r.colStatus: model.HoldActive}).
+		Where(squirrel.LtOrEq{r.colExpiresAt: now}).
+		OrderBy(r.colExpiresAt, r.colID).
+		Limit(1)
+
+	err := r.storage.Select(ctx, q, &hold)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &hold, nil
+}
+
+// Update stores the status and the capture of the hold.
+func (r *Hold) Update(ctx context.Context, hold model.Hold) error {
+	errMsg := "storage.Hold: failed to update hold"
+
+	q := r.storage.UpdateStmt(holdTable, nil).
+		Set(r.colStatus, hold.Status).
+		Set(r.colCapturedCents, hold.CapturedCents).
+		Set(r.colTransactionID, hold.TransactionID).
+		Set(r.colUpdatedAt, hold.UpdatedAt).
+		Where(squirrel.Eq{r.colID: hold.ID})
+
+	if _, err := r.storage.Exec(ctx, q); err != nil {
+		return ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"hold_id", hold.ID,
+		)
+	}
+
+	return nil
+}
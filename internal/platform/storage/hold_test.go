@@ -0,0 +1,279 @@
+package storage_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var holdColumns = []string{
+	"id", "bank_account_id", "amount_cents", "currency", "counterparty_name", "description", "status", "captured_cents",
+	"transaction_id", "expires_at", "created_at", "updated_at",
+}
+
+func holdRow(rows *sqlmock.Rows, hold model.Hold) *sqlmock.Rows {
+	return rows.AddRow(
+		hold.ID, hold.BankAccountID, hold.AmountCents, hold.Currency, hold.CounterpartyName, hold.Description,
+		hold.Status, hold.CapturedCents, hold.TransactionID, hold.ExpiresAt, hold.CreatedAt, hold.UpdatedAt,
+	)
+}
+
+func testHold(id model.HoldID) model.Hold {
+	now := time.Date(2021, 12, 13, 9, 0, 0, 0, time.UTC)
+
+	return model.Hold{
+		ID: id,
+		HoldState: model.HoldState{
+			BankAccountID:    1,
+			AmountCents:      4500,
+			Currency:         "EUR",
+			CounterpartyName: "Carrot Shop",
+			Description:      "Card payment",
+			Status:           model.HoldActive,
+			ExpiresAt:        now.Add(7 * 24 * time.Hour),
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		},
+	}
+}
+
+func TestHold_Add(t *testing.T) {
+	t.Parallel()
+
+	hold := testHold(1)
+
+	tests := []struct {
+		name   string
+		pgxErr error
+		want   *model.Hold
+		err    error
+	}{
+		{
+			name: "hold added successfully",
+			want: &hold,
+		},
+		{
+			name:   "db error when adding hold",
+			pgxErr: sql.ErrTxDone,
+			err:    sql.ErrTxDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				INSERT INTO holds (bank_account_id,amount_cents,currency,counterparty_name,description,status,expires_at,created_at,updated_at)
+				VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+				RETURNING id
+			`).
+				WithArgs(
+					hold.BankAccountID, hold.AmountCents, hold.Currency, hold.CounterpartyName, hold.Description, hold.Status,
+					hold.ExpiresAt, hold.CreatedAt, hold.UpdatedAt,
+				)
+
+			if tc.pgxErr != nil {
+				meQuery.WillReturnError(tc.pgxErr)
+			} else {
+				meQuery.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(hold.ID))
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewHold(st).Add(context.Background(), hold.HoldState)
+
+			assert.Equal(t, tc.want, got, "Add() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "Add() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Add() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestHold_Find(t *testing.T) {
+	t.Parallel()
+
+	hold := testHold(1)
+
+	tests := []struct {
+		name   string
+		found  bool
+		pgxErr error
+		want   *model.Hold
+		err    error
+	}{
+		{
+			name:  "hold found",
+			found: true,
+			want:  &hold,
+		},
+		{
+			name:   "hold not found",
+			pgxErr: sql.ErrNoRows,
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:   "db error when finding hold",
+			pgxErr: sql.ErrConnDone,
+			err:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			mock.ExpectBegin()
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, bank_account_id, amount_cents, currency, counterparty_name, description, status, captured_cents,
+					transaction_id, expires_at, created_at, updated_at
+				FROM holds
+				WHERE bank_account_id = $1 AND id = $2
+				FOR UPDATE
+			`).
+				WithArgs(hold.BankAccountID, hold.ID)
+
+			if tc.found {
+				meQuery.WillReturnRows(holdRow(sqlmock.NewRows(holdColumns), hold))
+				mock.ExpectCommit()
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+				mock.ExpectRollback()
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			var got *model.Hold
+
+			err = st.InTx(context.Background(), func(ctx context.Context) error {
+				got, err = storage.NewHold(st).Find(ctx, hold.BankAccountID, hold.ID)
+
+				return err
+			})
+
+			assert.Equal(t, tc.want, got, "Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "Find() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Find() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestHold_FindExpired(t *testing.T) {
+	t.Parallel()
+
+	hold := testHold(1)
+	now := hold.ExpiresAt
+
+	tests := []struct {
+		name   string
+		found  bool
+		pgxErr error
+		want   *model.Hold
+		err    error
+	}{
+		{
+			name:  "expired hold found",
+			found: true,
+			want:  &hold,
+		},
+		{
+			name:   "no expired hold",
+			pgxErr: sql.ErrNoRows,
+		},
+		{
+			name:   "db error when finding expired hold",
+			pgxErr: sql.ErrConnDone,
+			err:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, bank_account_id, amount_cents, currency, counterparty_name, description, status, captured_cents,
+					transaction_id, expires_at, created_at, updated_at
+				FROM holds
+				WHERE status = $1 AND expires_at <= $2
+				ORDER BY expires_at, id LIMIT 1
+			`).
+				WithArgs(model.HoldActive, now)
+
+			if tc.found {
+				meQuery.WillReturnRows(holdRow(sqlmock.NewRows(holdColumns), hold))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewHold(st).FindExpired(context.Background(), now)
+
+			assert.Equal(t, tc.want, got, "FindExpired() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "FindExpired() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("FindExpired() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestHold_Update(t *testing.T) {
+	t.Parallel()
+
+	hold := testHold(1)
+	hold.Status = model.HoldCaptured
+	hold.CapturedCents = 4000
+	hold.TransactionID = 7
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+
+	mock.ExpectExec(`
+		UPDATE holds SET status = $1, captured_cents = $2, transaction_id = $3, updated_at = $4 WHERE id = $5
+	`).
+		WithArgs(hold.Status, hold.CapturedCents, hold.TransactionID, hold.UpdatedAt, hold.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	err = storage.NewHold(st).Update(context.Background(), hold)
+	assert.NoError(t, err, "Update() got error = %v", err)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Update() expectations were not met = %v", err)
+	}
+}
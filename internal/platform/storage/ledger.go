@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const (
+	journalEntryTable = "journal_entries"
+	postingTable      = "postings"
+)
+
+// Ledger represents a Ledger repository.
+//
+// The ledger is append-only, journal entries and postings are never updated nor deleted.
+type Ledger struct {
+	storage *sqluct.Storage
+
+	colJournalEntryID      string
+	colPostingJournalEntry string
+	colLedgerAccount       string
+	colBankAccountID       string
+	colAmountCents         string
+	colCurrency            string
+}
+
+// NewLedger returns instance of Ledger.
+func NewLedger(storage *sqluct.Storage) *Ledger {
+	var (
+		journalEntry model.JournalEntry
+		posting      model.Posting
+	)
+
+	return &Ledger{
+		storage:                storage,
+		colJournalEntryID:      storage.Mapper.Col(&journalEntry, &journalEntry.ID),
+		colPostingJournalEntry: storage.Mapper.Col(&posting, &posting.JournalEntryID),
+		colLedgerAccount:       storage.Mapper.Col(&posting, &posting.LedgerAccount),
+		colBankAccountID:       storage.Mapper.Col(&posting, &posting.BankAccountID),
+		colAmountCents:         storage.Mapper.Col(&posting, &posting.AmountCents),
+		colCurrency:            storage.Mapper.Col(&posting, &posting.Currency),
+	}
+}
+
+// Post adds the journal entry with its postings to the storage.
+//
+// Returns model.ErrUnbalancedJournal when the postings do not balance.
+func (r *Ledger) Post(ctx context.Context, journalEntryState model.JournalEntryState, postingStates []model.PostingState) (model.JournalEntryID, error) {
+	errMsg := "storage.Ledger: failed to post journal entry"
+
+	if err := model.ValidateJournal(postingStates); err != nil {
+		return 0, ctxd.WrapError(ctx, err, errMsg)
+	}
+
+	journalEntry := model.JournalEntry{
+		JournalEntryState: journalEntryState,
+	}
+
+	q := r.storage.InsertStmt(journalEntryTable, journalEntry, sqluct.SkipZeroValues).
+		Suffix("RETURNING " + r.colJournalEntryID)
+
+	if err := r.storage.Select(ctx, q, &journalEntry.ID); err != nil {
+		return 0, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	postings := make([]model.Posting, len(postingStates))
+
+	for i, state := range postingStates {
+		postings[i].PostingState = state
+		postings[i].JournalEntryID = journalEntry.ID
+	}
+
+	if _, err := r.storage.Exec(ctx, r.storage.InsertStmt(postingTable, postings, sqluct.SkipZeroValues)); err != nil {
+		return 0, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return journalEntry.ID, nil
+}
+
+// BalanceDiscrepancies finds the bank accounts which balance differs from the sum of their postings.
+func (r *Ledger) BalanceDiscrepancies(ctx context.Context) ([]model.BalanceDiscrepancy, error) {
+	errMsg := "storage.Ledger: failed to find balance discrepancies"
+
+	var bankAccount model.BankAccount
+
+	colID := r.storage.Mapper.Col(&bankAccount, &bankAccount.ID)
+	colBalanceCents := r.storage.Mapper.Col(&bankAccount, &bankAccount.BalanceCents)
+
+	postingsCents := fmt.Sprintf("COALESCE(SUM(p.%s), 0)", r.colAmountCents)
+
+	q := r.storage.QueryBuilder().
+		Select(
+			"b."+colID+" AS bank_account_id",
+			"b."+colBalanceCents,
+			postingsCents+" AS postings_cents",
+		).
+		From(bankAccountTable+" b").
+		LeftJoin(fmt.Sprintf(
+			"%s p ON p.%s = b.%s AND p.%s = '%s'",
+			postingTable,
+			r.colBankAccountID,
+			colID,
+			r.colLedgerAccount,
+			model.LedgerAccountBankAccount,
+		)).
+		GroupBy("b."+colID, "b."+colBalanceCents).
+		Having(fmt.Sprintf("b.%s <> %s", colBalanceCents, postingsCents)).
+		OrderBy("b." + colID)
+
+	var discrepancies []model.BalanceDiscrepancy
+
+	if err := r.storage.Select(ctx, q, &discrepancies); err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return discrepancies, nil
+}
+
+// UnbalancedJournalEntries finds the journal entries which postings do not balance.
+func (r *Ledger) UnbalancedJournalEntries(ctx context.Context) ([]model.JournalEntryID, error) {
+	errMsg := "storage.Ledger: failed to find unbalanced journal entries"
+
+	q := r.storage.QueryBuilder().
+		Select(r.colPostingJournalEntry).
+		Distinct().
+		From(postingTable).
+		GroupBy(r.colPostingJournalEntry, r.colCurrency).
+		Having(squirrel.NotEq{"SUM(" + r.colAmountCents + ")": 0}).
+		OrderBy(r.colPostingJournalEntry)
+
+	var ids []model.JournalEntryID
+
+	if err := r.storage.Select(ctx, q, &ids); err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return ids, nil
+}
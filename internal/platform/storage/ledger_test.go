@@ -0,0 +1,188 @@
+package storage_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedger_Post(t *testing.T) {
+	t.Parallel()
+
+	journalEntryState := model.JournalEntryState{
+		TransferBulkID: 1,
+		Description:    "Transfer bulk",
+	}
+
+	balanced := []model.PostingState{
+		{
+			LedgerAccount: model.LedgerAccountBankAccount,
+			BankAccountID: 1,
+			AmountCents:   -1500,
+			Currency:      "EUR",
+		},
+		{
+			LedgerAccount: model.LedgerAccountOutgoingTransfers,
+			TransactionID: 1,
+			AmountCents:   1500,
+			Currency:      "EUR",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		postings []model.PostingState
+		want     model.JournalEntryID
+		wantErr  bool
+		noQuery  bool
+		pgxErr   error
+		err      error
+	}{
+		{
+			name:     "journal entry posted successfully",
+			postings: balanced,
+			want:     1,
+			wantErr:  false,
+			pgxErr:   nil,
+			err:      nil,
+		},
+		{
+			name:     "unbalanced journal entry",
+			postings: balanced[:1],
+			want:     0,
+			wantErr:  true,
+			noQuery:  true,
+			pgxErr:   nil,
+			err:      model.ErrUnbalancedJournal,
+		},
+		{
+			name:     "db error when posting journal entry",
+			postings: balanced,
+			want:     0,
+			wantErr:  true,
+			pgxErr:   sql.ErrTxDone,
+			err:      ctxd.WrapError(context.Background(), sql.ErrTxDone, "storage.Ledger: failed to post journal entry"),
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			if !tc.noQuery {
+				meQuery := mock.ExpectQuery(`
+					INSERT INTO journal_entries (transfer_bulk_id,description)
+					VALUES ($1,$2)
+					RETURNING id
+				`).
+					WithArgs(journalEntryState.TransferBulkID, journalEntryState.Description)
+
+				if tc.pgxErr == nil {
+					meQuery.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+					mock.ExpectExec(`
+						INSERT INTO postings (journal_entry_id,ledger_account,bank_account_id,transaction_id,amount_cents,currency)
+						VALUES ($1,$2,$3,$4,$5,$6),($7,$8,$9,$10,$11,$12)
+					`).
+						WithArgs(
+							1, tc.postings[0].LedgerAccount, tc.postings[0].BankAccountID, tc.postings[0].TransactionID, tc.postings[0].AmountCents, tc.postings[0].Currency,
+							1, tc.postings[1].LedgerAccount, tc.postings[1].BankAccountID, tc.postings[1].TransactionID, tc.postings[1].AmountCents, tc.postings[1].Currency,
+						).
+						WillReturnResult(sqlmock.NewResult(0, 2))
+				} else {
+					meQuery.WillReturnError(tc.pgxErr)
+				}
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewLedger(st)
+
+			got, err := r.Post(context.Background(), journalEntryState, tc.postings)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Post() error = %v, wantErr %v", err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.want, got, "Post() got = %v, want %v", got, tc.want)
+
+			assert.ErrorIsf(t, err, tc.err, "Post() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Post() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestLedger_BalanceDiscrepancies(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`
+		SELECT b.id AS bank_account_id, b.balance_cents, COALESCE(SUM(p.amount_cents), 0) AS postings_cents
+		FROM bank_accounts b
+		LEFT JOIN postings p ON p.bank_account_id = b.id AND p.ledger_account = 'bank_account'
+		GROUP BY b.id, b.balance_cents
+		HAVING b.balance_cents <> COALESCE(SUM(p.amount_cents), 0)
+		ORDER BY b.id
+	`).
+		WillReturnRows(sqlmock.NewRows([]string{"bank_account_id", "balance_cents", "postings_cents"}).AddRow(2, 1000, 900))
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	r := storage.NewLedger(st)
+
+	got, err := r.BalanceDiscrepancies(context.Background())
+	assert.NoError(t, err, "BalanceDiscrepancies() got error = %v", err)
+
+	assert.Equal(t, []model.BalanceDiscrepancy{{BankAccountID: 2, BalanceCents: 1000, PostingsCents: 900}}, got)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("BalanceDiscrepancies() expectations were not met = %v", err)
+	}
+}
+
+func TestLedger_UnbalancedJournalEntries(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`
+		SELECT DISTINCT journal_entry_id
+		FROM postings
+		GROUP BY journal_entry_id, currency
+		HAVING SUM(amount_cents) <> $1
+		ORDER BY journal_entry_id
+	`).
+		WithArgs(0).
+		WillReturnError(errRowsClosed)
+
+	st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+	r := storage.NewLedger(st)
+
+	got, err := r.UnbalancedJournalEntries(context.Background())
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, errRowsClosed)
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("UnbalancedJournalEntries() expectations were not met = %v", err)
+	}
+}
@@ -0,0 +1,285 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const (
+	standingOrderTable          = "standing_orders"
+	standingOrderExecutionTable = "standing_order_executions"
+)
+
+// StandingOrder represents a StandingOrder repository, along with the executions of the standing orders.
+type StandingOrder struct {
+	storage *sqluct.Storage
+
+	colID                string
+	colOrgIban           string
+	colAmountCents       string
+	colCurrency          string
+	colCounterpartyName  string
+	colCounterpartyIban  string
+	colCounterpartyBic   string
+	colDescription       string
+	colEndDate           string
+	colCount             string
+	colStatus            string
+	colNextExecutionDate string
+	colExecutionCount    string
+	colUpdatedAt         string
+
+	colExecutionID              string
+	colExecutionStandingOrderID string
+	colExecutionDate            string
+	colExecutionStatus          string
+	colExecutionJobID           string
+	colExecutionCreatedAt       string
+}
+
+// NewStandingOrder returns instance of StandingOrder.
+func NewStandingOrder(storage *sqluct.Storage) *StandingOrder {
+	var (
+		order     model.StandingOrder
+		execution model.StandingOrderExecution
+	)
+
+	return &StandingOrder{
+		storage:              storage,
+		colID:                storage.Mapper.Col(&order, &order.ID),
+		colOrgIban:           storage.Mapper.Col(&order, &order.OrganizationIban),
+		colAmountCents:       storage.Mapper.Col(&order, &order.AmountCents),
+		colCurrency:          storage.Mapper.Col(&order, &order.Currency),
+		colCounterpartyName:  storage.Mapper.Col(&order, &order.CounterpartyName),
+		colCounterpartyIban:  storage.Mapper.Col(&order, &order.CounterpartyIban),
+		colCounterpartyBic:   storage.Mapper.Col(&order, &order.CounterpartyBic),
+		colDescription:       storage.Mapper.Col(&order, &order.Description),
+		colEndDate:           storage.Mapper.Col(&order, &order.EndDate),
+		colCount:             storage.Mapper.Col(&order, &order.Count),
+		colStatus:            storage.Mapper.Col(&order, &order.Status),
+		colNextExecutionDate: storage.Mapper.Col(&order, &order.NextExecutionDate),
+		colExecutionCount:    storage.Mapper.Col(&order, &order.ExecutionCount),
+		colUpdatedAt:         storage.Mapper.Col(&order, &order.UpdatedAt),
+
+		colExecutionID:              storage.Mapper.Col(&execution, &execution.ID),
+		colExecutionStandingOrderID: storage.Mapper.Col(&execution, &execution.StandingOrderID),
+		colExecutionDate:            storage.Mapper.Col(&execution, &execution.ExecutionDate),
+		colExecutionStatus:          storage.Mapper.Col(&execution, &execution.Status),
+		colExecutionJobID:           storage.Mapper.Col(&execution, &execution.TransferBulkJobID),
+		colExecutionCreatedAt:       storage.Mapper.Col(&execution, &execution.CreatedAt),
+	}
+}
+
+// Add adds the standing order to the storage.
+func (r *StandingOrder) Add(ctx context.Context, orderState model.StandingOrderState) (*model.StandingOrder, error) {
+	errMsg := "storage.StandingOrder: failed to add standing order"
+
+	order := model.StandingOrder{
+		StandingOrderState: orderState,
+	}
+
+	q := r.storage.InsertStmt(standingOrderTable, order, sqluct.SkipZeroValues).
+		Suffix("RETURNING " + r.colID)
+
+	if err := r.storage.Select(ctx, q, &order.ID); err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &order, nil
+}
+
+// Find finds the standing order from a storage.
+//
+// Within a transaction, the standing order is locked until the transaction ends.
+func (r *StandingOrder) Find(ctx context.Context, id model.StandingOrderID) (*model.StandingOrder, error) {
+	errMsg := "storage.StandingOrder: failed to find standing order"
+
+	var order model.StandingOrder
+
+	q := r.storage.SelectStmt(standingOrderTable, order).
+		Where(squirrel.Eq{r.colID: id})
+
+	if tx := sqluct.TxFromContext(ctx); tx != nil {
+		q = q.Suffix("FOR UPDATE")
+	}
+
+	err := r.storage.Select(ctx, q, &order)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg, "standing_order_id", id)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"standing_order_id", id,
+		)
+	}
+
+	return &order, nil
+}
+
+// List lists the standing orders of the bank account, but the cancelled ones.
+func (r *StandingOrder) List(ctx context.Context, organizationIban string) ([]model.StandingOrder, error) {
+	errMsg := "storage.StandingOrder: failed to list standing orders"
+
+	var orders []model.StandingOrder
+
+	q := r.storage.SelectStmt(standingOrderTable, model.StandingOrder{}).
+		Where(squirrel.Eq{r.colOrgIban: organizationIban}).
+		Where(squirrel.NotEq{r.colStatus: model.StandingOrderCancelled}).
+		OrderBy(r.colID)
+
+	if err := r.storage.Select(ctx, q, &orders); err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"organization_iban", organizationIban,
+		)
+	}
+
+	return orders, nil
+}
+
+// Update stores the credit transfer, the end of the recurrence and the schedule of the standing order.
+func (r *StandingOrder) Update(ctx context.Context, order model.StandingOrder) error {
+	errMsg := "storage.StandingOrder: failed to update standing order"
+
+	q := r.storage.UpdateStmt(standingOrderTable, nil).
+		Set(r.colAmountCents, order.AmountCents).
+		Set(r.colCurrency, order.Currency).
+		Set(r.colCounterpartyName, order.CounterpartyName).
+		Set(r.colCounterpartyIban, order.CounterpartyIban).
+		Set(r.colCounterpartyBic, order.CounterpartyBic).
+		Set(r.colDescription, order.Description).
+		Set(r.colEndDate, order.EndDate).
+		Set(r.colCount, order.Count).
+		Set(r.colStatus, order.Status).
+		Set(r.colNextExecutionDate, order.NextExecutionDate).
+		Set(r.colExecutionCount, order.ExecutionCount).
+		Set(r.colUpdatedAt, order.UpdatedAt).
+		Where(squirrel.Eq{r.colID: order.ID})
+
+	if _, err := r.storage.Exec(ctx, q); err != nil {
+		return ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"standing_order_id", order.ID,
+		)
+	}
+
+	return nil
+}
+
+// ClaimDue finds the active standing order which next execution date is the earliest, not after today.
+//
+// Returns nil when there is no standing order to execute. Within a transaction, the standing order is locked until the
+// transaction ends, standing orders locked by a concurrent claim are skipped.
+func (r *StandingOrder) ClaimDue(ctx context.Context, today time.Time) (*model.StandingOrder, error) {
+	errMsg := "storage.StandingOrder: failed to claim standing order"
+
+	var order model.StandingOrder
+
+	q := r.storage.SelectStmt(standingOrderTable, order).
+		Where(squirrel.Eq{r.colStatus: model.StandingOrderActive}).
+		Where(squirrel.LtOrEq{r.colNextExecutionDate: today}).
+		OrderBy(r.colNextExecutionDate, r.colID).
+		Limit(1)
+
+	if tx := sqluct.TxFromContext(ctx); tx != nil {
+		q = q.Suffix("FOR UPDATE SKIP LOCKED")
+	}
+
+	err := r.storage.Select(ctx, q, &order)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &order, nil
+}
+
+// AddExecution adds the execution of the standing order to the storage.
+func (r *StandingOrder) AddExecution(ctx context.Context, executionState model.StandingOrderExecutionState) error {
+	errMsg := "storage.StandingOrder: failed to add standing order execution"
+
+	execution := model.StandingOrderExecution{
+		StandingOrderExecutionState: executionState,
+	}
+
+	if _, err := r.storage.Exec(ctx, r.storage.InsertStmt(standingOrderExecutionTable, execution, sqluct.SkipZeroValues)); err != nil {
+		return ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"standing_order_id", executionState.StandingOrderID,
+		)
+	}
+
+	return nil
+}
+
+// ListExecutions lists the executions of the standing order by execution date, with the outcome of their transfer
+// bulk jobs.
+func (r *StandingOrder) ListExecutions(ctx context.Context, id model.StandingOrderID) ([]model.StandingOrderExecutionOutcome, error) {
+	errMsg := "storage.StandingOrder: failed to list standing order executions"
+
+	var (
+		executions []model.StandingOrderExecutionOutcome
+		job        model.TransferBulkJob
+	)
+
+	colJobID := r.storage.Mapper.Col(&job, &job.ID)
+	colJobStatus := r.storage.Mapper.Col(&job, &job.Status)
+	colJobTransferBulkID := r.storage.Mapper.Col(&job, &job.TransferBulkID)
+	colJobError := r.storage.Mapper.Col(&job, &job.Error)
+
+	q := r.storage.QueryBuilder().
+		Select(
+			"e."+r.colExecutionID,
+			"e."+r.colExecutionStandingOrderID,
+			"e."+r.colExecutionDate,
+			"e."+r.colExecutionStatus,
+			"e."+r.colExecutionJobID,
+			"e."+r.colExecutionCreatedAt,
+			"COALESCE(j."+colJobStatus+", '') AS job_status",
+			"COALESCE(j."+colJobTransferBulkID+", 0) AS transfer_bulk_id",
+			"COALESCE(j."+colJobError+", '') AS error",
+		).
+		From(standingOrderExecutionTable + " e").
+		LeftJoin(transferBulkJobTable + " j ON j." + colJobID + " = e." + r.colExecutionJobID).
+		Where(squirrel.Eq{"e." + r.colExecutionStandingOrderID: id}).
+		OrderBy("e." + r.colExecutionDate)
+
+	if err := r.storage.Select(ctx, q, &executions); err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"standing_order_id", id,
+		)
+	}
+
+	return executions, nil
+}
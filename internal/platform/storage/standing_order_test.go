@@ -0,0 +1,378 @@
+package storage_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var standingOrderColumns = []string{
+	"id", "organization_name", "organization_iban", "organization_bic", "status", "next_execution_date", "execution_count",
+	"created_by", "created_at", "updated_at", "amount_cents", "currency", "counterparty_name", "counterparty_iban", "counterparty_bic",
+	"description", "frequency", "day_of_month", "start_date", "end_date", "count",
+}
+
+func standingOrderRow(rows *sqlmock.Rows, order model.StandingOrder) *sqlmock.Rows {
+	return rows.AddRow(
+		order.ID, order.OrganizationName, order.OrganizationIban, order.OrganizationBic, order.Status,
+		order.NextExecutionDate, order.ExecutionCount, order.CreatedBy, order.CreatedAt, order.UpdatedAt, order.AmountCents, order.Currency,
+		order.CounterpartyName, order.CounterpartyIban, order.CounterpartyBic, order.Description, order.Frequency,
+		order.DayOfMonth, order.StartDate, order.EndDate, order.Count,
+	)
+}
+
+func testStandingOrder(id model.StandingOrderID) model.StandingOrder {
+	now := time.Date(2021, 12, 1, 9, 0, 0, 0, time.UTC)
+	next := time.Date(2021, 12, 5, 0, 0, 0, 0, time.UTC)
+
+	return model.StandingOrder{
+		ID: id,
+		StandingOrderState: model.StandingOrderState{
+			OrganizationName: "ACME Corp",
+			OrganizationIban: "FR10474608000002006107XXXXX",
+			OrganizationBic:  "OIVUSCLQXXX",
+			StandingOrderTransfer: model.StandingOrderTransfer{
+				AmountCents:      120000,
+				Currency:         "EUR",
+				CounterpartyName: "Landlord",
+				CounterpartyIban: "EE382200221020145685",
+				CounterpartyBic:  "CRLYFRPPTOU",
+				Description:      "Rent",
+			},
+			StandingOrderRecurrence: model.StandingOrderRecurrence{
+				Frequency:  model.StandingOrderMonthly,
+				DayOfMonth: 5,
+				StartDate:  time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+			},
+			Status:            model.StandingOrderActive,
+			NextExecutionDate: &next,
+			CreatedBy:         "alice",
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		},
+	}
+}
+
+func TestStandingOrder_Add(t *testing.T) {
+	t.Parallel()
+
+	order := testStandingOrder(1)
+
+	tests := []struct {
+		name   string
+		pgxErr error
+		want   *model.StandingOrder
+		err    error
+	}{
+		{
+			name: "standing order added successfully",
+			want: &order,
+		},
+		{
+			name:   "db error when adding standing order",
+			pgxErr: sql.ErrTxDone,
+			err:    sql.ErrTxDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				INSERT INTO standing_orders
+					(organization_name,organization_iban,organization_bic,status,next_execution_date,created_by,created_at,updated_at,amount_cents,currency,counterparty_name,counterparty_iban,counterparty_bic,description,frequency,day_of_month,start_date)
+				VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17)
+				RETURNING id
+			`).
+				WithArgs(
+					order.OrganizationName, order.OrganizationIban, order.OrganizationBic, order.Status, order.NextExecutionDate,
+					order.CreatedBy, order.CreatedAt, order.UpdatedAt, order.AmountCents, order.Currency, order.CounterpartyName,
+					order.CounterpartyIban, order.CounterpartyBic, order.Description, order.Frequency, order.DayOfMonth,
+					order.StartDate,
+				)
+
+			if tc.pgxErr != nil {
+				meQuery.WillReturnError(tc.pgxErr)
+			} else {
+				meQuery.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(order.ID))
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewStandingOrder(st).Add(context.Background(), order.StandingOrderState)
+
+			assert.Equal(t, tc.want, got, "Add() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "Add() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Add() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestStandingOrder_Find(t *testing.T) {
+	t.Parallel()
+
+	order := testStandingOrder(1)
+
+	tests := []struct {
+		name   string
+		found  bool
+		pgxErr error
+		want   *model.StandingOrder
+		err    error
+	}{
+		{
+			name:  "standing order found",
+			found: true,
+			want:  &order,
+		},
+		{
+			name:   "standing order not found",
+			pgxErr: sql.ErrNoRows,
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:   "db error when finding standing order",
+			pgxErr: sql.ErrConnDone,
+			err:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, organization_name, organization_iban, organization_bic, status, next_execution_date,
+					execution_count, created_by, created_at, updated_at, amount_cents, currency, counterparty_name, counterparty_iban,
+					counterparty_bic, description, frequency, day_of_month, start_date, end_date, count
+				FROM standing_orders
+				WHERE id = $1
+			`).
+				WithArgs(order.ID)
+
+			if tc.found {
+				meQuery.WillReturnRows(standingOrderRow(sqlmock.NewRows(standingOrderColumns), order))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewStandingOrder(st).Find(context.Background(), order.ID)
+
+			assert.Equal(t, tc.want, got, "Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "Find() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Find() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestStandingOrder_ClaimDue(t *testing.T) {
+	t.Parallel()
+
+	today := time.Date(2021, 12, 5, 0, 0, 0, 0, time.UTC)
+	order := testStandingOrder(1)
+
+	tests := []struct {
+		name   string
+		found  bool
+		pgxErr error
+		want   *model.StandingOrder
+		err    error
+	}{
+		{
+			name:  "standing order claimed",
+			found: true,
+			want:  &order,
+		},
+		{
+			name:   "no standing order to claim",
+			pgxErr: sql.ErrNoRows,
+		},
+		{
+			name:   "db error when claiming standing order",
+			pgxErr: sql.ErrConnDone,
+			err:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			mock.ExpectBegin()
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, organization_name, organization_iban, organization_bic, status, next_execution_date,
+					execution_count, created_by, created_at, updated_at, amount_cents, currency, counterparty_name, counterparty_iban,
+					counterparty_bic, description, frequency, day_of_month, start_date, end_date, count
+				FROM standing_orders
+				WHERE status = $1 AND next_execution_date <= $2
+				ORDER BY next_execution_date, id LIMIT 1 FOR UPDATE SKIP LOCKED
+			`).
+				WithArgs(model.StandingOrderActive, today)
+
+			if tc.found {
+				meQuery.WillReturnRows(standingOrderRow(sqlmock.NewRows(standingOrderColumns), order))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			if tc.err != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			var got *model.StandingOrder
+
+			err = st.InTx(context.Background(), func(ctx context.Context) error {
+				got, err = storage.NewStandingOrder(st).ClaimDue(ctx, today)
+
+				return err
+			})
+
+			assert.Equal(t, tc.want, got, "ClaimDue() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "ClaimDue() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("ClaimDue() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestStandingOrder_ListExecutions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 5, 9, 0, 0, 0, time.UTC)
+
+	executions := []model.StandingOrderExecutionOutcome{
+		{
+			StandingOrderExecution: model.StandingOrderExecution{
+				ID: 1,
+				StandingOrderExecutionState: model.StandingOrderExecutionState{
+					StandingOrderID:   1,
+					ExecutionDate:     time.Date(2021, 12, 5, 0, 0, 0, 0, time.UTC),
+					Status:            model.StandingOrderExecutionEnqueued,
+					TransferBulkJobID: 3,
+					CreatedAt:         now,
+				},
+			},
+			JobStatus:      model.TransferBulkJobCompleted,
+			TransferBulkID: 2,
+		},
+		{
+			StandingOrderExecution: model.StandingOrderExecution{
+				ID: 2,
+				StandingOrderExecutionState: model.StandingOrderExecutionState{
+					StandingOrderID: 1,
+					ExecutionDate:   time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC),
+					Status:          model.StandingOrderExecutionSkipped,
+					CreatedAt:       now,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		pgxErr error
+		want   []model.StandingOrderExecutionOutcome
+		err    error
+	}{
+		{
+			name: "standing order executions listed",
+			want: executions,
+		},
+		{
+			name:   "db error when listing standing order executions",
+			pgxErr: sql.ErrConnDone,
+			err:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT e.id, e.standing_order_id, e.execution_date, e.status, e.transfer_bulk_job_id, e.created_at,
+					COALESCE(j.status, '') AS job_status, COALESCE(j.transfer_bulk_id, 0) AS transfer_bulk_id,
+					COALESCE(j.error, '') AS error
+				FROM standing_order_executions e
+				LEFT JOIN transfer_bulk_jobs j ON j.id = e.transfer_bulk_job_id
+				WHERE e.standing_order_id = $1
+				ORDER BY e.execution_date
+			`).
+				WithArgs(model.StandingOrderID(1))
+
+			if tc.pgxErr != nil {
+				meQuery.WillReturnError(tc.pgxErr)
+			} else {
+				rows := sqlmock.NewRows([]string{
+					"id", "standing_order_id", "execution_date", "status", "transfer_bulk_job_id", "created_at",
+					"job_status", "transfer_bulk_id", "error",
+				})
+
+				for _, e := range executions {
+					rows.AddRow(
+						e.ID, e.StandingOrderID, e.ExecutionDate, e.Status, e.TransferBulkJobID, e.CreatedAt,
+						e.JobStatus, e.TransferBulkID, e.Error,
+					)
+				}
+
+				meQuery.WillReturnRows(rows)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewStandingOrder(st).ListExecutions(context.Background(), 1)
+
+			assert.Equal(t, tc.want, got, "ListExecutions() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "ListExecutions() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("ListExecutions() expectations were not met = %v", err)
+			}
+		})
+	}
+}
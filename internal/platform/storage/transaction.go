@@ -2,7 +2,12 @@ package storage
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/bool64/ctxd"
 	"github.com/bool64/sqluct"
 	"github.com/dohernandez/qonto/internal/domain/model"
@@ -10,20 +15,50 @@ import (
 
 const transactionTable = "transactions"
 
+// organizationUsageLock is the prefix of the advisory lock key of the usage of the limits of an organization.
+const organizationUsageLock = "organization_usage/"
+
 // Transaction represents a Transaction repository.
 type Transaction struct {
 	storage *sqluct.Storage
+
+	colID               string
+	colTransferBulkID   string
+	colBankAccountID    string
+	colCounterpartyName string
+	colCounterpartyIban string
+	colDebitedCents     string
+	colCreatedAt        string
+
+	colReversedTransactionID string
+	colExternalReference     string
+	colChargedTransactionID  string
 }
 
 // NewTransaction returns instance of Transaction.
 func NewTransaction(storage *sqluct.Storage) *Transaction {
+	var transaction model.Transaction
+
 	return &Transaction{
-		storage: storage,
+		storage:             storage,
+		colID:               storage.Mapper.Col(&transaction, &transaction.ID),
+		colTransferBulkID:   storage.Mapper.Col(&transaction, &transaction.TransferBulkID),
+		colBankAccountID:    storage.Mapper.Col(&transaction, &transaction.BankAccountID),
+		colCounterpartyName: storage.Mapper.Col(&transaction, &transaction.CounterpartyName),
+		colCounterpartyIban: storage.Mapper.Col(&transaction, &transaction.CounterpartyIban),
+		colDebitedCents:     storage.Mapper.Col(&transaction, &transaction.DebitedCents),
+		colCreatedAt:        storage.Mapper.Col(&transaction, &transaction.CreatedAt),
+
+		colReversedTransactionID: storage.Mapper.Col(&transaction, &transaction.ReversedTransactionID),
+		colExternalReference:     storage.Mapper.Col(&transaction, &transaction.ExternalReference),
+		colChargedTransactionID:  storage.Mapper.Col(&transaction, &transaction.ChargedTransactionID),
 	}
 }
 
 // Add adds transaction to the storage.
-func (r Transaction) Add(ctx context.Context, transactionStates []model.TransactionState) error {
+//
+// Returns the ids of the added transactions, in the same order as the given transaction states.
+func (r Transaction) Add(ctx context.Context, transactionStates []model.TransactionState) ([]model.TransactionID, error) {
 	errMsg := "storage.Transaction: failed to add transaction"
 
 	transactions := make([]model.Transaction, len(transactionStates))
@@ -32,16 +67,334 @@ func (r Transaction) Add(ctx context.Context, transactionStates []model.Transact
 		transactions[i].TransactionState = state
 	}
 
-	q := r.storage.InsertStmt(transactionTable, transactions, sqluct.SkipZeroValues)
+	q := r.storage.InsertStmt(transactionTable, transactions, sqluct.SkipZeroValues).
+		Suffix("RETURNING " + r.colID)
 
-	_, err := r.storage.Exec(ctx, q)
+	var ids []model.TransactionID
+
+	err := r.storage.Select(ctx, q, &ids)
 	if err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return ids, nil
+}
+
+// FindIDsByTransferBulk finds the ids of the transactions of the credit transfers added by the transfer bulk, not of
+// its fees.
+func (r Transaction) FindIDsByTransferBulk(ctx context.Context, transferBulkID model.TransferBulkID) ([]model.TransactionID, error) {
+	errMsg := "storage.Transaction: failed to find transactions by transfer bulk"
+
+	q := r.storage.QueryBuilder().
+		Select(r.colID).
+		From(transactionTable).
+		Where(squirrel.Eq{r.colTransferBulkID: transferBulkID}).
+		Where(squirrel.Eq{r.colChargedTransactionID: 0}).
+		OrderBy(r.colID)
+
+	var ids []model.TransactionID
+
+	err := r.storage.Select(ctx, q, &ids)
+	if err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return ids, nil
+}
+
+// FindByTransferBulk finds the transactions added by the transfer bulk, its fees included.
+func (r Transaction) FindByTransferBulk(ctx context.Context, transferBulkID model.TransferBulkID) ([]model.Transaction, error) {
+	errMsg := "storage.Transaction: failed to find transactions by transfer bulk"
+
+	q := r.storage.SelectStmt(transactionTable, model.Transaction{}).
+		Where(squirrel.Eq{r.colTransferBulkID: transferBulkID}).
+		OrderBy(r.colID)
+
+	var transactions []model.Transaction
+
+	err := r.storage.Select(ctx, q, &transactions)
+	if err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return transactions, nil
+}
+
+// SumReversed sums the amounts reversed of the transactions, by transaction id.
+//
+// The transactions not reversed are not in the sums.
+func (r Transaction) SumReversed(ctx context.Context, ids []model.TransactionID) (map[model.TransactionID]model.Cents, error) {
+	errMsg := "storage.Transaction: failed to sum reversed amounts"
+
+	q := r.storage.QueryBuilder().
+		Select(r.colReversedTransactionID, "-SUM("+r.colDebitedCents+") AS reversed_cents").
+		From(transactionTable).
+		Where(squirrel.Eq{r.colReversedTransactionID: ids}).
+		GroupBy(r.colReversedTransactionID)
+
+	var rows []struct {
+		TransactionID model.TransactionID `db:"reversed_transaction_id"`
+		ReversedCents model.Cents         `db:"reversed_cents"`
+	}
+
+	err := r.storage.Select(ctx, q, &rows)
+	if err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	sums := make(map[model.TransactionID]model.Cents, len(rows))
+
+	for _, row := range rows {
+		sums[row.TransactionID] = row.ReversedCents
+	}
+
+	return sums, nil
+}
+
+// Find finds the transaction of the bank account from a storage.
+func (r Transaction) Find(ctx context.Context, bankAccountID model.BankAccountID, id model.TransactionID) (*model.Transaction, error) {
+	errMsg := "storage.Transaction: failed to find transaction"
+
+	var transaction model.Transaction
+
+	q := r.storage.SelectStmt(transactionTable, transaction).
+		Where(squirrel.Eq{r.colID: id}).
+		Where(squirrel.Eq{r.colBankAccountID: bankAccountID})
+
+	err := r.storage.Select(ctx, q, &transaction)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &transaction, nil
+}
+
+// FindByExternalReference finds the inbound credit of the bank account from a storage.
+//
+// Returns nil when the bank account has no inbound credit of the reference.
+func (r Transaction) FindByExternalReference(ctx context.Context, bankAccountID model.BankAccountID, reference string) (*model.Transaction, error) {
+	errMsg := "storage.Transaction: failed to find transaction by external reference"
+
+	var transaction model.Transaction
+
+	q := r.storage.SelectStmt(transactionTable, transaction).
+		Where(squirrel.Eq{r.colBankAccountID: bankAccountID}).
+		Where(squirrel.Eq{r.colExternalReference: reference})
+
+	err := r.storage.Select(ctx, q, &transaction)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &transaction, nil
+}
+
+// List lists the transactions matching the filter from a storage.
+//
+// Transactions are sorted by creation time, the ones created at the same time by id.
+func (r Transaction) List(ctx context.Context, filter model.TransactionFilter) ([]model.Transaction, error) {
+	errMsg := "storage.Transaction: failed to list transactions"
+
+	q := r.storage.SelectStmt(transactionTable, model.Transaction{}).
+		Where(squirrel.Eq{r.colBankAccountID: filter.BankAccountID})
+
+	if !filter.From.IsZero() {
+		q = q.Where(squirrel.GtOrEq{r.colCreatedAt: filter.From})
+	}
+
+	if !filter.To.IsZero() {
+		q = q.Where(squirrel.Lt{r.colCreatedAt: filter.To})
+	}
+
+	if filter.Counterparty != "" {
+		q = q.Where(squirrel.Or{
+			squirrel.ILike{r.colCounterpartyName: "%" + escapeLike(filter.Counterparty) + "%"},
+			squirrel.Eq{r.colCounterpartyIban: filter.Counterparty},
+		})
+	}
+
+	if filter.MinAmountCents != nil {
+		q = q.Where(squirrel.GtOrEq{r.colDebitedCents: *filter.MinAmountCents})
+	}
+
+	if filter.MaxAmountCents != nil {
+		q = q.Where(squirrel.LtOrEq{r.colDebitedCents: *filter.MaxAmountCents})
+	}
+
+	cmp, order := ">", "ASC"
+
+	if filter.Sort == model.TransactionSortNewest {
+		cmp, order = "<", "DESC"
+	}
+
+	if filter.After != nil {
+		q = q.Where(
+			"("+r.colCreatedAt+", "+r.colID+") "+cmp+" (?, ?)",
+			filter.After.CreatedAt,
+			filter.After.ID,
+		)
+	}
+
+	q = q.OrderBy(r.colCreatedAt+" "+order, r.colID+" "+order)
+
+	if filter.Limit > 0 {
+		q = q.Limit(uint64(filter.Limit))
+	}
+
+	var transactions []model.Transaction
+
+	err := r.storage.Select(ctx, q, &transactions)
+	if err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return transactions, nil
+}
+
+// SumDebited sums the amounts debited from the bank account by the transactions created at or after since.
+func (r Transaction) SumDebited(ctx context.Context, bankAccountID model.BankAccountID, since time.Time) (model.Cents, error) {
+	errMsg := "storage.Transaction: failed to sum debited amounts"
+
+	q := r.storage.QueryBuilder().
+		Select("COALESCE(SUM(" + r.colDebitedCents + "), 0)").
+		From(transactionTable).
+		Where(squirrel.Eq{r.colBankAccountID: bankAccountID}).
+		Where(squirrel.GtOrEq{r.colCreatedAt: since})
+
+	var sum model.Cents
+
+	err := r.storage.Select(ctx, q, &sum)
+	if err != nil {
+		return 0, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return sum, nil
+}
+
+// LockOrganizationUsage locks the usage of the limits of the organization until the transaction ends.
+//
+// The usage of the organization sums the debits of all its bank accounts while only the debited one is locked, the
+// advisory lock keeps the transfer bulks of the sibling accounts from reading the same usage.
+func (r Transaction) LockOrganizationUsage(ctx context.Context, organizationName string) error {
+	errMsg := "storage.Transaction: failed to lock organization usage"
+
+	q := r.storage.QueryBuilder().
+		Select().
+		Column(squirrel.Expr("pg_advisory_xact_lock(hashtext(?))", organizationUsageLock+organizationName))
+
+	if _, err := r.storage.Exec(ctx, q); err != nil {
 		return ctxd.WrapError(
 			ctx,
 			err,
 			errMsg,
+			"organization_name", organizationName,
 		)
 	}
 
 	return nil
 }
+
+// OutgoingUsage sums the outgoing debits of the bank account limited, or of the bank accounts of the organization
+// limited, from the start of the day and of the month, in UTC, and counts them within the window ending at now. The
+// credit transfers and the hold captures are part of the usage, not the fees charged, the reversals nor the credits.
+func (r Transaction) OutgoingUsage(ctx context.Context, limit model.TransferLimit, now time.Time) (*model.TransferUsage, error) {
+	errMsg := "storage.Transaction: failed to find outgoing usage"
+
+	now = now.UTC()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	windowStart := now.Add(-limit.Window())
+
+	since := monthStart
+	if windowStart.Before(since) {
+		since = windowStart
+	}
+
+	q := r.storage.QueryBuilder().
+		Select().
+		Column(squirrel.Expr(
+			"COALESCE(SUM("+r.colDebitedCents+") FILTER (WHERE "+r.colCreatedAt+" >= ?), 0) AS daily_cents",
+			dayStart,
+		)).
+		Column(squirrel.Expr(
+			"COALESCE(SUM("+r.colDebitedCents+") FILTER (WHERE "+r.colCreatedAt+" >= ?), 0) AS monthly_cents",
+			monthStart,
+		)).
+		Column(squirrel.Expr(
+			"COUNT(*) FILTER (WHERE "+r.colCreatedAt+" >= ?) AS window_transfers",
+			windowStart,
+		)).
+		From(transactionTable).
+		Where(squirrel.Gt{r.colDebitedCents: 0}).
+		Where(squirrel.Eq{r.colReversedTransactionID: 0}).
+		Where(squirrel.Eq{r.colChargedTransactionID: 0}).
+		Where(squirrel.GtOrEq{r.colCreatedAt: since})
+
+	if limit.BankAccountID != 0 {
+		q = q.Where(squirrel.Eq{r.colBankAccountID: limit.BankAccountID})
+	} else {
+		q = q.Where(
+			r.colBankAccountID+" IN (SELECT id FROM "+bankAccountTable+" WHERE organization_name = ?)",
+			limit.OrganizationName,
+		)
+	}
+
+	var usage model.TransferUsage
+
+	err := r.storage.Select(ctx, q, &usage)
+	if err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"transfer_limit_id", limit.ID,
+		)
+	}
+
+	return &usage, nil
+}
+
+// escapeLike escapes the LIKE pattern characters of s.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
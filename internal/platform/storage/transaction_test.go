@@ -6,6 +6,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/bool64/ctxd"
@@ -27,6 +28,7 @@ func TestTransaction_Add(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
+		want    []model.TransactionID
 		wantErr bool
 		pgxErr  error
 		err     error
@@ -43,6 +45,10 @@ func TestTransaction_Add(t *testing.T) {
 						AmountCurrency:   "EUR",
 						BankAccountID:    1,
 						Description:      "Description1",
+						TransferBulkID:   1,
+						DebitedCents:     1000,
+						DebitedCurrency:  "EUR",
+						ExchangeRate:     "1",
 					},
 					{
 						CounterpartyName: "CounterpartyName2",
@@ -52,9 +58,14 @@ func TestTransaction_Add(t *testing.T) {
 						AmountCurrency:   "EUR",
 						BankAccountID:    1,
 						Description:      "Description2",
+						TransferBulkID:   1,
+						DebitedCents:     2000,
+						DebitedCurrency:  "EUR",
+						ExchangeRate:     "1",
 					},
 				},
 			},
+			want:    []model.TransactionID{1, 2},
 			wantErr: false,
 			pgxErr:  nil,
 			err:     nil,
@@ -71,9 +82,14 @@ func TestTransaction_Add(t *testing.T) {
 						AmountCurrency:   "EUR",
 						BankAccountID:    1,
 						Description:      "Description",
+						TransferBulkID:   1,
+						DebitedCents:     1000,
+						DebitedCurrency:  "EUR",
+						ExchangeRate:     "1",
 					},
 				},
 			},
+			want:    nil,
 			wantErr: true,
 			pgxErr:  sql.ErrTxDone,
 			err:     ctxd.WrapError(context.Background(), sql.ErrTxDone, "storage.Transaction: failed to add transaction"),
@@ -100,7 +116,7 @@ func TestTransaction_Add(t *testing.T) {
 					values += ","
 				}
 
-				values += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d)", i+1, i+2, i+3, i+4, i+5, i+6, i+7)
+				values += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d)", i+1, i+2, i+3, i+4, i+5, i+6, i+7, i+8, i+9, i+10, i+11)
 
 				withArgs = append(withArgs,
 					state.CounterpartyName,
@@ -110,18 +126,29 @@ func TestTransaction_Add(t *testing.T) {
 					state.AmountCurrency,
 					state.BankAccountID,
 					state.Description,
+					state.TransferBulkID,
+					state.DebitedCents,
+					state.DebitedCurrency,
+					state.ExchangeRate,
 				)
 
-				i += 7
+				i += 11
 			}
 
-			meQuery := mock.ExpectExec(`
-				INSERT INTO transactions (counterparty_name,counterparty_iban,counterparty_bic,amount_cents,amount_currency,bank_account_id,description) 
+			meQuery := mock.ExpectQuery(`
+				INSERT INTO transactions (counterparty_name,counterparty_iban,counterparty_bic,amount_cents,amount_currency,bank_account_id,description,transfer_bulk_id,debited_cents,debited_currency,exchange_rate) 
 				VALUES ` + values + `
+				RETURNING id
 			`).WithArgs(withArgs...)
 
 			if tc.err == nil {
-				meQuery.WillReturnResult(sqlmock.NewResult(2, 2))
+				rows := sqlmock.NewRows([]string{"id"})
+
+				for _, id := range tc.want {
+					rows.AddRow(id)
+				}
+
+				meQuery.WillReturnRows(rows)
 			} else {
 				meQuery.WillReturnError(tc.pgxErr)
 			}
@@ -130,10 +157,13 @@ func TestTransaction_Add(t *testing.T) {
 
 			r := storage.NewTransaction(st)
 
-			if err = r.Add(context.Background(), tc.args.transactionState); (err != nil) != tc.wantErr {
+			got, err := r.Add(context.Background(), tc.args.transactionState)
+			if (err != nil) != tc.wantErr {
 				t.Errorf("Add() error = %v, wantErr %v", err, tc.wantErr)
 			}
 
+			assert.Equal(t, tc.want, got, "Add() got = %v, want %v", got, tc.want)
+
 			assert.ErrorIsf(t, tc.err, err, "Add() err got = %v, want %v", err, tc.err)
 
 			if err = mock.ExpectationsWereMet(); err != nil {
@@ -142,3 +172,697 @@ func TestTransaction_Add(t *testing.T) {
 		})
 	}
 }
+
+func TestTransaction_FindIDsByTransferBulk(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		transferBulkID model.TransferBulkID
+		want           []model.TransactionID
+		wantErr        bool
+		pgxErr         error
+		err            error
+	}{
+		{
+			name:           "transactions found successfully",
+			transferBulkID: 1,
+			want:           []model.TransactionID{1, 2, 3},
+			wantErr:        false,
+			pgxErr:         nil,
+			err:            nil,
+		},
+		{
+			name:           "db error when finding transactions",
+			transferBulkID: 1,
+			want:           nil,
+			wantErr:        true,
+			pgxErr:         errRowsClosed,
+			err:            ctxd.WrapError(context.Background(), errRowsClosed, "storage.Transaction: failed to find transactions by transfer bulk"),
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id
+				FROM transactions
+				WHERE transfer_bulk_id = $1 AND charged_transaction_id = $2
+				ORDER BY id
+			`).
+				WithArgs(tc.transferBulkID, 0)
+
+			if tc.pgxErr == nil {
+				rows := sqlmock.NewRows([]string{"id"})
+
+				for _, id := range tc.want {
+					rows.AddRow(id)
+				}
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewTransaction(st)
+
+			got, err := r.FindIDsByTransferBulk(context.Background(), tc.transferBulkID)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("FindIDsByTransferBulk() error = %v, wantErr %v", err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.want, got, "FindIDsByTransferBulk() got = %v, want %v", got, tc.want)
+
+			assert.ErrorIsf(t, tc.err, err, "FindIDsByTransferBulk() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("FindIDsByTransferBulk() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+var transactionColumns = []string{
+	"id", "counterparty_name", "counterparty_iban", "counterparty_bic", "amount_cents", "amount_currency",
+	"bank_account_id", "description", "transfer_bulk_id", "debited_cents", "debited_currency", "exchange_rate",
+	"reversed_transaction_id", "reversal_reason", "external_reference", "internal", "charged_transaction_id",
+	"created_at",
+}
+
+func transactionRow(rows *sqlmock.Rows, transaction model.Transaction) {
+	rows.AddRow(
+		transaction.ID, transaction.CounterpartyName, transaction.CounterpartyIban, transaction.CounterpartyBic,
+		transaction.AmountCents, transaction.AmountCurrency, transaction.BankAccountID, transaction.Description,
+		transaction.TransferBulkID, transaction.DebitedCents, transaction.DebitedCurrency, transaction.ExchangeRate,
+		transaction.ReversedTransactionID, transaction.ReversalReason, transaction.ExternalReference, transaction.Internal,
+		transaction.ChargedTransactionID, transaction.CreatedAt,
+	)
+}
+
+func testTransaction(id model.TransactionID) model.Transaction {
+	return model.Transaction{
+		ID: id,
+		TransactionState: model.TransactionState{
+			CounterpartyName: "Bip Bip",
+			CounterpartyIban: "EE303680981021245685",
+			CounterpartyBic:  "CRLYFRPPTOU",
+			AmountCents:      1450,
+			AmountCurrency:   "EUR",
+			BankAccountID:    1,
+			Description:      "Wonderland/4410",
+			TransferBulkID:   1,
+			DebitedCents:     1450,
+			DebitedCurrency:  "EUR",
+			ExchangeRate:     "1",
+			CreatedAt:        time.Date(2021, 12, 7, 9, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestTransaction_Find(t *testing.T) {
+	t.Parallel()
+
+	transaction := testTransaction(1)
+
+	tests := []struct {
+		name   string
+		want   *model.Transaction
+		pgxErr error
+		err    error
+	}{
+		{
+			name: "transaction found",
+			want: &transaction,
+		},
+		{
+			name:   "transaction does not exists",
+			pgxErr: sql.ErrNoRows,
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:   "db error when finding transaction",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, counterparty_name, counterparty_iban, counterparty_bic, amount_cents, amount_currency, bank_account_id, description, transfer_bulk_id, debited_cents, debited_currency, exchange_rate, reversed_transaction_id, reversal_reason, external_reference, internal, charged_transaction_id, created_at
+				FROM transactions
+				WHERE id = $1 AND bank_account_id = $2
+			`).
+				WithArgs(transaction.ID, transaction.BankAccountID)
+
+			if tc.pgxErr == nil {
+				rows := sqlmock.NewRows(transactionColumns)
+
+				transactionRow(rows, *tc.want)
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewTransaction(st)
+
+			got, err := r.Find(context.Background(), transaction.BankAccountID, transaction.ID)
+
+			assert.Equal(t, tc.want, got, "Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "Find() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Find() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransaction_FindByExternalReference(t *testing.T) {
+	t.Parallel()
+
+	transaction := testTransaction(1)
+	transaction.ExternalReference = "E2E-2021-12-11-001"
+
+	tests := []struct {
+		name   string
+		want   *model.Transaction
+		pgxErr error
+		err    error
+	}{
+		{
+			name: "inbound credit found",
+			want: &transaction,
+		},
+		{
+			name:   "inbound credit does not exists",
+			pgxErr: sql.ErrNoRows,
+		},
+		{
+			name:   "db error when finding transaction",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, counterparty_name, counterparty_iban, counterparty_bic, amount_cents, amount_currency, bank_account_id, description, transfer_bulk_id, debited_cents, debited_currency, exchange_rate, reversed_transaction_id, reversal_reason, external_reference, internal, charged_transaction_id, created_at
+				FROM transactions
+				WHERE bank_account_id = $1 AND external_reference = $2
+			`).
+				WithArgs(transaction.BankAccountID, transaction.ExternalReference)
+
+			if tc.pgxErr == nil {
+				rows := sqlmock.NewRows(transactionColumns)
+
+				transactionRow(rows, *tc.want)
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewTransaction(st)
+
+			got, err := r.FindByExternalReference(context.Background(), transaction.BankAccountID, transaction.ExternalReference)
+
+			assert.Equal(t, tc.want, got, "FindByExternalReference() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "FindByExternalReference() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("FindByExternalReference() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransaction_List(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)
+	minAmount := model.Cents(1000)
+	maxAmount := model.Cents(2000)
+
+	tests := []struct {
+		name     string
+		filter   model.TransactionFilter
+		query    string
+		withArgs []driver.Value
+		want     []model.Transaction
+		pgxErr   error
+		err      error
+	}{
+		{
+			name: "transactions listed, newest first",
+			filter: model.TransactionFilter{
+				BankAccountID: 1,
+				Sort:          model.TransactionSortNewest,
+				Limit:         3,
+			},
+			query: `
+				SELECT id, counterparty_name, counterparty_iban, counterparty_bic, amount_cents, amount_currency, bank_account_id, description, transfer_bulk_id, debited_cents, debited_currency, exchange_rate, reversed_transaction_id, reversal_reason, external_reference, internal, charged_transaction_id, created_at
+				FROM transactions
+				WHERE bank_account_id = $1
+				ORDER BY created_at DESC, id DESC
+				LIMIT 3
+			`,
+			withArgs: []driver.Value{model.BankAccountID(1)},
+			want:     []model.Transaction{testTransaction(2), testTransaction(1)},
+		},
+		{
+			name: "transactions listed with filters, oldest first after the cursor",
+			filter: model.TransactionFilter{
+				BankAccountID:  1,
+				From:           from,
+				To:             to,
+				Counterparty:   "bip_50%",
+				MinAmountCents: &minAmount,
+				MaxAmountCents: &maxAmount,
+				Sort:           model.TransactionSortOldest,
+				After:          &model.TransactionCursor{CreatedAt: from, ID: 7},
+				Limit:          2,
+			},
+			query: `
+				SELECT id, counterparty_name, counterparty_iban, counterparty_bic, amount_cents, amount_currency, bank_account_id, description, transfer_bulk_id, debited_cents, debited_currency, exchange_rate, reversed_transaction_id, reversal_reason, external_reference, internal, charged_transaction_id, created_at
+				FROM transactions
+				WHERE bank_account_id = $1 AND created_at >= $2 AND created_at < $3
+				AND (counterparty_name ILIKE $4 OR counterparty_iban = $5)
+				AND debited_cents >= $6 AND debited_cents <= $7
+				AND (created_at, id) > ($8, $9)
+				ORDER BY created_at ASC, id ASC
+				LIMIT 2
+			`,
+			withArgs: []driver.Value{
+				model.BankAccountID(1), from, to, `%bip\_50\%%`, "bip_50%", minAmount, maxAmount, from, model.TransactionID(7),
+			},
+			want: []model.Transaction{testTransaction(8)},
+		},
+		{
+			name: "db error when listing transactions",
+			filter: model.TransactionFilter{
+				BankAccountID: 1,
+				Sort:          model.TransactionSortNewest,
+			},
+			query: `
+				SELECT id, counterparty_name, counterparty_iban, counterparty_bic, amount_cents, amount_currency, bank_account_id, description, transfer_bulk_id, debited_cents, debited_currency, exchange_rate, reversed_transaction_id, reversal_reason, external_reference, internal, charged_transaction_id, created_at
+				FROM transactions
+				WHERE bank_account_id = $1
+				ORDER BY created_at DESC, id DESC
+			`,
+			withArgs: []driver.Value{model.BankAccountID(1)},
+			pgxErr:   errRowsClosed,
+			err:      errRowsClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(tc.query).WithArgs(tc.withArgs...)
+
+			if tc.pgxErr == nil {
+				rows := sqlmock.NewRows(transactionColumns)
+
+				for _, transaction := range tc.want {
+					transactionRow(rows, transaction)
+				}
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewTransaction(st)
+
+			got, err := r.List(context.Background(), tc.filter)
+
+			assert.Equal(t, tc.want, got, "List() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "List() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("List() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransaction_SumDebited(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		want   model.Cents
+		pgxErr error
+		err    error
+	}{
+		{
+			name: "debited amounts summed",
+			want: 6225150,
+		},
+		{
+			name:   "db error when summing debited amounts",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT COALESCE(SUM(debited_cents), 0)
+				FROM transactions
+				WHERE bank_account_id = $1 AND created_at >= $2
+			`).
+				WithArgs(model.BankAccountID(1), since)
+
+			if tc.pgxErr == nil {
+				meQuery.WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(tc.want))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewTransaction(st)
+
+			got, err := r.SumDebited(context.Background(), 1, since)
+
+			assert.Equal(t, tc.want, got, "SumDebited() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "SumDebited() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("SumDebited() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransaction_LockOrganizationUsage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		pgxErr error
+		err    error
+	}{
+		{
+			name: "organization usage locked successfully",
+		},
+		{
+			name:   "db error when locking organization usage",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectExec(`
+				SELECT pg_advisory_xact_lock(hashtext($1))
+			`).
+				WithArgs("organization_usage/ACME Corp")
+
+			if tc.pgxErr == nil {
+				meQuery.WillReturnResult(sqlmock.NewResult(0, 1))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			err = storage.NewTransaction(st).LockOrganizationUsage(context.Background(), "ACME Corp")
+
+			assert.ErrorIsf(t, err, tc.err, "LockOrganizationUsage() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("LockOrganizationUsage() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransaction_OutgoingUsage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 15, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		limit  model.TransferLimit
+		where  string
+		arg    interface{}
+		pgxErr error
+		err    error
+	}{
+		{
+			name:  "bank account usage found successfully",
+			limit: model.TransferLimit{ID: 1, TransferLimitState: model.TransferLimitState{BankAccountID: 1, WindowSeconds: 3600}},
+			where: "bank_account_id = $8",
+			arg:   model.BankAccountID(1),
+		},
+		{
+			name: "organization usage found successfully",
+			limit: model.TransferLimit{
+				ID:                 2,
+				TransferLimitState: model.TransferLimitState{OrganizationName: "ACME Corp", WindowSeconds: 3600},
+			},
+			where: "bank_account_id IN (SELECT id FROM bank_accounts WHERE organization_name = $8)",
+			arg:   "ACME Corp",
+		},
+		{
+			name:   "db error when finding usage",
+			limit:  model.TransferLimit{ID: 1, TransferLimitState: model.TransferLimitState{BankAccountID: 1, WindowSeconds: 3600}},
+			where:  "bank_account_id = $8",
+			arg:    model.BankAccountID(1),
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			dayStart := time.Date(2021, 12, 15, 0, 0, 0, 0, time.UTC)
+			monthStart := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+			windowStart := now.Add(-time.Hour)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT COALESCE(SUM(debited_cents) FILTER (WHERE created_at >= $1), 0) AS daily_cents, COALESCE(SUM(debited_cents) FILTER (WHERE created_at >= $2), 0) AS monthly_cents, COUNT(*) FILTER (WHERE created_at >= $3) AS window_transfers
+				FROM transactions
+				WHERE debited_cents > $4 AND reversed_transaction_id = $5 AND charged_transaction_id = $6 AND created_at >= $7 AND `+tc.where+`
+			`).
+				WithArgs(dayStart, monthStart, windowStart, 0, 0, 0, monthStart, tc.arg)
+
+			var want *model.TransferUsage
+
+			if tc.pgxErr == nil {
+				want = &model.TransferUsage{DailyCents: 150000, MonthlyCents: 900000, WindowTransfers: 3}
+
+				meQuery.WillReturnRows(
+					sqlmock.NewRows([]string{"daily_cents", "monthly_cents", "window_transfers"}).
+						AddRow(want.DailyCents, want.MonthlyCents, want.WindowTransfers),
+				)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewTransaction(st).OutgoingUsage(context.Background(), tc.limit, now)
+
+			assert.Equal(t, want, got, "OutgoingUsage() got = %v, want %v", got, want)
+			assert.ErrorIsf(t, err, tc.err, "OutgoingUsage() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("OutgoingUsage() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransaction_FindByTransferBulk(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		want   []model.Transaction
+		pgxErr error
+		err    error
+	}{
+		{
+			name: "transactions found",
+			want: []model.Transaction{testTransaction(1), testTransaction(2)},
+		},
+		{
+			name:   "db error when finding transactions",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, counterparty_name, counterparty_iban, counterparty_bic, amount_cents, amount_currency, bank_account_id, description, transfer_bulk_id, debited_cents, debited_currency, exchange_rate, reversed_transaction_id, reversal_reason, external_reference, internal, charged_transaction_id, created_at
+				FROM transactions
+				WHERE transfer_bulk_id = $1
+				ORDER BY id
+			`).
+				WithArgs(model.TransferBulkID(1))
+
+			if tc.pgxErr == nil {
+				rows := sqlmock.NewRows(transactionColumns)
+
+				for _, transaction := range tc.want {
+					transactionRow(rows, transaction)
+				}
+
+				meQuery.WillReturnRows(rows)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewTransaction(st)
+
+			got, err := r.FindByTransferBulk(context.Background(), 1)
+
+			assert.Equal(t, tc.want, got, "FindByTransferBulk() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "FindByTransferBulk() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("FindByTransferBulk() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransaction_SumReversed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		want   map[model.TransactionID]model.Cents
+		pgxErr error
+		err    error
+	}{
+		{
+			name: "reversed amounts summed",
+			want: map[model.TransactionID]model.Cents{1: 1450, 3: 500},
+		},
+		{
+			name:   "db error when summing reversed amounts",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT reversed_transaction_id, -SUM(debited_cents) AS reversed_cents
+				FROM transactions
+				WHERE reversed_transaction_id IN ($1,$2,$3)
+				GROUP BY reversed_transaction_id
+			`).
+				WithArgs(model.TransactionID(1), model.TransactionID(2), model.TransactionID(3))
+
+			if tc.pgxErr == nil {
+				meQuery.WillReturnRows(sqlmock.NewRows([]string{"reversed_transaction_id", "reversed_cents"}).
+					AddRow(1, 1450).
+					AddRow(3, 500))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewTransaction(st)
+
+			got, err := r.SumReversed(context.Background(), []model.TransactionID{1, 2, 3})
+
+			assert.Equal(t, tc.want, got, "SumReversed() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "SumReversed() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("SumReversed() expectations were not met = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const transferBulkTable = "transfer_bulks"
+
+// TransferBulk represents a TransferBulk repository.
+type TransferBulk struct {
+	storage *sqluct.Storage
+
+	colID             string
+	colIdempotencyKey string
+	colBankAccountID  string
+	colDebitedCents   string
+	colFeesCents      string
+	colBalanceCents   string
+	colCurrency       string
+	colRejections     string
+	colStatus         string
+	colRequest        string
+	colSubmittedBy    string
+	colScreeningHits  string
+}
+
+// NewTransferBulk returns instance of TransferBulk.
+func NewTransferBulk(storage *sqluct.Storage) *TransferBulk {
+	var transferBulk model.TransferBulk
+
+	return &TransferBulk{
+		storage:           storage,
+		colID:             storage.Mapper.Col(&transferBulk, &transferBulk.ID),
+		colIdempotencyKey: storage.Mapper.Col(&transferBulk, &transferBulk.IdempotencyKey),
+		colBankAccountID:  storage.Mapper.Col(&transferBulk, &transferBulk.BankAccountID),
+		colDebitedCents:   storage.Mapper.Col(&transferBulk, &transferBulk.DebitedCents),
+		colFeesCents:      storage.Mapper.Col(&transferBulk, &transferBulk.FeesCents),
+		colBalanceCents:   storage.Mapper.Col(&transferBulk, &transferBulk.BalanceCents),
+		colCurrency:       storage.Mapper.Col(&transferBulk, &transferBulk.Currency),
+		colRejections:     storage.Mapper.Col(&transferBulk, &transferBulk.Rejections),
+		colStatus:         storage.Mapper.Col(&transferBulk, &transferBulk.Status),
+		colRequest:        storage.Mapper.Col(&transferBulk, &transferBulk.Request),
+		colSubmittedBy:    storage.Mapper.Col(&transferBulk, &transferBulk.SubmittedBy),
+		colScreeningHits:  storage.Mapper.Col(&transferBulk, &transferBulk.ScreeningHits),
+	}
+}
+
+// Reserve adds the transfer bulk to the storage.
+//
+// When the idempotency key was already used, returns the stored transfer bulk and false. A concurrent reservation
+// with the same idempotency key waits until the running one is committed or rolled back.
+func (r *TransferBulk) Reserve(ctx context.Context, transferBulkState model.TransferBulkState) (*model.TransferBulk, bool, error) {
+	errMsg := "storage.TransferBulk: failed to reserve transfer bulk"
+
+	transferBulk := model.TransferBulk{
+		TransferBulkState: transferBulkState,
+	}
+
+	q := r.storage.InsertStmt(transferBulkTable, transferBulk, sqluct.SkipZeroValues).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%[1]s) WHERE %[1]s <> '' DO NOTHING RETURNING %[2]s",
+			r.colIdempotencyKey,
+			r.colID,
+		))
+
+	err := r.storage.Select(ctx, q, &transferBulk.ID)
+	if err == nil {
+		return &transferBulk, true, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	var stored model.TransferBulk
+
+	sq := r.storage.SelectStmt(transferBulkTable, stored).
+		Where(squirrel.Eq{r.colIdempotencyKey: transferBulkState.IdempotencyKey})
+
+	if err := r.storage.Select(ctx, sq, &stored); err != nil {
+		return nil, false, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &stored, false, nil
+}
+
+// Find finds the transfer bulk from a storage.
+func (r *TransferBulk) Find(ctx context.Context, id model.TransferBulkID) (*model.TransferBulk, error) {
+	errMsg := "storage.TransferBulk: failed to find transfer bulk"
+
+	var transferBulk model.TransferBulk
+
+	q := r.storage.SelectStmt(transferBulkTable, transferBulk).
+		Where(squirrel.Eq{r.colID: id})
+
+	err := r.storage.Select(ctx, q, &transferBulk)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &transferBulk, nil
+}
+
+// Lock finds the transfer bulk from a storage, locked until the transaction ends.
+func (r *TransferBulk) Lock(ctx context.Context, id model.TransferBulkID) (*model.TransferBulk, error) {
+	errMsg := "storage.TransferBulk: failed to lock transfer bulk"
+
+	var transferBulk model.TransferBulk
+
+	q := r.storage.SelectStmt(transferBulkTable, transferBulk).
+		Where(squirrel.Eq{r.colID: id}).
+		Suffix("FOR UPDATE")
+
+	err := r.storage.Select(ctx, q, &transferBulk)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg, "transfer_bulk_id", id)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"transfer_bulk_id", id,
+		)
+	}
+
+	return &transferBulk, nil
+}
+
+// Complete stores the outcome of the performed transfer bulk, or of the transfer bulk awaiting approval or held for
+// review.
+func (r *TransferBulk) Complete(ctx context.Context, transferBulk model.TransferBulk) error {
+	errMsg := "storage.TransferBulk: failed to complete transfer bulk"
+
+	q := r.storage.UpdateStmt(transferBulkTable, nil).
+		Set(r.colBankAccountID, transferBulk.BankAccountID).
+		Set(r.colDebitedCents, transferBulk.DebitedCents).
+		Set(r.colFeesCents, transferBulk.FeesCents).
+		Set(r.colBalanceCents, transferBulk.BalanceCents).
+		Set(r.colCurrency, transferBulk.Currency).
+		Set(r.colRejections, transferBulk.Rejections).
+		Set(r.colStatus, transferBulk.Status).
+		Set(r.colRequest, transferBulk.Request).
+		Set(r.colSubmittedBy, transferBulk.SubmittedBy).
+		Set(r.colScreeningHits, transferBulk.ScreeningHits).
+		Where(squirrel.Eq{r.colID: transferBulk.ID})
+
+	if _, err := r.storage.Exec(ctx, q); err != nil {
+		return ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const transferBulkDecisionTable = "transfer_bulk_decisions"
+
+// TransferBulkDecision represents a TransferBulkDecision repository.
+type TransferBulkDecision struct {
+	storage *sqluct.Storage
+
+	colID string
+}
+
+// NewTransferBulkDecision returns instance of TransferBulkDecision.
+func NewTransferBulkDecision(storage *sqluct.Storage) *TransferBulkDecision {
+	var decision model.TransferBulkDecision
+
+	return &TransferBulkDecision{
+		storage: storage,
+		colID:   storage.Mapper.Col(&decision, &decision.ID),
+	}
+}
+
+// Add adds the decision to the approval trail of the transfer bulk in the storage.
+func (r *TransferBulkDecision) Add(ctx context.Context, decisionState model.TransferBulkDecisionState) (*model.TransferBulkDecision, error) {
+	errMsg := "storage.TransferBulkDecision: failed to add transfer bulk decision"
+
+	decision := model.TransferBulkDecision{
+		TransferBulkDecisionState: decisionState,
+	}
+
+	q := r.storage.InsertStmt(transferBulkDecisionTable, decision, sqluct.SkipZeroValues).
+		Suffix("RETURNING " + r.colID)
+
+	if err := r.storage.Select(ctx, q, &decision.ID); err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"transfer_bulk_id", decisionState.TransferBulkID,
+		)
+	}
+
+	return &decision, nil
+}
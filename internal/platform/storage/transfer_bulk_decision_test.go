@@ -0,0 +1,83 @@
+package storage_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferBulkDecision_Add(t *testing.T) {
+	t.Parallel()
+
+	decision := model.TransferBulkDecision{
+		ID: 2,
+		TransferBulkDecisionState: model.TransferBulkDecisionState{
+			TransferBulkID: 1,
+			Decision:       model.TransferBulkApproved,
+			UserID:         "bob",
+			Reason:         "payroll checked",
+			CreatedAt:      time.Date(2021, 12, 17, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	tests := []struct {
+		name   string
+		pgxErr error
+		want   *model.TransferBulkDecision
+		err    error
+	}{
+		{
+			name: "decision added successfully",
+			want: &decision,
+		},
+		{
+			name:   "db error when adding decision",
+			pgxErr: sql.ErrTxDone,
+			err:    sql.ErrTxDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				INSERT INTO transfer_bulk_decisions (transfer_bulk_id,decision,user_id,reason,created_at)
+				VALUES ($1,$2,$3,$4,$5)
+				RETURNING id
+			`).
+				WithArgs(decision.TransferBulkID, decision.Decision, decision.UserID, decision.Reason, decision.CreatedAt)
+
+			if tc.pgxErr != nil {
+				meQuery.WillReturnError(tc.pgxErr)
+			} else {
+				meQuery.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(decision.ID))
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewTransferBulkDecision(st).Add(context.Background(), decision.TransferBulkDecisionState)
+
+			assert.Equal(t, tc.want, got, "Add() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "Add() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Add() expectations were not met = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const transferBulkJobTable = "transfer_bulk_jobs"
+
+// TransferBulkJob represents a TransferBulkJob repository.
+type TransferBulkJob struct {
+	storage *sqluct.Storage
+
+	colID             string
+	colIdempotencyKey string
+	colStatus         string
+	colOrgIban        string
+	colExecutionDate  string
+	colProcessedRows  string
+	colRows           string
+	colTransferBulkID string
+	colDebitedCents   string
+	colFeesCents      string
+	colBalanceCents   string
+	colCurrency       string
+	colError          string
+	colUpdatedAt      string
+}
+
+// NewTransferBulkJob returns instance of TransferBulkJob.
+func NewTransferBulkJob(storage *sqluct.Storage) *TransferBulkJob {
+	var job model.TransferBulkJob
+
+	return &TransferBulkJob{
+		storage:           storage,
+		colID:             storage.Mapper.Col(&job, &job.ID),
+		colIdempotencyKey: storage.Mapper.Col(&job, &job.IdempotencyKey),
+		colStatus:         storage.Mapper.Col(&job, &job.Status),
+		colOrgIban:        storage.Mapper.Col(&job, &job.OrganizationIban),
+		colExecutionDate:  storage.Mapper.Col(&job, &job.ExecutionDate),
+		colProcessedRows:  storage.Mapper.Col(&job, &job.ProcessedRows),
+		colRows:           storage.Mapper.Col(&job, &job.Rows),
+		colTransferBulkID: storage.Mapper.Col(&job, &job.TransferBulkID),
+		colDebitedCents:   storage.Mapper.Col(&job, &job.DebitedCents),
+		colFeesCents:      storage.Mapper.Col(&job, &job.FeesCents),
+		colBalanceCents:   storage.Mapper.Col(&job, &job.BalanceCents),
+		colCurrency:       storage.Mapper.Col(&job, &job.Currency),
+		colError:          storage.Mapper.Col(&job, &job.Error),
+		colUpdatedAt:      storage.Mapper.Col(&job, &job.UpdatedAt),
+	}
+}
+
+// Enqueue adds the transfer bulk job to the storage.
+//
+// When the idempotency key was already used, returns the stored transfer bulk job and false.
+func (r *TransferBulkJob) Enqueue(ctx context.Context, jobState model.TransferBulkJobState) (*model.TransferBulkJob, bool, error) {
+	errMsg := "storage.TransferBulkJob: failed to enqueue transfer bulk job"
+
+	job := model.TransferBulkJob{
+		TransferBulkJobState: jobState,
+	}
+
+	q := r.storage.InsertStmt(transferBulkJobTable, job, sqluct.SkipZeroValues).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%[1]s) WHERE %[1]s <> '' DO NOTHING RETURNING %[2]s",
+			r.colIdempotencyKey,
+			r.colID,
+		))
+
+	err := r.storage.Select(ctx, q, &job.ID)
+	if err == nil {
+		return &job, true, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	var stored model.TransferBulkJob
+
+	sq := r.storage.SelectStmt(transferBulkJobTable, stored).
+		Where(squirrel.Eq{r.colIdempotencyKey: jobState.IdempotencyKey})
+
+	if err := r.storage.Select(ctx, sq, &stored); err != nil {
+		return nil, false, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &stored, false, nil
+}
+
+// Find finds the transfer bulk job from a storage.
+func (r *TransferBulkJob) Find(ctx context.Context, id model.TransferBulkJobID) (*model.TransferBulkJob, error) {
+	errMsg := "storage.TransferBulkJob: failed to find transfer bulk job"
+
+	var job model.TransferBulkJob
+
+	q := r.storage.SelectStmt(transferBulkJobTable, job).
+		Where(squirrel.Eq{r.colID: id})
+
+	err := r.storage.Select(ctx, q, &job)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ctxd.WrapError(ctx, ErrNotFound, errMsg, "transfer_bulk_job_id", id)
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"transfer_bulk_job_id", id,
+		)
+	}
+
+	return &job, nil
+}
+
+// ListScheduled lists the scheduled transfer bulk jobs of the bank account, by execution date.
+//
+// The scheduled jobs of all the bank accounts are listed when organizationIban is empty.
+func (r *TransferBulkJob) ListScheduled(ctx context.Context, organizationIban string) ([]model.TransferBulkJob, error) {
+	errMsg := "storage.TransferBulkJob: failed to list scheduled transfer bulk jobs"
+
+	var jobs []model.TransferBulkJob
+
+	q := r.storage.SelectStmt(transferBulkJobTable, model.TransferBulkJob{}).
+		Where(squirrel.Eq{r.colStatus: model.TransferBulkJobScheduled}).
+		OrderBy(r.colExecutionDate, r.colID)
+
+	if organizationIban != "" {
+		q = q.Where(squirrel.Eq{r.colOrgIban: organizationIban})
+	}
+
+	if err := r.storage.Select(ctx, q, &jobs); err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"organization_iban", organizationIban,
+		)
+	}
+
+	return jobs, nil
+}
+
+// Cancel marks the scheduled transfer bulk job as cancelled.
+//
+// Returns false when the job is not scheduled, e.g. it is already claimed, or does not exist.
+func (r *TransferBulkJob) Cancel(ctx context.Context, id model.TransferBulkJobID, now time.Time) (bool, error) {
+	errMsg := "storage.TransferBulkJob: failed to cancel transfer bulk job"
+
+	q := r.storage.UpdateStmt(transferBulkJobTable, nil).
+		Set(r.colStatus, model.TransferBulkJobCancelled).
+		Set(r.colUpdatedAt, now).
+		Where(squirrel.Eq{
+			r.colID:     id,
+			r.colStatus: model.TransferBulkJobScheduled,
+		})
+
+	res, err := r.storage.Exec(ctx, q)
+	if err != nil {
+		return false, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"transfer_bulk_job_id", id,
+		)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"transfer_bulk_job_id", id,
+		)
+	}
+
+	return affected > 0, nil
+}
+
+// Claim marks the oldest pending job, scheduled job which execution date is reached, or processing job not updated
+// since staleBefore, as processing.
+//
+// The execution date is reached when it is not after the UTC date of now. Returns nil when there is no job to
+// process. Within a transaction, jobs locked by a concurrent claim are skipped.
+func (r *TransferBulkJob) Claim(ctx context.Context, now, staleBefore time.Time) (*model.TransferBulkJob, error) {
+	errMsg := "storage.TransferBulkJob: failed to claim transfer bulk job"
+
+	var job model.TransferBulkJob
+
+	today := now.UTC().Truncate(24 * time.Hour)
+
+	q := r.storage.SelectStmt(transferBulkJobTable, job).
+		Where(squirrel.Or{
+			squirrel.Eq{r.colStatus: model.TransferBulkJobPending},
+			squirrel.And{
+				squirrel.Eq{r.colStatus: model.TransferBulkJobScheduled},
+				squirrel.LtOrEq{r.colExecutionDate: today},
+			},
+			squirrel.And{
+				squirrel.Eq{r.colStatus: model.TransferBulkJobProcessing},
+				squirrel.Lt{r.colUpdatedAt: staleBefore},
+			},
+		}).
+		OrderBy(r.colID).
+		Limit(1)
+
+	if tx := sqluct.TxFromContext(ctx); tx != nil {
+		q = q.Suffix("FOR UPDATE SKIP LOCKED")
+	}
+
+	err := r.storage.Select(ctx, q, &job)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	uq := r.storage.UpdateStmt(transferBulkJobTable, nil).
+		Set(r.colStatus, model.TransferBulkJobProcessing).
+		Set(r.colUpdatedAt, now).
+		Where(squirrel.Eq{r.colID: job.ID})
+
+	if _, err := r.storage.Exec(ctx, uq); err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"transfer_bulk_job_id", job.ID,
+		)
+	}
+
+	job.Status = model.TransferBulkJobProcessing
+	job.UpdatedAt = now
+
+	return &job, nil
+}
+
+// Finish stores the status and the outcome of the processed job.
+func (r *TransferBulkJob) Finish(ctx context.Context, job model.TransferBulkJob) error {
+	errMsg := "storage.TransferBulkJob: failed to finish transfer bulk job"
+
+	q := r.storage.UpdateStmt(transferBulkJobTable, nil).
+		Set(r.colStatus, job.Status).
+		Set(r.colProcessedRows, job.ProcessedRows).
+		Set(r.colRows, job.Rows).
+		Set(r.colTransferBulkID, job.TransferBulkID).
+		Set(r.colDebitedCents, job.DebitedCents).
+		Set(r.colBalanceCents, job.BalanceCents).
+		Set(r.colCurrency, job.Currency).
+		Set(r.colFeesCents, job.FeesCents).
+		Set(r.colError, job.Error).
+		Set(r.colUpdatedAt, job.UpdatedAt).
+		Where(squirrel.Eq{r.colID: job.ID})
+
+	if _, err := r.storage.Exec(ctx, q); err != nil {
+		return ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"transfer_bulk_job_id", job.ID,
+		)
+	}
+
+	return nil
+}
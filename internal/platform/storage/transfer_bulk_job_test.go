@@ -0,0 +1,560 @@
+package storage_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var transferBulkJobColumns = []string{
+	"id", "idempotency_key", "request_hash", "status", "request", "total_rows", "organization_iban", "execution_date",
+	"submitted_by", "processed_rows", "rows", "transfer_bulk_id", "debited_cents", "balance_cents", "currency",
+	"fees_cents", "error", "created_at", "updated_at",
+}
+
+func transferBulkJobRow(rows *sqlmock.Rows, job model.TransferBulkJob) *sqlmock.Rows {
+	jobRows, _ := job.Rows.Value() // nolint: errcheck
+
+	return rows.AddRow(
+		job.ID, job.IdempotencyKey, job.RequestHash, job.Status, job.Request, job.TotalRows, job.OrganizationIban,
+		job.ExecutionDate, job.SubmittedBy, job.ProcessedRows, jobRows, job.TransferBulkID, job.DebitedCents, job.BalanceCents, job.Currency,
+		job.FeesCents, job.Error, job.CreatedAt, job.UpdatedAt,
+	)
+}
+
+func TestTransferBulkJob_Enqueue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 5, 9, 0, 0, 0, time.UTC)
+
+	jobState := model.TransferBulkJobState{
+		IdempotencyKey:   "IdempotencyKey",
+		RequestHash:      "RequestHash",
+		Status:           model.TransferBulkJobPending,
+		Request:          "{}",
+		TotalRows:        3,
+		OrganizationIban: "FR10474608000002006107XXXXX",
+		SubmittedBy:      "alice",
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	stored := model.TransferBulkJob{
+		ID: 2,
+		TransferBulkJobState: model.TransferBulkJobState{
+			IdempotencyKey: "IdempotencyKey",
+			RequestHash:    "OtherRequestHash",
+			Status:         model.TransferBulkJobCompleted,
+			Request:        "{}",
+			TotalRows:      1,
+			ProcessedRows:  1,
+			Rows:           model.TransferBulkJobRows{{Status: model.TransferBulkJobRowCompleted, TransactionID: 1}},
+			TransferBulkID: 1,
+			DebitedCents:   1000,
+			BalanceCents:   9000,
+			Currency:       "EUR",
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		},
+	}
+
+	insertedID := model.TransferBulkJobID(1)
+
+	tests := []struct {
+		name     string
+		pgxID    *model.TransferBulkJobID
+		stored   *model.TransferBulkJob
+		pgxErr   error
+		want     *model.TransferBulkJob
+		enqueued bool
+		err      error
+	}{
+		{
+			name:     "transfer bulk job enqueued successfully",
+			pgxID:    &insertedID,
+			want:     &model.TransferBulkJob{ID: 1, TransferBulkJobState: jobState},
+			enqueued: true,
+		},
+		{
+			name:     "transfer bulk job already enqueued",
+			stored:   &stored,
+			want:     &stored,
+			enqueued: false,
+		},
+		{
+			name:   "db error when enqueuing transfer bulk job",
+			pgxErr: sql.ErrTxDone,
+			err:    sql.ErrTxDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				INSERT INTO transfer_bulk_jobs
+					(idempotency_key,request_hash,status,request,total_rows,organization_iban,submitted_by,created_at,updated_at)
+				VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+				ON CONFLICT (idempotency_key) WHERE idempotency_key <> '' DO NOTHING RETURNING id
+			`).
+				WithArgs(
+					jobState.IdempotencyKey, jobState.RequestHash, jobState.Status, jobState.Request, jobState.TotalRows,
+					jobState.OrganizationIban, jobState.SubmittedBy, jobState.CreatedAt, jobState.UpdatedAt,
+				)
+
+			switch {
+			case tc.pgxID != nil:
+				meQuery.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(*tc.pgxID))
+			case tc.stored != nil:
+				meQuery.WillReturnError(sql.ErrNoRows)
+
+				mock.ExpectQuery(`
+					SELECT id, idempotency_key, request_hash, status, request, total_rows, organization_iban, execution_date,
+						submitted_by, processed_rows, rows, transfer_bulk_id, debited_cents, balance_cents, currency, fees_cents, error, created_at, updated_at
+					FROM transfer_bulk_jobs
+					WHERE idempotency_key = $1
+				`).
+					WithArgs(jobState.IdempotencyKey).
+					WillReturnRows(transferBulkJobRow(sqlmock.NewRows(transferBulkJobColumns), *tc.stored))
+			default:
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, enqueued, err := storage.NewTransferBulkJob(st).Enqueue(context.Background(), jobState)
+
+			assert.Equal(t, tc.want, got, "Enqueue() got = %v, want %v", got, tc.want)
+			assert.Equal(t, tc.enqueued, enqueued, "Enqueue() got enqueued = %v, want %v", enqueued, tc.enqueued)
+			assert.ErrorIsf(t, err, tc.err, "Enqueue() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Enqueue() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferBulkJob_Find(t *testing.T) {
+	t.Parallel()
+
+	job := model.TransferBulkJob{
+		ID: 1,
+		TransferBulkJobState: model.TransferBulkJobState{
+			Status:    model.TransferBulkJobPending,
+			Request:   "{}",
+			TotalRows: 3,
+			Rows:      model.TransferBulkJobRows{},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		found  bool
+		pgxErr error
+		want   *model.TransferBulkJob
+		err    error
+	}{
+		{
+			name:  "transfer bulk job found",
+			found: true,
+			want:  &job,
+		},
+		{
+			name:   "transfer bulk job not found",
+			pgxErr: sql.ErrNoRows,
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:   "db error when finding transfer bulk job",
+			pgxErr: sql.ErrConnDone,
+			err:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, idempotency_key, request_hash, status, request, total_rows, organization_iban, execution_date,
+					submitted_by, processed_rows, rows, transfer_bulk_id, debited_cents, balance_cents, currency, fees_cents, error, created_at, updated_at
+				FROM transfer_bulk_jobs
+				WHERE id = $1
+			`).
+				WithArgs(job.ID)
+
+			if tc.found {
+				meQuery.WillReturnRows(transferBulkJobRow(sqlmock.NewRows(transferBulkJobColumns), job))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewTransferBulkJob(st).Find(context.Background(), job.ID)
+
+			assert.Equal(t, tc.want, got, "Find() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "Find() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Find() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferBulkJob_ListScheduled(t *testing.T) {
+	t.Parallel()
+
+	executionDate := time.Date(2021, 12, 10, 0, 0, 0, 0, time.UTC)
+
+	job := model.TransferBulkJob{
+		ID: 1,
+		TransferBulkJobState: model.TransferBulkJobState{
+			Status:           model.TransferBulkJobScheduled,
+			Request:          "{}",
+			TotalRows:        3,
+			OrganizationIban: "FR10474608000002006107XXXXX",
+			ExecutionDate:    &executionDate,
+			Rows:             model.TransferBulkJobRows{},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		iban   string
+		pgxErr error
+		want   []model.TransferBulkJob
+		err    error
+	}{
+		{
+			name: "scheduled transfer bulk jobs of the bank account listed",
+			iban: "FR10474608000002006107XXXXX",
+			want: []model.TransferBulkJob{job},
+		},
+		{
+			name: "scheduled transfer bulk jobs of all the bank accounts listed",
+			want: []model.TransferBulkJob{job},
+		},
+		{
+			name:   "db error when listing scheduled transfer bulk jobs",
+			pgxErr: sql.ErrConnDone,
+			err:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			query := `
+				SELECT id, idempotency_key, request_hash, status, request, total_rows, organization_iban, execution_date,
+					submitted_by, processed_rows, rows, transfer_bulk_id, debited_cents, balance_cents, currency, fees_cents, error, created_at, updated_at
+				FROM transfer_bulk_jobs
+				WHERE status = $1
+			`
+			args := []driver.Value{model.TransferBulkJobScheduled}
+
+			if tc.iban != "" {
+				query += ` AND organization_iban = $2`
+				args = append(args, tc.iban)
+			}
+
+			meQuery := mock.ExpectQuery(query + ` ORDER BY execution_date, id`).
+				WithArgs(args...)
+
+			if tc.pgxErr == nil {
+				meQuery.WillReturnRows(transferBulkJobRow(sqlmock.NewRows(transferBulkJobColumns), job))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewTransferBulkJob(st).ListScheduled(context.Background(), tc.iban)
+
+			assert.Equal(t, tc.want, got, "ListScheduled() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "ListScheduled() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("ListScheduled() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferBulkJob_Cancel(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 5, 9, 0, 0, 0, time.UTC)
+	id := model.TransferBulkJobID(1)
+
+	tests := []struct {
+		name      string
+		affected  int64
+		pgxErr    error
+		cancelled bool
+		err       error
+	}{
+		{
+			name:      "transfer bulk job cancelled",
+			affected:  1,
+			cancelled: true,
+		},
+		{
+			name: "transfer bulk job not scheduled",
+		},
+		{
+			name:   "db error when cancelling transfer bulk job",
+			pgxErr: sql.ErrConnDone,
+			err:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meExec := mock.ExpectExec(`UPDATE transfer_bulk_jobs SET status = $1, updated_at = $2 WHERE id = $3 AND status = $4`).
+				WithArgs(model.TransferBulkJobCancelled, now, id, model.TransferBulkJobScheduled)
+
+			if tc.pgxErr == nil {
+				meExec.WillReturnResult(sqlmock.NewResult(0, tc.affected))
+			} else {
+				meExec.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			cancelled, err := storage.NewTransferBulkJob(st).Cancel(context.Background(), id, now)
+
+			assert.Equal(t, tc.cancelled, cancelled, "Cancel() got = %v, want %v", cancelled, tc.cancelled)
+			assert.ErrorIsf(t, err, tc.err, "Cancel() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Cancel() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferBulkJob_Claim(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 12, 5, 9, 0, 0, 0, time.UTC)
+	staleBefore := now.Add(-5 * time.Minute)
+
+	job := model.TransferBulkJob{
+		ID: 1,
+		TransferBulkJobState: model.TransferBulkJobState{
+			Status:    model.TransferBulkJobPending,
+			Request:   "{}",
+			TotalRows: 3,
+			Rows:      model.TransferBulkJobRows{},
+			CreatedAt: staleBefore,
+			UpdatedAt: staleBefore,
+		},
+	}
+
+	claimed := job
+	claimed.Status = model.TransferBulkJobProcessing
+	claimed.UpdatedAt = now
+
+	tests := []struct {
+		name      string
+		found     bool
+		pgxErr    error
+		updateErr error
+		want      *model.TransferBulkJob
+		err       error
+	}{
+		{
+			name:  "transfer bulk job claimed",
+			found: true,
+			want:  &claimed,
+		},
+		{
+			name:   "no transfer bulk job to claim",
+			pgxErr: sql.ErrNoRows,
+		},
+		{
+			name:   "db error when finding transfer bulk job to claim",
+			pgxErr: sql.ErrConnDone,
+			err:    sql.ErrConnDone,
+		},
+		{
+			name:      "db error when claiming transfer bulk job",
+			found:     true,
+			updateErr: sql.ErrConnDone,
+			err:       sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			mock.ExpectBegin()
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, idempotency_key, request_hash, status, request, total_rows, organization_iban, execution_date,
+					submitted_by, processed_rows, rows, transfer_bulk_id, debited_cents, balance_cents, currency, fees_cents, error, created_at, updated_at
+				FROM transfer_bulk_jobs
+				WHERE (status = $1 OR (status = $2 AND execution_date <= $3) OR (status = $4 AND updated_at < $5))
+				ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED
+			`).
+				WithArgs(
+					model.TransferBulkJobPending,
+					model.TransferBulkJobScheduled, time.Date(2021, 12, 5, 0, 0, 0, 0, time.UTC),
+					model.TransferBulkJobProcessing, staleBefore,
+				)
+
+			if tc.found {
+				meQuery.WillReturnRows(transferBulkJobRow(sqlmock.NewRows(transferBulkJobColumns), job))
+
+				meExec := mock.ExpectExec(`UPDATE transfer_bulk_jobs SET status = $1, updated_at = $2 WHERE id = $3`).
+					WithArgs(model.TransferBulkJobProcessing, now, job.ID)
+
+				if tc.updateErr != nil {
+					meExec.WillReturnError(tc.updateErr)
+				} else {
+					meExec.WillReturnResult(sqlmock.NewResult(0, 1))
+				}
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			if tc.err != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			var got *model.TransferBulkJob
+
+			err = st.InTx(context.Background(), func(ctx context.Context) error {
+				got, err = storage.NewTransferBulkJob(st).Claim(ctx, now, staleBefore)
+
+				return err
+			})
+
+			assert.Equal(t, tc.want, got, "Claim() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "Claim() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Claim() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferBulkJob_Finish(t *testing.T) {
+	t.Parallel()
+
+	job := model.TransferBulkJob{
+		ID: 1,
+		TransferBulkJobState: model.TransferBulkJobState{
+			Status:         model.TransferBulkJobCompleted,
+			ProcessedRows:  1,
+			Rows:           model.TransferBulkJobRows{{Status: model.TransferBulkJobRowCompleted, TransactionID: 1}},
+			TransferBulkID: 1,
+			DebitedCents:   1000,
+			BalanceCents:   9000,
+			Currency:       "EUR",
+			FeesCents:      50,
+			UpdatedAt:      time.Date(2021, 12, 5, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	tests := []struct {
+		name   string
+		pgxErr error
+		err    error
+	}{
+		{
+			name: "transfer bulk job finished successfully",
+		},
+		{
+			name:   "db error when finishing transfer bulk job",
+			pgxErr: errRowsClosed,
+			err:    errRowsClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectExec(`
+				UPDATE transfer_bulk_jobs
+				SET status = $1, processed_rows = $2, rows = $3, transfer_bulk_id = $4, debited_cents = $5,
+					balance_cents = $6, currency = $7, fees_cents = $8, error = $9, updated_at = $10
+				WHERE id = $11
+			`).
+				WithArgs(
+					job.Status, job.ProcessedRows, `[{"status":"completed","transaction_id":1}]`, job.TransferBulkID,
+					job.DebitedCents, job.BalanceCents, job.Currency, job.FeesCents, job.Error, job.UpdatedAt, job.ID,
+				)
+
+			if tc.pgxErr == nil {
+				meQuery.WillReturnResult(sqlmock.NewResult(0, 1))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			err = storage.NewTransferBulkJob(st).Finish(context.Background(), job)
+
+			assert.ErrorIsf(t, err, tc.err, "Finish() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Finish() expectations were not met = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,385 @@
+package storage_test
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferBulk_Reserve(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		transferBulkState model.TransferBulkState
+		pgxID             *model.TransferBulkID
+		pgxStored         *model.TransferBulk
+	}
+
+	insertedID := model.TransferBulkID(1)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *model.TransferBulk
+		reserved bool
+		wantErr  bool
+		pgxErr   error
+		err      error
+	}{
+		{
+			name: "transfer bulk reserved successfully",
+			args: args{
+				transferBulkState: model.TransferBulkState{
+					IdempotencyKey: "IdempotencyKey",
+					RequestHash:    "RequestHash",
+				},
+				pgxID: &insertedID,
+			},
+			want: &model.TransferBulk{
+				ID: 1,
+				TransferBulkState: model.TransferBulkState{
+					IdempotencyKey: "IdempotencyKey",
+					RequestHash:    "RequestHash",
+				},
+			},
+			reserved: true,
+			wantErr:  false,
+			pgxErr:   nil,
+			err:      nil,
+		},
+		{
+			name: "transfer bulk already reserved",
+			args: args{
+				transferBulkState: model.TransferBulkState{
+					IdempotencyKey: "IdempotencyKey",
+					RequestHash:    "RequestHash",
+				},
+				pgxStored: &model.TransferBulk{
+					ID: 2,
+					TransferBulkState: model.TransferBulkState{
+						IdempotencyKey: "IdempotencyKey",
+						RequestHash:    "OtherRequestHash",
+						BankAccountID:  1,
+						DebitedCents:   1000,
+						BalanceCents:   9000,
+						Currency:       "EUR",
+						Rejections:     model.TransferBulkRejections{{Row: 1, Reason: "not enough balance"}},
+						Status:         model.TransferBulkExecuted,
+					},
+				},
+			},
+			want: &model.TransferBulk{
+				ID: 2,
+				TransferBulkState: model.TransferBulkState{
+					IdempotencyKey: "IdempotencyKey",
+					RequestHash:    "OtherRequestHash",
+					BankAccountID:  1,
+					DebitedCents:   1000,
+					BalanceCents:   9000,
+					Currency:       "EUR",
+					Rejections:     model.TransferBulkRejections{{Row: 1, Reason: "not enough balance"}},
+					Status:         model.TransferBulkExecuted,
+					ScreeningHits:  model.ScreeningHits{},
+				},
+			},
+			reserved: false,
+			wantErr:  false,
+			pgxErr:   nil,
+			err:      nil,
+		},
+		{
+			name: "db error when reserving transfer bulk",
+			args: args{
+				transferBulkState: model.TransferBulkState{
+					IdempotencyKey: "IdempotencyKey",
+					RequestHash:    "RequestHash",
+				},
+			},
+			want:     nil,
+			reserved: false,
+			wantErr:  true,
+			pgxErr:   sql.ErrTxDone,
+			err:      ctxd.WrapError(context.Background(), sql.ErrTxDone, "storage.TransferBulk: failed to reserve transfer bulk"),
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				INSERT INTO transfer_bulks (idempotency_key,request_hash)
+				VALUES ($1,$2)
+				ON CONFLICT (idempotency_key) WHERE idempotency_key <> '' DO NOTHING RETURNING id
+			`).
+				WithArgs(tc.args.transferBulkState.IdempotencyKey, tc.args.transferBulkState.RequestHash)
+
+			switch {
+			case tc.args.pgxID != nil:
+				meQuery.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(*tc.args.pgxID))
+			case tc.args.pgxStored != nil:
+				meQuery.WillReturnError(sql.ErrNoRows)
+
+				rejections, err := tc.args.pgxStored.Rejections.Value()
+				require.NoError(t, err)
+
+				hits, err := tc.args.pgxStored.ScreeningHits.Value()
+				require.NoError(t, err)
+
+				rows := sqlmock.NewRows([]string{"id", "idempotency_key", "request_hash", "bank_account_id", "debited_cents", "fees_cents", "balance_cents", "currency", "rejections", "status", "request", "submitted_by", "screening_hits"}).
+					AddRow(
+						tc.args.pgxStored.ID, tc.args.pgxStored.IdempotencyKey, tc.args.pgxStored.RequestHash,
+						tc.args.pgxStored.BankAccountID, tc.args.pgxStored.DebitedCents, tc.args.pgxStored.FeesCents, tc.args.pgxStored.BalanceCents,
+						tc.args.pgxStored.Currency, rejections, tc.args.pgxStored.Status, tc.args.pgxStored.Request, tc.args.pgxStored.SubmittedBy,
+						hits,
+					)
+
+				mock.ExpectQuery(`
+					SELECT id, idempotency_key, request_hash, bank_account_id, debited_cents, fees_cents, balance_cents, currency, rejections, status, request, submitted_by, screening_hits
+					FROM transfer_bulks
+					WHERE idempotency_key = $1
+				`).
+					WithArgs(tc.args.transferBulkState.IdempotencyKey).
+					WillReturnRows(rows)
+			default:
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewTransferBulk(st)
+
+			got, reserved, err := r.Reserve(context.Background(), tc.args.transferBulkState)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Reserve() error = %v, wantErr %v", err, tc.wantErr)
+
+				return
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Reserve() got = %v, want %v", got, tc.want)
+			}
+
+			assert.Equal(t, tc.reserved, reserved, "Reserve() got reserved = %v, want %v", reserved, tc.reserved)
+
+			assert.ErrorIsf(t, tc.err, err, "Reserve() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Reserve() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferBulk_Lock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		pgxResult *model.TransferBulk
+		pgxErr    error
+		err       error
+	}{
+		{
+			name: "transfer bulk locked",
+			pgxResult: &model.TransferBulk{
+				ID: 1,
+				TransferBulkState: model.TransferBulkState{
+					IdempotencyKey: "IdempotencyKey",
+					RequestHash:    "RequestHash",
+					BankAccountID:  1,
+					DebitedCents:   1000,
+					BalanceCents:   10000,
+					Currency:       "EUR",
+					Rejections:     model.TransferBulkRejections{},
+					Status:         model.TransferBulkHeldForReview,
+					Request:        `{"OrganizationName":"ACME Corp"}`,
+					SubmittedBy:    "alice",
+					ScreeningHits: model.ScreeningHits{
+						{Row: 0, EntryID: "SDN-1", EntryName: "Evil Corp", MatchedOn: model.ScreeningName, Score: 0.91},
+					},
+				},
+			},
+		},
+		{
+			name:   "transfer bulk does not exists",
+			pgxErr: sql.ErrNoRows,
+			err:    storage.ErrNotFound,
+		},
+		{
+			name:   "db error when locking transfer bulk",
+			pgxErr: sql.ErrTxDone,
+			err:    sql.ErrTxDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, idempotency_key, request_hash, bank_account_id, debited_cents, fees_cents, balance_cents, currency, rejections, status, request, submitted_by, screening_hits
+				FROM transfer_bulks
+				WHERE id = $1 FOR UPDATE
+			`).
+				WithArgs(1)
+
+			if tc.pgxErr == nil {
+				b := tc.pgxResult
+
+				rejections, err := b.Rejections.Value()
+				require.NoError(t, err)
+
+				hits, err := b.ScreeningHits.Value()
+				require.NoError(t, err)
+
+				meQuery.WillReturnRows(
+					sqlmock.NewRows([]string{"id", "idempotency_key", "request_hash", "bank_account_id", "debited_cents", "fees_cents", "balance_cents", "currency", "rejections", "status", "request", "submitted_by", "screening_hits"}).
+						AddRow(
+							b.ID, b.IdempotencyKey, b.RequestHash, b.BankAccountID, b.DebitedCents, b.FeesCents, b.BalanceCents,
+							b.Currency, rejections, b.Status, b.Request, b.SubmittedBy, hits,
+						),
+				)
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewTransferBulk(st)
+
+			got, err := r.Lock(context.Background(), 1)
+
+			assert.Equal(t, tc.pgxResult, got, "Lock() got = %v, want %v", got, tc.pgxResult)
+			assert.ErrorIsf(t, err, tc.err, "Lock() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Lock() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferBulk_Complete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		transferBulk model.TransferBulk
+		wantErr      bool
+		pgxErr       error
+		err          error
+	}{
+		{
+			name: "transfer bulk completed successfully",
+			transferBulk: model.TransferBulk{
+				ID: 1,
+				TransferBulkState: model.TransferBulkState{
+					BankAccountID: 1,
+					DebitedCents:  1000,
+					BalanceCents:  9000,
+					Currency:      "EUR",
+					Rejections:    model.TransferBulkRejections{{Row: 1, Reason: "not enough balance"}},
+					Status:        model.TransferBulkHeldForReview,
+					Request:       `{"OrganizationName":"ACME Corp"}`,
+					SubmittedBy:   "alice",
+					ScreeningHits: model.ScreeningHits{
+						{Row: 0, EntryID: "SDN-1", EntryName: "Evil Corp", MatchedOn: model.ScreeningName, Score: 0.91},
+					},
+				},
+			},
+			wantErr: false,
+			pgxErr:  nil,
+			err:     nil,
+		},
+		{
+			name: "db error when completing transfer bulk",
+			transferBulk: model.TransferBulk{
+				ID: 1,
+				TransferBulkState: model.TransferBulkState{
+					BankAccountID: 1,
+					DebitedCents:  1000,
+					BalanceCents:  9000,
+					Currency:      "EUR",
+					Rejections:    model.TransferBulkRejections{{Row: 1, Reason: "not enough balance"}},
+				},
+			},
+			wantErr: true,
+			pgxErr:  errRowsClosed,
+			err:     ctxd.WrapError(context.Background(), errRowsClosed, "storage.TransferBulk: failed to complete transfer bulk"),
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			hits, err := tc.transferBulk.ScreeningHits.Value()
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectExec(`
+				UPDATE transfer_bulks
+				SET bank_account_id = $1, debited_cents = $2, fees_cents = $3, balance_cents = $4, currency = $5, rejections = $6, status = $7, request = $8, submitted_by = $9, screening_hits = $10
+				WHERE id = $11
+			`).
+				WithArgs(
+					tc.transferBulk.BankAccountID,
+					tc.transferBulk.DebitedCents,
+					tc.transferBulk.FeesCents,
+					tc.transferBulk.BalanceCents,
+					tc.transferBulk.Currency,
+					`[{"row":1,"reason":"not enough balance"}]`,
+					tc.transferBulk.Status,
+					tc.transferBulk.Request,
+					tc.transferBulk.SubmittedBy,
+					hits,
+					tc.transferBulk.ID,
+				)
+
+			if tc.pgxErr == nil {
+				meQuery.WillReturnResult(sqlmock.NewResult(0, 1))
+			} else {
+				meQuery.WillReturnError(tc.pgxErr)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			r := storage.NewTransferBulk(st)
+
+			if err = r.Complete(context.Background(), tc.transferBulk); (err != nil) != tc.wantErr {
+				t.Errorf("Complete() error = %v, wantErr %v", err, tc.wantErr)
+			}
+
+			assert.ErrorIsf(t, tc.err, err, "Complete() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Complete() expectations were not met = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/bool64/ctxd"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+)
+
+const transferLimitTable = "transfer_limits"
+
+// TransferLimit represents a TransferLimit repository.
+type TransferLimit struct {
+	storage *sqluct.Storage
+
+	colID               string
+	colBankAccountID    string
+	colOrganizationName string
+	colLimits           []string
+}
+
+// NewTransferLimit returns instance of TransferLimit.
+func NewTransferLimit(storage *sqluct.Storage) *TransferLimit {
+	var limit model.TransferLimit
+
+	return &TransferLimit{
+		storage:             storage,
+		colID:               storage.Mapper.Col(&limit, &limit.ID),
+		colBankAccountID:    storage.Mapper.Col(&limit, &limit.BankAccountID),
+		colOrganizationName: storage.Mapper.Col(&limit, &limit.OrganizationName),
+		colLimits: []string{
+			storage.Mapper.Col(&limit, &limit.MaxTransferCents),
+			storage.Mapper.Col(&limit, &limit.MaxBatchCents),
+			storage.Mapper.Col(&limit, &limit.DailyCents),
+			storage.Mapper.Col(&limit, &limit.MonthlyCents),
+			storage.Mapper.Col(&limit, &limit.MaxTransfers),
+			storage.Mapper.Col(&limit, &limit.WindowSeconds),
+			storage.Mapper.Col(&limit, &limit.UpdatedAt),
+		},
+	}
+}
+
+// FindForAccount finds the limits of the bank account, and of the organization of the bank account, from a storage.
+func (r *TransferLimit) FindForAccount(ctx context.Context, account model.BankAccount) ([]model.TransferLimit, error) {
+	errMsg := "storage.TransferLimit: failed to find transfer limits"
+
+	var limits []model.TransferLimit
+
+	q := r.storage.SelectStmt(transferLimitTable, limits).
+		Where(squirrel.Or{
+			squirrel.Eq{r.colBankAccountID: account.ID},
+			squirrel.Eq{r.colOrganizationName: account.OrganizationName},
+		}).
+		OrderBy(r.colID)
+
+	err := r.storage.Select(ctx, q, &limits)
+	if err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+			"bank_account_id", account.ID,
+		)
+	}
+
+	return limits, nil
+}
+
+// Set sets the limits of the bank account, or of the organization, replacing the limits set before.
+func (r *TransferLimit) Set(ctx context.Context, limitState model.TransferLimitState) (*model.TransferLimit, error) {
+	errMsg := "storage.TransferLimit: failed to set transfer limits"
+
+	limit := model.TransferLimit{
+		TransferLimitState: limitState,
+	}
+
+	target := r.colBankAccountID + ") WHERE " + r.colBankAccountID + " <> 0"
+	if limitState.BankAccountID == 0 {
+		target = r.colOrganizationName + ") WHERE " + r.colOrganizationName + " <> ''"
+	}
+
+	updates := make([]string, len(r.colLimits))
+
+	for i, col := range r.colLimits {
+		updates[i] = fmt.Sprintf("%s = excluded.%s", col, col)
+	}
+
+	q := r.storage.InsertStmt(transferLimitTable, limitState).
+		Suffix("ON CONFLICT (" + target + " DO UPDATE SET " + strings.Join(updates, ", ") + " RETURNING " + r.colID)
+
+	if err := r.storage.Select(ctx, q, &limit.ID); err != nil {
+		return nil, ctxd.WrapError(
+			ctx,
+			err,
+			errMsg,
+		)
+	}
+
+	return &limit, nil
+}
@@ -0,0 +1,188 @@
+package storage_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/bool64/sqluct"
+	"github.com/dohernandez/qonto/internal/domain/model"
+	"github.com/dohernandez/qonto/internal/platform/storage"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTransferLimit(id model.TransferLimitID, bankAccountID model.BankAccountID, organizationName string) model.TransferLimit {
+	return model.TransferLimit{
+		ID: id,
+		TransferLimitState: model.TransferLimitState{
+			BankAccountID:    bankAccountID,
+			OrganizationName: organizationName,
+			MaxTransferCents: 100000,
+			DailyCents:       500000,
+			MaxTransfers:     10,
+			WindowSeconds:    3600,
+			UpdatedAt:        time.Date(2021, 12, 15, 9, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestTransferLimit_FindForAccount(t *testing.T) {
+	t.Parallel()
+
+	account := model.BankAccount{
+		ID: 1,
+		BankAccountState: model.BankAccountState{
+			OrganizationName: "ACME Corp",
+		},
+	}
+
+	accountLimit := testTransferLimit(1, 1, "")
+	organizationLimit := testTransferLimit(2, 0, "ACME Corp")
+
+	tests := []struct {
+		name   string
+		pgxErr error
+		want   []model.TransferLimit
+		err    error
+	}{
+		{
+			name: "transfer limits found successfully",
+			want: []model.TransferLimit{accountLimit, organizationLimit},
+		},
+		{
+			name:   "db error when finding transfer limits",
+			pgxErr: sql.ErrTxDone,
+			err:    sql.ErrTxDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			meQuery := mock.ExpectQuery(`
+				SELECT id, bank_account_id, organization_name, max_transfer_cents, max_batch_cents, daily_cents, monthly_cents, max_transfers, window_seconds, updated_at
+				FROM transfer_limits
+				WHERE (bank_account_id = $1 OR organization_name = $2)
+				ORDER BY id
+			`).
+				WithArgs(account.ID, account.OrganizationName)
+
+			if tc.pgxErr != nil {
+				meQuery.WillReturnError(tc.pgxErr)
+			} else {
+				rows := sqlmock.NewRows([]string{
+					"id", "bank_account_id", "organization_name", "max_transfer_cents", "max_batch_cents", "daily_cents",
+					"monthly_cents", "max_transfers", "window_seconds", "updated_at",
+				})
+
+				for _, l := range tc.want {
+					rows.AddRow(
+						l.ID, l.BankAccountID, l.OrganizationName, l.MaxTransferCents, l.MaxBatchCents, l.DailyCents,
+						l.MonthlyCents, l.MaxTransfers, l.WindowSeconds, l.UpdatedAt,
+					)
+				}
+
+				meQuery.WillReturnRows(rows)
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewTransferLimit(st).FindForAccount(context.Background(), account)
+
+			assert.Equal(t, tc.want, got, "FindForAccount() got = %v, want %v", got, tc.want)
+			assert.ErrorIsf(t, err, tc.err, "FindForAccount() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("FindForAccount() expectations were not met = %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferLimit_Set(t *testing.T) {
+	t.Parallel()
+
+	accountLimit := testTransferLimit(1, 1, "")
+	organizationLimit := testTransferLimit(2, 0, "ACME Corp")
+
+	tests := []struct {
+		name   string
+		limit  model.TransferLimit
+		target string
+		pgxErr error
+		err    error
+	}{
+		{
+			name:   "bank account transfer limits set successfully",
+			limit:  accountLimit,
+			target: "(bank_account_id) WHERE bank_account_id <> 0",
+		},
+		{
+			name:   "organization transfer limits set successfully",
+			limit:  organizationLimit,
+			target: "(organization_name) WHERE organization_name <> ''",
+		},
+		{
+			name:   "db error when setting transfer limits",
+			limit:  accountLimit,
+			target: "(bank_account_id) WHERE bank_account_id <> 0",
+			pgxErr: sql.ErrTxDone,
+			err:    sql.ErrTxDone,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+
+			l := tc.limit
+
+			meQuery := mock.ExpectQuery(`
+				INSERT INTO transfer_limits (bank_account_id,organization_name,max_transfer_cents,max_batch_cents,daily_cents,monthly_cents,max_transfers,window_seconds,updated_at)
+				VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+				ON CONFLICT `+tc.target+` DO UPDATE SET max_transfer_cents = excluded.max_transfer_cents, max_batch_cents = excluded.max_batch_cents, daily_cents = excluded.daily_cents, monthly_cents = excluded.monthly_cents, max_transfers = excluded.max_transfers, window_seconds = excluded.window_seconds, updated_at = excluded.updated_at
+				RETURNING id
+			`).
+				WithArgs(
+					l.BankAccountID, l.OrganizationName, l.MaxTransferCents, l.MaxBatchCents, l.DailyCents,
+					l.MonthlyCents, l.MaxTransfers, l.WindowSeconds, l.UpdatedAt,
+				)
+
+			var want *model.TransferLimit
+
+			if tc.pgxErr != nil {
+				meQuery.WillReturnError(tc.pgxErr)
+			} else {
+				meQuery.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(l.ID))
+
+				want = &l
+			}
+
+			st := sqluct.NewStorage(sqlx.NewDb(db, "sqlmock"))
+
+			got, err := storage.NewTransferLimit(st).Set(context.Background(), l.TransferLimitState)
+
+			assert.Equal(t, want, got, "Set() got = %v, want %v", got, want)
+			assert.ErrorIsf(t, err, tc.err, "Set() err got = %v, want %v", err, tc.err)
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Set() expectations were not met = %v", err)
+			}
+		})
+	}
+}
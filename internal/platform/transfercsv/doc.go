@@ -0,0 +1,2 @@
+// Package transfercsv reads credit transfers from CSV files.
+package transfercsv
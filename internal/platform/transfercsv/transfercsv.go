@@ -0,0 +1,272 @@
+package transfercsv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+)
+
+// The fields of a credit transfer, also the default column names.
+const (
+	FieldAmount           = "amount"
+	FieldCurrency         = "currency"
+	FieldCounterpartyName = "counterparty_name"
+	FieldCounterpartyBic  = "counterparty_bic"
+	FieldCounterpartyIban = "counterparty_iban"
+	FieldDescription      = "description"
+)
+
+// fields are the fields of a credit transfer, in the order of TransactionBulkTransferInput.
+var fields = []string{
+	FieldAmount, FieldCurrency, FieldCounterpartyName, FieldCounterpartyBic, FieldCounterpartyIban, FieldDescription,
+}
+
+// creditTransferField matches the path of a credit transfer field violation, e.g. "credit_transfers[2].amount".
+var creditTransferField = regexp.MustCompile(`^credit_transfers\[(\d+)\]\.(\w+)$`)
+
+// Mapping maps the fields of a credit transfer to the CSV column names.
+type Mapping map[string]string
+
+// DefaultMapping returns the mapping of the columns named as the fields.
+func DefaultMapping() Mapping {
+	m := make(Mapping, len(fields))
+
+	for _, f := range fields {
+		m[f] = f
+	}
+
+	return m
+}
+
+// ParseMapping parses the mapping of the fields to the column names, e.g. "amount=Montant,currency=Devise".
+//
+// The fields not mapped keep their default column name.
+func ParseMapping(s string) (Mapping, error) {
+	m := DefaultMapping()
+
+	if strings.TrimSpace(s) == "" {
+		return m, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		field := strings.TrimSpace(kv[0])
+
+		if _, ok := m[field]; !ok || len(kv) != 2 || strings.TrimSpace(kv[1]) == "" {
+			return nil, fmt.Errorf("invalid column mapping %q, expected field=column with field one of %s",
+				pair, strings.Join(fields, ", "))
+		}
+
+		m[field] = strings.TrimSpace(kv[1])
+	}
+
+	return m, nil
+}
+
+// Parser reads credit transfers from a CSV with a header line.
+type Parser struct {
+	Mapping Mapping
+	// Comma is the field delimiter, ',' when zero.
+	Comma rune
+}
+
+// Parse reads the credit transfers, and the lines of the CSV they were read from.
+//
+// Returns a *usecase.ValidationError with the line-level violations when the CSV can not be read, the fields being
+// "header" or "lines[N]" with N the line number.
+func (p Parser) Parse(r io.Reader) ([]usecase.TransactionBulkTransferInput, []int, error) {
+	mapping := p.Mapping
+	if mapping == nil {
+		mapping = DefaultMapping()
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	if p.Comma != 0 {
+		cr.Comma = p.Comma
+	}
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = errors.New("must not be empty")
+		}
+
+		return nil, nil, violation("header", err)
+	}
+
+	columns := make(map[string]int, len(header))
+
+	for i, name := range header {
+		// a byte order mark is written by spreadsheet tools before the first column name.
+		columns[strings.TrimPrefix(strings.TrimSpace(name), "\ufeff")] = i
+	}
+
+	indexes := make([]int, len(fields))
+
+	var verr usecase.ValidationError
+
+	for i, f := range fields {
+		idx, ok := columns[mapping[f]]
+		if !ok {
+			verr.Violations = append(verr.Violations, usecase.FieldViolation{
+				Field:       "header",
+				Description: fmt.Sprintf("missing column %q of field %s", mapping[f], f),
+			})
+		}
+
+		indexes[i] = idx
+	}
+
+	if len(verr.Violations) > 0 {
+		return nil, nil, &verr
+	}
+
+	var (
+		transfers []usecase.TransactionBulkTransferInput
+		lines     []int
+	)
+
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		line, _ := cr.FieldPos(0)
+
+		if err != nil {
+			var perr *csv.ParseError
+			if errors.As(err, &perr) {
+				line = perr.Line
+				err = perr.Err
+			}
+
+			verr.Violations = append(verr.Violations, usecase.FieldViolation{
+				Field:       lineField(line, ""),
+				Description: err.Error(),
+			})
+
+			// the reader can not recover from a syntax error, e.g. a bare quote.
+			break
+		}
+
+		if isBlank(record) {
+			continue
+		}
+
+		if len(record) != len(header) {
+			verr.Violations = append(verr.Violations, usecase.FieldViolation{
+				Field:       lineField(line, ""),
+				Description: fmt.Sprintf("has %d columns, expected %d", len(record), len(header)),
+			})
+
+			continue
+		}
+
+		values := make([]string, len(fields))
+
+		for i, idx := range indexes {
+			values[i] = strings.TrimSpace(record[idx])
+		}
+
+		transfers = append(transfers, usecase.TransactionBulkTransferInput{
+			Amount:           values[0],
+			Currency:         strings.ToUpper(values[1]),
+			CounterpartyName: values[2],
+			CounterpartyBic:  values[3],
+			CounterpartyIban: values[4],
+			Description:      values[5],
+		})
+		lines = append(lines, line)
+	}
+
+	if len(verr.Violations) > 0 {
+		return nil, nil, &verr
+	}
+
+	return transfers, lines, nil
+}
+
+// LineViolations rewrites the credit transfer field violations as the lines and columns of the CSV they were read from.
+func (p Parser) LineViolations(violations []usecase.FieldViolation, lines []int) []usecase.FieldViolation {
+	mapping := p.Mapping
+	if mapping == nil {
+		mapping = DefaultMapping()
+	}
+
+	rewritten := make([]usecase.FieldViolation, len(violations))
+
+	for i, v := range violations {
+		rewritten[i] = v
+
+		m := creditTransferField.FindStringSubmatch(v.Field)
+		if m == nil {
+			continue
+		}
+
+		idx, err := strconv.Atoi(m[1])
+		if err != nil || idx >= len(lines) {
+			continue
+		}
+
+		column, ok := mapping[m[2]]
+		if !ok {
+			column = m[2]
+		}
+
+		rewritten[i].Field = lineField(lines[idx], column)
+	}
+
+	return rewritten
+}
+
+// ParseComma parses the field delimiter, a single character.
+func ParseComma(s string) (rune, error) {
+	if s == "" {
+		return ',', nil
+	}
+
+	if utf8.RuneCountInString(s) != 1 {
+		return 0, fmt.Errorf("invalid delimiter %q, expected a single character", s)
+	}
+
+	r, _ := utf8.DecodeRuneInString(s)
+
+	return r, nil
+}
+
+func lineField(line int, column string) string {
+	field := fmt.Sprintf("lines[%d]", line)
+
+	if column != "" {
+		field += "." + column
+	}
+
+	return field
+}
+
+func isBlank(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func violation(field string, err error) *usecase.ValidationError {
+	return &usecase.ValidationError{
+		Violations: []usecase.FieldViolation{{Field: field, Description: err.Error()}},
+	}
+}
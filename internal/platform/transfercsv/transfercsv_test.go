@@ -0,0 +1,134 @@
+package transfercsv_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dohernandez/qonto/internal/domain/usecase"
+	"github.com/dohernandez/qonto/internal/platform/transfercsv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser_Parse(t *testing.T) {
+	t.Parallel()
+
+	mapping, err := transfercsv.ParseMapping("amount=Montant, currency=Devise,counterparty_name=Bénéficiaire")
+	require.NoError(t, err)
+
+	parser := transfercsv.Parser{Mapping: mapping, Comma: ';'}
+
+	transfers, lines, err := parser.Parse(strings.NewReader("\ufeffBénéficiaire;counterparty_iban;counterparty_bic;Montant;Devise;description;notes\n" +
+		"Bip Bip;EE303680981021245685;CRLYFRPPTOU;14.50;eur;Wonderland/4410;\n" +
+		"\n" +
+		"Bugs Bunny;FR9810009380540930414023042;RNJZNTMC;999;EUR;\"2020 09 24; GoldenCarrot\";first\n",
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, []usecase.TransactionBulkTransferInput{
+		{
+			Amount:           "14.50",
+			Currency:         "EUR",
+			CounterpartyName: "Bip Bip",
+			CounterpartyBic:  "CRLYFRPPTOU",
+			CounterpartyIban: "EE303680981021245685",
+			Description:      "Wonderland/4410",
+		},
+		{
+			Amount:           "999",
+			Currency:         "EUR",
+			CounterpartyName: "Bugs Bunny",
+			CounterpartyBic:  "RNJZNTMC",
+			CounterpartyIban: "FR9810009380540930414023042",
+			Description:      "2020 09 24; GoldenCarrot",
+		},
+	}, transfers)
+	assert.Equal(t, []int{2, 4}, lines)
+}
+
+func TestParser_Parse_invalid(t *testing.T) {
+	t.Parallel()
+
+	header := "amount,currency,counterparty_name,counterparty_bic,counterparty_iban,description\n"
+
+	for _, tc := range []struct {
+		scenario   string
+		csv        string
+		violations []usecase.FieldViolation
+	}{
+		{
+			scenario: "empty file",
+			csv:      "",
+			violations: []usecase.FieldViolation{
+				{Field: "header", Description: "must not be empty"},
+			},
+		},
+		{
+			scenario: "missing columns",
+			csv:      "amount,currency,counterparty_name,counterparty_iban\n",
+			violations: []usecase.FieldViolation{
+				{Field: "header", Description: `missing column "counterparty_bic" of field counterparty_bic`},
+				{Field: "header", Description: `missing column "description" of field description`},
+			},
+		},
+		{
+			scenario: "lines with a wrong number of columns",
+			csv: header +
+				"14.50,EUR,Bip Bip,CRLYFRPPTOU,EE303680981021245685\n" +
+				"999,EUR,Bugs Bunny,RNJZNTMC,FR9810009380540930414023042,GoldenCarrot\n" +
+				"61238,EUR,Wile E Coyote,ZDRPLBQI,DE44354208100362090817,Invoice,12\n",
+			violations: []usecase.FieldViolation{
+				{Field: "lines[2]", Description: "has 5 columns, expected 6"},
+				{Field: "lines[4]", Description: "has 7 columns, expected 6"},
+			},
+		},
+		{
+			scenario: "bare quote",
+			csv:      header + "14.50,EUR,Bip \"Bip\",CRLYFRPPTOU,EE303680981021245685,Wonderland\n",
+			violations: []usecase.FieldViolation{
+				{Field: "lines[2]", Description: `bare " in non-quoted-field`},
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := transfercsv.Parser{}.Parse(strings.NewReader(tc.csv))
+
+			var verr *usecase.ValidationError
+
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tc.violations, verr.Violations)
+		})
+	}
+}
+
+func TestParseMapping_invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, mapping := range []string{"amount", "amount=", "iban=IBAN"} {
+		_, err := transfercsv.ParseMapping(mapping)
+		assert.Error(t, err, mapping)
+	}
+}
+
+func TestParser_LineViolations(t *testing.T) {
+	t.Parallel()
+
+	mapping, err := transfercsv.ParseMapping("amount=Montant")
+	require.NoError(t, err)
+
+	violations := transfercsv.Parser{Mapping: mapping}.LineViolations([]usecase.FieldViolation{
+		{Field: "organization_iban", Description: "must not be empty"},
+		{Field: "credit_transfers[0].amount", Description: "invalid amount"},
+		{Field: "credit_transfers[1].counterparty_iban", Description: "invalid IBAN"},
+	}, []int{2, 5})
+
+	assert.Equal(t, []usecase.FieldViolation{
+		{Field: "organization_iban", Description: "must not be empty"},
+		{Field: "lines[2].Montant", Description: "invalid amount"},
+		{Field: "lines[5].counterparty_iban", Description: "invalid IBAN"},
+	}, violations)
+}
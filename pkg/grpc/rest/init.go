@@ -12,12 +12,15 @@ import (
 )
 
 type InitRESTServiceConfig struct {
-	Listener         net.Listener
-	Service          ServiceServer
-	UInterceptor     []grpc.UnaryServerInterceptor
-	Handlers         []HandlerPathOption
-	Options          []Option
-	ResponseModifier func(context.Context, http.ResponseWriter, proto.Message) error
+	Listener              net.Listener
+	Service               ServiceServer
+	UInterceptor          []grpc.UnaryServerInterceptor
+	Handlers              []HandlerPathOption
+	Options               []Option
+	ResponseModifier      func(context.Context, http.ResponseWriter, proto.Message) error
+	IncomingHeaderMatcher mux.HeaderMatcherFunc
+	// Marshalers are the marshalers by MIME type, besides the default one.
+	Marshalers map[string]mux.Marshaler
 }
 
 // InitRESTService initialize an instance of REST service based on the GRPC service.
@@ -53,5 +56,21 @@ func InitRESTService(
 		)
 	}
 
+	if cfg.IncomingHeaderMatcher != nil {
+		opts = append(opts,
+			WithServerMuxOption(
+				mux.WithIncomingHeaderMatcher(cfg.IncomingHeaderMatcher),
+			),
+		)
+	}
+
+	for mime, marshaler := range cfg.Marshalers {
+		opts = append(opts,
+			WithServerMuxOption(
+				mux.WithMarshalerOption(mime, marshaler),
+			),
+		)
+	}
+
 	return NewServer(opts...)
 }
@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"io"
+
+	mux "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// RawBodyMarshaler decodes the raw request body into a bytes field, for the methods which body is a file, e.g. XML.
+//
+// Any other value is decoded, and the responses encoded, by the embedded Marshaler.
+type RawBodyMarshaler struct {
+	mux.Marshaler
+}
+
+// NewRawBodyMarshaler returns instance of RawBodyMarshaler embedding the gateway default JSON marshaler.
+func NewRawBodyMarshaler() *RawBodyMarshaler {
+	return &RawBodyMarshaler{
+		Marshaler: &mux.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		},
+	}
+}
+
+// NewDecoder returns a Decoder reading the whole body into a *[]byte, or delegating to the embedded Marshaler.
+func (m *RawBodyMarshaler) NewDecoder(r io.Reader) mux.Decoder {
+	return mux.DecoderFunc(func(v interface{}) error {
+		raw, ok := v.(*[]byte)
+		if !ok {
+			return m.Marshaler.NewDecoder(r).Decode(v)
+		}
+
+		body, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+
+		*raw = body
+
+		return nil
+	})
+}
@@ -0,0 +1,28 @@
+package rest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dohernandez/qonto/pkg/grpc/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestRawBodyMarshaler_NewDecoder(t *testing.T) {
+	t.Parallel()
+
+	m := rest.NewRawBodyMarshaler()
+
+	var raw []byte
+
+	require.NoError(t, m.NewDecoder(strings.NewReader("<Document/>")).Decode(&raw))
+	assert.Equal(t, "<Document/>", string(raw))
+
+	var msg wrapperspb.StringValue
+
+	require.NoError(t, m.NewDecoder(strings.NewReader(`"value"`)).Decode(&msg))
+	assert.Equal(t, "value", msg.Value)
+	assert.Equal(t, "application/json", m.ContentType(&msg))
+}
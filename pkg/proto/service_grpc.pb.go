@@ -7,7 +7,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -24,8 +23,138 @@ type QontoServiceClient interface {
 	// Receives a request with bulk of transfer to perform. Responses whether the transfer were done successfully or not, due to:
 	// - account not found
 	// - not enough funds in the account
+	// - transfer limit of the account, or of its organization, exceeded
+	// - idempotency key already used with a different request
+	// - invalid request, the field violations are returned as google.rpc.BadRequest details
 	// - internal server.
-	TransferBulk(ctx context.Context, in *TransferBulkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	//
+	// The idempotency key can be sent either in the request or in the `Idempotency-Key` header. Replaying a request with
+	// the same idempotency key returns the original outcome without performing the transfers again.
+	//
+	// Asynchronous requests are validated and accepted as a job, responding only the job id and status. The transfers
+	// are performed in background, the job outcome is polled with GetTransferBulkJob.
+	//
+	// Validate-only requests run the same account lookup, validation and balance check without performing the
+	// transfers, responding the amount that would be debited, the balance after and the rows that would be executed.
+	TransferBulk(ctx context.Context, in *TransferBulkRequest, opts ...grpc.CallOption) (*TransferBulkResponse, error)
+	// ImportTransferBulk performs the transfers of a SEPA pain.001.001.03 credit transfer initiation file.
+	//
+	// The debtor account of the file is the organization account and each CdtTrfTxInf is a credit transfer. The number
+	// of transactions (NbOfTxs) and the control sums (CtrlSum) of the group header and of the payment information blocks
+	// must match the credit transfers. The file message id (MsgId) is the idempotency key when none is sent.
+	//
+	// Responses as TransferBulk. The file is sent as the raw request body with the `Content-Type: application/xml`
+	// header, or base64 encoded as a JSON string.
+	ImportTransferBulk(ctx context.Context, in *ImportTransferBulkRequest, opts ...grpc.CallOption) (*TransferBulkResponse, error)
+	// GetTransferBulkJob returns the transfer bulk job.
+	//
+	// Responses the job status and the outcome of each credit transfer once processed.
+	GetTransferBulkJob(ctx context.Context, in *GetTransferBulkJobRequest, opts ...grpc.CallOption) (*GetTransferBulkJobResponse, error)
+	// ListScheduledTransferBulks lists the transfer bulks scheduled with a requested execution date and not yet performed.
+	//
+	// Responses the scheduled jobs by execution date, the jobs of the bank account when the organization iban is set.
+	ListScheduledTransferBulks(ctx context.Context, in *ListScheduledTransferBulksRequest, opts ...grpc.CallOption) (*ListScheduledTransferBulksResponse, error)
+	// CancelScheduledTransferBulk cancels the scheduled transfer bulk, its transfers are not performed.
+	//
+	// Responses the cancelled job. Only scheduled jobs can be cancelled, once the execution date is reached the job is
+	// processed as any other job. Cancelling a cancelled job responses the job as is.
+	CancelScheduledTransferBulk(ctx context.Context, in *CancelScheduledTransferBulkRequest, opts ...grpc.CallOption) (*GetTransferBulkJobResponse, error)
+	// ReverseTransferBulk reverses the transactions performed by the transfer bulk.
+	//
+	// Each transaction is credited back its amount not reversed yet, the transactions already reversed are left as they
+	// are. Responses the reversals and the account balance after.
+	ReverseTransferBulk(ctx context.Context, in *ReverseTransferBulkRequest, opts ...grpc.CallOption) (*ReversalResponse, error)
+	// ApproveTransferBulk performs the transfers of the transfer bulk awaiting approval, or held for review.
+	//
+	// The transfer bulk must be approved by a user other than the one who submitted it, identified by the X-User-Id
+	// header asserted by a trusted proxy. The transfers are performed as if just requested, checking the balance and
+	// the limits. Responses the performed transfer bulk.
+	ApproveTransferBulk(ctx context.Context, in *ApproveTransferBulkRequest, opts ...grpc.CallOption) (*TransferBulkResponse, error)
+	// RejectTransferBulk rejects the transfer bulk awaiting approval, or held for review, its transfers are not performed.
+	//
+	// The transfer bulk must be rejected by a user other than the one who submitted it, identified by the X-User-Id
+	// header asserted by a trusted proxy. Responses the rejected transfer bulk.
+	RejectTransferBulk(ctx context.Context, in *RejectTransferBulkRequest, opts ...grpc.CallOption) (*TransferBulkResponse, error)
+	// CreateStandingOrder creates a standing order, a credit transfer performed repeatedly.
+	//
+	// The credit transfer is performed daily, weekly on the weekday of the start date, or monthly on the day of the
+	// month, from the start date until the end date or the number of executions is reached. On each execution date, the
+	// credit transfer is accepted as a transfer bulk job, see GetTransferBulkJob.
+	CreateStandingOrder(ctx context.Context, in *CreateStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error)
+	// GetStandingOrder returns the standing order.
+	GetStandingOrder(ctx context.Context, in *GetStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error)
+	// ListStandingOrders lists the standing orders of the bank account, but the deleted ones.
+	ListStandingOrders(ctx context.Context, in *ListStandingOrdersRequest, opts ...grpc.CallOption) (*ListStandingOrdersResponse, error)
+	// UpdateStandingOrder replaces the credit transfer and the end of the recurrence of the standing order.
+	//
+	// The frequency and the start date can not be changed, the standing order is deleted and created again instead. The
+	// standing order is completed when the new end of the recurrence is already reached.
+	UpdateStandingOrder(ctx context.Context, in *UpdateStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error)
+	// DeleteStandingOrder cancels the standing order, it is no longer executed. Its executions are kept.
+	DeleteStandingOrder(ctx context.Context, in *DeleteStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error)
+	// PauseStandingOrder pauses the standing order until resumed.
+	PauseStandingOrder(ctx context.Context, in *PauseStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error)
+	// ResumeStandingOrder resumes the paused standing order.
+	//
+	// The execution dates missed while paused are not executed, the standing order is executed from today on.
+	ResumeStandingOrder(ctx context.Context, in *ResumeStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error)
+	// SkipStandingOrder skips the next execution of the standing order.
+	//
+	// The skipped execution is listed in the executions of the standing order, it does not count as an execution.
+	SkipStandingOrder(ctx context.Context, in *SkipStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error)
+	// ListStandingOrderExecutions lists the executions of the standing order by execution date.
+	//
+	// Responses the executed and skipped dates, with the outcome of the transfer bulk job of the executed ones.
+	ListStandingOrderExecutions(ctx context.Context, in *ListStandingOrderExecutionsRequest, opts ...grpc.CallOption) (*ListStandingOrderExecutionsResponse, error)
+	// CheckLedger checks the ledger invariants.
+	//
+	// Responses the bank accounts which balance differs from the sum of their postings and the journal entries which
+	// postings do not balance.
+	CheckLedger(ctx context.Context, in *CheckLedgerRequest, opts ...grpc.CallOption) (*CheckLedgerResponse, error)
+	// ListTransactions lists the transactions of the bank account.
+	//
+	// Transactions are listed newest first by default, a page at a time. The next page is listed sending the
+	// next_page_token of the response as page_token, the other parameters must not change between pages.
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	// GetTransaction returns the transaction of the bank account.
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// ReverseTransaction reverses the transaction of the bank account.
+	//
+	// The reversal is a transaction crediting back the account, linked to the reversed transaction. The amount not
+	// reversed yet is reversed unless amount_cents is given, a transaction can be partially reversed several times.
+	// Reversals cannot be reversed. Responses the reversal and the account balance after.
+	ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReversalResponse, error)
+	// CreateInboundCredit books a credit transfer received by the bank account.
+	//
+	// The amount is converted into the account currency and credited to the account. The credit transfer is booked once
+	// per external reference of the account, sending again the same credit transfer responses the transaction already
+	// booked.
+	CreateInboundCredit(ctx context.Context, in *InboundCreditRequest, opts ...grpc.CallOption) (*InboundCreditResponse, error)
+	// PlaceHold reserves an amount of the bank account, e.g. for a card authorization.
+	//
+	// The held amount is not part of the available balance until the hold is captured, released or expires.
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*HoldResponse, error)
+	// CaptureHold debits the held amount, or part of it, from the bank account.
+	//
+	// The hold is closed, the amount not captured is released.
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*HoldResponse, error)
+	// ReleaseHold releases the held amount without debiting the bank account.
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*HoldResponse, error)
+	// GetBankAccount returns the bank account details and balances.
+	//
+	// The bank account is looked up by id, or by iban and bic.
+	GetBankAccount(ctx context.Context, in *GetBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error)
+	// SetOverdraftLimit sets the agreed overdraft facility of the bank account.
+	//
+	// The balance checks allow the available balance to go negative down to minus the limit, 0 removes the overdraft
+	// facility. Served under /v1/admin, the path to expose to the administrators only.
+	SetOverdraftLimit(ctx context.Context, in *SetOverdraftLimitRequest, opts ...grpc.CallOption) (*BankAccount, error)
+	// SetTransferLimits sets the limits of the credit transfers of the bank account, or of all the bank accounts of the
+	// organization.
+	//
+	// The limits replace the limits set before, 0 for no limit. A transfer bulk breaching a limit of the bank account, or
+	// of its organization, is denied. Served under /v1/admin, the path to expose to the administrators only.
+	SetTransferLimits(ctx context.Context, in *SetTransferLimitsRequest, opts ...grpc.CallOption) (*TransferLimits, error)
 }
 
 type qontoServiceClient struct {
@@ -36,8 +165,8 @@ func NewQontoServiceClient(cc grpc.ClientConnInterface) QontoServiceClient {
 	return &qontoServiceClient{cc}
 }
 
-func (c *qontoServiceClient) TransferBulk(ctx context.Context, in *TransferBulkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *qontoServiceClient) TransferBulk(ctx context.Context, in *TransferBulkRequest, opts ...grpc.CallOption) (*TransferBulkResponse, error) {
+	out := new(TransferBulkResponse)
 	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/TransferBulk", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -45,6 +174,249 @@ func (c *qontoServiceClient) TransferBulk(ctx context.Context, in *TransferBulkR
 	return out, nil
 }
 
+func (c *qontoServiceClient) ImportTransferBulk(ctx context.Context, in *ImportTransferBulkRequest, opts ...grpc.CallOption) (*TransferBulkResponse, error) {
+	out := new(TransferBulkResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/ImportTransferBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) GetTransferBulkJob(ctx context.Context, in *GetTransferBulkJobRequest, opts ...grpc.CallOption) (*GetTransferBulkJobResponse, error) {
+	out := new(GetTransferBulkJobResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/GetTransferBulkJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) ListScheduledTransferBulks(ctx context.Context, in *ListScheduledTransferBulksRequest, opts ...grpc.CallOption) (*ListScheduledTransferBulksResponse, error) {
+	out := new(ListScheduledTransferBulksResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/ListScheduledTransferBulks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) CancelScheduledTransferBulk(ctx context.Context, in *CancelScheduledTransferBulkRequest, opts ...grpc.CallOption) (*GetTransferBulkJobResponse, error) {
+	out := new(GetTransferBulkJobResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/CancelScheduledTransferBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) ReverseTransferBulk(ctx context.Context, in *ReverseTransferBulkRequest, opts ...grpc.CallOption) (*ReversalResponse, error) {
+	out := new(ReversalResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/ReverseTransferBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) ApproveTransferBulk(ctx context.Context, in *ApproveTransferBulkRequest, opts ...grpc.CallOption) (*TransferBulkResponse, error) {
+	out := new(TransferBulkResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/ApproveTransferBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) RejectTransferBulk(ctx context.Context, in *RejectTransferBulkRequest, opts ...grpc.CallOption) (*TransferBulkResponse, error) {
+	out := new(TransferBulkResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/RejectTransferBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) CreateStandingOrder(ctx context.Context, in *CreateStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error) {
+	out := new(StandingOrder)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/CreateStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) GetStandingOrder(ctx context.Context, in *GetStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error) {
+	out := new(StandingOrder)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/GetStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) ListStandingOrders(ctx context.Context, in *ListStandingOrdersRequest, opts ...grpc.CallOption) (*ListStandingOrdersResponse, error) {
+	out := new(ListStandingOrdersResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/ListStandingOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) UpdateStandingOrder(ctx context.Context, in *UpdateStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error) {
+	out := new(StandingOrder)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/UpdateStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) DeleteStandingOrder(ctx context.Context, in *DeleteStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error) {
+	out := new(StandingOrder)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/DeleteStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) PauseStandingOrder(ctx context.Context, in *PauseStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error) {
+	out := new(StandingOrder)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/PauseStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) ResumeStandingOrder(ctx context.Context, in *ResumeStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error) {
+	out := new(StandingOrder)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/ResumeStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) SkipStandingOrder(ctx context.Context, in *SkipStandingOrderRequest, opts ...grpc.CallOption) (*StandingOrder, error) {
+	out := new(StandingOrder)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/SkipStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) ListStandingOrderExecutions(ctx context.Context, in *ListStandingOrderExecutionsRequest, opts ...grpc.CallOption) (*ListStandingOrderExecutionsResponse, error) {
+	out := new(ListStandingOrderExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/ListStandingOrderExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) CheckLedger(ctx context.Context, in *CheckLedgerRequest, opts ...grpc.CallOption) (*CheckLedgerResponse, error) {
+	out := new(CheckLedgerResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/CheckLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/ListTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReversalResponse, error) {
+	out := new(ReversalResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/ReverseTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) CreateInboundCredit(ctx context.Context, in *InboundCreditRequest, opts ...grpc.CallOption) (*InboundCreditResponse, error) {
+	out := new(InboundCreditResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/CreateInboundCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*HoldResponse, error) {
+	out := new(HoldResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/PlaceHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*HoldResponse, error) {
+	out := new(HoldResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/CaptureHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*HoldResponse, error) {
+	out := new(HoldResponse)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/ReleaseHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) GetBankAccount(ctx context.Context, in *GetBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error) {
+	out := new(BankAccount)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/GetBankAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) SetOverdraftLimit(ctx context.Context, in *SetOverdraftLimitRequest, opts ...grpc.CallOption) (*BankAccount, error) {
+	out := new(BankAccount)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/SetOverdraftLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qontoServiceClient) SetTransferLimits(ctx context.Context, in *SetTransferLimitsRequest, opts ...grpc.CallOption) (*TransferLimits, error) {
+	out := new(TransferLimits)
+	err := c.cc.Invoke(ctx, "/api.qonto.QontoService/SetTransferLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QontoServiceServer is the server API for QontoService service.
 // All implementations must embed UnimplementedQontoServiceServer
 // for forward compatibility
@@ -54,8 +426,138 @@ type QontoServiceServer interface {
 	// Receives a request with bulk of transfer to perform. Responses whether the transfer were done successfully or not, due to:
 	// - account not found
 	// - not enough funds in the account
+	// - transfer limit of the account, or of its organization, exceeded
+	// - idempotency key already used with a different request
+	// - invalid request, the field violations are returned as google.rpc.BadRequest details
 	// - internal server.
-	TransferBulk(context.Context, *TransferBulkRequest) (*emptypb.Empty, error)
+	//
+	// The idempotency key can be sent either in the request or in the `Idempotency-Key` header. Replaying a request with
+	// the same idempotency key returns the original outcome without performing the transfers again.
+	//
+	// Asynchronous requests are validated and accepted as a job, responding only the job id and status. The transfers
+	// are performed in background, the job outcome is polled with GetTransferBulkJob.
+	//
+	// Validate-only requests run the same account lookup, validation and balance check without performing the
+	// transfers, responding the amount that would be debited, the balance after and the rows that would be executed.
+	TransferBulk(context.Context, *TransferBulkRequest) (*TransferBulkResponse, error)
+	// ImportTransferBulk performs the transfers of a SEPA pain.001.001.03 credit transfer initiation file.
+	//
+	// The debtor account of the file is the organization account and each CdtTrfTxInf is a credit transfer. The number
+	// of transactions (NbOfTxs) and the control sums (CtrlSum) of the group header and of the payment information blocks
+	// must match the credit transfers. The file message id (MsgId) is the idempotency key when none is sent.
+	//
+	// Responses as TransferBulk. The file is sent as the raw request body with the `Content-Type: application/xml`
+	// header, or base64 encoded as a JSON string.
+	ImportTransferBulk(context.Context, *ImportTransferBulkRequest) (*TransferBulkResponse, error)
+	// GetTransferBulkJob returns the transfer bulk job.
+	//
+	// Responses the job status and the outcome of each credit transfer once processed.
+	GetTransferBulkJob(context.Context, *GetTransferBulkJobRequest) (*GetTransferBulkJobResponse, error)
+	// ListScheduledTransferBulks lists the transfer bulks scheduled with a requested execution date and not yet performed.
+	//
+	// Responses the scheduled jobs by execution date, the jobs of the bank account when the organization iban is set.
+	ListScheduledTransferBulks(context.Context, *ListScheduledTransferBulksRequest) (*ListScheduledTransferBulksResponse, error)
+	// CancelScheduledTransferBulk cancels the scheduled transfer bulk, its transfers are not performed.
+	//
+	// Responses the cancelled job. Only scheduled jobs can be cancelled, once the execution date is reached the job is
+	// processed as any other job. Cancelling a cancelled job responses the job as is.
+	CancelScheduledTransferBulk(context.Context, *CancelScheduledTransferBulkRequest) (*GetTransferBulkJobResponse, error)
+	// ReverseTransferBulk reverses the transactions performed by the transfer bulk.
+	//
+	// Each transaction is credited back its amount not reversed yet, the transactions already reversed are left as they
+	// are. Responses the reversals and the account balance after.
+	ReverseTransferBulk(context.Context, *ReverseTransferBulkRequest) (*ReversalResponse, error)
+	// ApproveTransferBulk performs the transfers of the transfer bulk awaiting approval, or held for review.
+	//
+	// The transfer bulk must be approved by a user other than the one who submitted it, identified by the X-User-Id
+	// header asserted by a trusted proxy. The transfers are performed as if just requested, checking the balance and
+	// the limits. Responses the performed transfer bulk.
+	ApproveTransferBulk(context.Context, *ApproveTransferBulkRequest) (*TransferBulkResponse, error)
+	// RejectTransferBulk rejects the transfer bulk awaiting approval, or held for review, its transfers are not performed.
+	//
+	// The transfer bulk must be rejected by a user other than the one who submitted it, identified by the X-User-Id
+	// header asserted by a trusted proxy. Responses the rejected transfer bulk.
+	RejectTransferBulk(context.Context, *RejectTransferBulkRequest) (*TransferBulkResponse, error)
+	// CreateStandingOrder creates a standing order, a credit transfer performed repeatedly.
+	//
+	// The credit transfer is performed daily, weekly on the weekday of the start date, or monthly on the day of the
+	// month, from the start date until the end date or the number of executions is reached. On each execution date, the
+	// credit transfer is accepted as a transfer bulk job, see GetTransferBulkJob.
+	CreateStandingOrder(context.Context, *CreateStandingOrderRequest) (*StandingOrder, error)
+	// GetStandingOrder returns the standing order.
+	GetStandingOrder(context.Context, *GetStandingOrderRequest) (*StandingOrder, error)
+	// ListStandingOrders lists the standing orders of the bank account, but the deleted ones.
+	ListStandingOrders(context.Context, *ListStandingOrdersRequest) (*ListStandingOrdersResponse, error)
+	// UpdateStandingOrder replaces the credit transfer and the end of the recurrence of the standing order.
+	//
+	// The frequency and the start date can not be changed, the standing order is deleted and created again instead. The
+	// standing order is completed when the new end of the recurrence is already reached.
+	UpdateStandingOrder(context.Context, *UpdateStandingOrderRequest) (*StandingOrder, error)
+	// DeleteStandingOrder cancels the standing order, it is no longer executed. Its executions are kept.
+	DeleteStandingOrder(context.Context, *DeleteStandingOrderRequest) (*StandingOrder, error)
+	// PauseStandingOrder pauses the standing order until resumed.
+	PauseStandingOrder(context.Context, *PauseStandingOrderRequest) (*StandingOrder, error)
+	// ResumeStandingOrder resumes the paused standing order.
+	//
+	// The execution dates missed while paused are not executed, the standing order is executed from today on.
+	ResumeStandingOrder(context.Context, *ResumeStandingOrderRequest) (*StandingOrder, error)
+	// SkipStandingOrder skips the next execution of the standing order.
+	//
+	// The skipped execution is listed in the executions of the standing order, it does not count as an execution.
+	SkipStandingOrder(context.Context, *SkipStandingOrderRequest) (*StandingOrder, error)
+	// ListStandingOrderExecutions lists the executions of the standing order by execution date.
+	//
+	// Responses the executed and skipped dates, with the outcome of the transfer bulk job of the executed ones.
+	ListStandingOrderExecutions(context.Context, *ListStandingOrderExecutionsRequest) (*ListStandingOrderExecutionsResponse, error)
+	// CheckLedger checks the ledger invariants.
+	//
+	// Responses the bank accounts which balance differs from the sum of their postings and the journal entries which
+	// postings do not balance.
+	CheckLedger(context.Context, *CheckLedgerRequest) (*CheckLedgerResponse, error)
+	// ListTransactions lists the transactions of the bank account.
+	//
+	// Transactions are listed newest first by default, a page at a time. The next page is listed sending the
+	// next_page_token of the response as page_token, the other parameters must not change between pages.
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	// GetTransaction returns the transaction of the bank account.
+	GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error)
+	// ReverseTransaction reverses the transaction of the bank account.
+	//
+	// The reversal is a transaction crediting back the account, linked to the reversed transaction. The amount not
+	// reversed yet is reversed unless amount_cents is given, a transaction can be partially reversed several times.
+	// Reversals cannot be reversed. Responses the reversal and the account balance after.
+	ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReversalResponse, error)
+	// CreateInboundCredit books a credit transfer received by the bank account.
+	//
+	// The amount is converted into the account currency and credited to the account. The credit transfer is booked once
+	// per external reference of the account, sending again the same credit transfer responses the transaction already
+	// booked.
+	CreateInboundCredit(context.Context, *InboundCreditRequest) (*InboundCreditResponse, error)
+	// PlaceHold reserves an amount of the bank account, e.g. for a card authorization.
+	//
+	// The held amount is not part of the available balance until the hold is captured, released or expires.
+	PlaceHold(context.Context, *PlaceHoldRequest) (*HoldResponse, error)
+	// CaptureHold debits the held amount, or part of it, from the bank account.
+	//
+	// The hold is closed, the amount not captured is released.
+	CaptureHold(context.Context, *CaptureHoldRequest) (*HoldResponse, error)
+	// ReleaseHold releases the held amount without debiting the bank account.
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*HoldResponse, error)
+	// GetBankAccount returns the bank account details and balances.
+	//
+	// The bank account is looked up by id, or by iban and bic.
+	GetBankAccount(context.Context, *GetBankAccountRequest) (*BankAccount, error)
+	// SetOverdraftLimit sets the agreed overdraft facility of the bank account.
+	//
+	// The balance checks allow the available balance to go negative down to minus the limit, 0 removes the overdraft
+	// facility. Served under /v1/admin, the path to expose to the administrators only.
+	SetOverdraftLimit(context.Context, *SetOverdraftLimitRequest) (*BankAccount, error)
+	// SetTransferLimits sets the limits of the credit transfers of the bank account, or of all the bank accounts of the
+	// organization.
+	//
+	// The limits replace the limits set before, 0 for no limit. A transfer bulk breaching a limit of the bank account, or
+	// of its organization, is denied. Served under /v1/admin, the path to expose to the administrators only.
+	SetTransferLimits(context.Context, *SetTransferLimitsRequest) (*TransferLimits, error)
 	mustEmbedUnimplementedQontoServiceServer()
 }
 
@@ -63,9 +565,90 @@ type QontoServiceServer interface {
 type UnimplementedQontoServiceServer struct {
 }
 
-func (UnimplementedQontoServiceServer) TransferBulk(context.Context, *TransferBulkRequest) (*emptypb.Empty, error) {
+func (UnimplementedQontoServiceServer) TransferBulk(context.Context, *TransferBulkRequest) (*TransferBulkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferBulk not implemented")
 }
+func (UnimplementedQontoServiceServer) ImportTransferBulk(context.Context, *ImportTransferBulkRequest) (*TransferBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTransferBulk not implemented")
+}
+func (UnimplementedQontoServiceServer) GetTransferBulkJob(context.Context, *GetTransferBulkJobRequest) (*GetTransferBulkJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransferBulkJob not implemented")
+}
+func (UnimplementedQontoServiceServer) ListScheduledTransferBulks(context.Context, *ListScheduledTransferBulksRequest) (*ListScheduledTransferBulksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransferBulks not implemented")
+}
+func (UnimplementedQontoServiceServer) CancelScheduledTransferBulk(context.Context, *CancelScheduledTransferBulkRequest) (*GetTransferBulkJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransferBulk not implemented")
+}
+func (UnimplementedQontoServiceServer) ReverseTransferBulk(context.Context, *ReverseTransferBulkRequest) (*ReversalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransferBulk not implemented")
+}
+func (UnimplementedQontoServiceServer) ApproveTransferBulk(context.Context, *ApproveTransferBulkRequest) (*TransferBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTransferBulk not implemented")
+}
+func (UnimplementedQontoServiceServer) RejectTransferBulk(context.Context, *RejectTransferBulkRequest) (*TransferBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTransferBulk not implemented")
+}
+func (UnimplementedQontoServiceServer) CreateStandingOrder(context.Context, *CreateStandingOrderRequest) (*StandingOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStandingOrder not implemented")
+}
+func (UnimplementedQontoServiceServer) GetStandingOrder(context.Context, *GetStandingOrderRequest) (*StandingOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandingOrder not implemented")
+}
+func (UnimplementedQontoServiceServer) ListStandingOrders(context.Context, *ListStandingOrdersRequest) (*ListStandingOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStandingOrders not implemented")
+}
+func (UnimplementedQontoServiceServer) UpdateStandingOrder(context.Context, *UpdateStandingOrderRequest) (*StandingOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStandingOrder not implemented")
+}
+func (UnimplementedQontoServiceServer) DeleteStandingOrder(context.Context, *DeleteStandingOrderRequest) (*StandingOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStandingOrder not implemented")
+}
+func (UnimplementedQontoServiceServer) PauseStandingOrder(context.Context, *PauseStandingOrderRequest) (*StandingOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStandingOrder not implemented")
+}
+func (UnimplementedQontoServiceServer) ResumeStandingOrder(context.Context, *ResumeStandingOrderRequest) (*StandingOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeStandingOrder not implemented")
+}
+func (UnimplementedQontoServiceServer) SkipStandingOrder(context.Context, *SkipStandingOrderRequest) (*StandingOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipStandingOrder not implemented")
+}
+func (UnimplementedQontoServiceServer) ListStandingOrderExecutions(context.Context, *ListStandingOrderExecutionsRequest) (*ListStandingOrderExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStandingOrderExecutions not implemented")
+}
+func (UnimplementedQontoServiceServer) CheckLedger(context.Context, *CheckLedgerRequest) (*CheckLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckLedger not implemented")
+}
+func (UnimplementedQontoServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedQontoServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedQontoServiceServer) ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReversalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransaction not implemented")
+}
+func (UnimplementedQontoServiceServer) CreateInboundCredit(context.Context, *InboundCreditRequest) (*InboundCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInboundCredit not implemented")
+}
+func (UnimplementedQontoServiceServer) PlaceHold(context.Context, *PlaceHoldRequest) (*HoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedQontoServiceServer) CaptureHold(context.Context, *CaptureHoldRequest) (*HoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedQontoServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*HoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedQontoServiceServer) GetBankAccount(context.Context, *GetBankAccountRequest) (*BankAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBankAccount not implemented")
+}
+func (UnimplementedQontoServiceServer) SetOverdraftLimit(context.Context, *SetOverdraftLimitRequest) (*BankAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOverdraftLimit not implemented")
+}
+func (UnimplementedQontoServiceServer) SetTransferLimits(context.Context, *SetTransferLimitsRequest) (*TransferLimits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferLimits not implemented")
+}
 func (UnimplementedQontoServiceServer) mustEmbedUnimplementedQontoServiceServer() {}
 
 // UnsafeQontoServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -97,16 +680,610 @@ func _QontoService_TransferBulk_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// QontoService_ServiceDesc is the grpc.ServiceDesc for QontoService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var QontoService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.qonto.QontoService",
-	HandlerType: (*QontoServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TransferBulk",
-			Handler:    _QontoService_TransferBulk_Handler,
+func _QontoService_ImportTransferBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTransferBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).ImportTransferBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/ImportTransferBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).ImportTransferBulk(ctx, req.(*ImportTransferBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_GetTransferBulkJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferBulkJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).GetTransferBulkJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/GetTransferBulkJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).GetTransferBulkJob(ctx, req.(*GetTransferBulkJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_ListScheduledTransferBulks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransferBulksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).ListScheduledTransferBulks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/ListScheduledTransferBulks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).ListScheduledTransferBulks(ctx, req.(*ListScheduledTransferBulksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_CancelScheduledTransferBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTransferBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).CancelScheduledTransferBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/CancelScheduledTransferBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).CancelScheduledTransferBulk(ctx, req.(*CancelScheduledTransferBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_ReverseTransferBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransferBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).ReverseTransferBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/ReverseTransferBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).ReverseTransferBulk(ctx, req.(*ReverseTransferBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_ApproveTransferBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTransferBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).ApproveTransferBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/ApproveTransferBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).ApproveTransferBulk(ctx, req.(*ApproveTransferBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_RejectTransferBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTransferBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).RejectTransferBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/RejectTransferBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).RejectTransferBulk(ctx, req.(*RejectTransferBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_CreateStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).CreateStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/CreateStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).CreateStandingOrder(ctx, req.(*CreateStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_GetStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).GetStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/GetStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).GetStandingOrder(ctx, req.(*GetStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_ListStandingOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStandingOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).ListStandingOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/ListStandingOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).ListStandingOrders(ctx, req.(*ListStandingOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_UpdateStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).UpdateStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/UpdateStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).UpdateStandingOrder(ctx, req.(*UpdateStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_DeleteStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).DeleteStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/DeleteStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).DeleteStandingOrder(ctx, req.(*DeleteStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_PauseStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).PauseStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/PauseStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).PauseStandingOrder(ctx, req.(*PauseStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_ResumeStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).ResumeStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/ResumeStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).ResumeStandingOrder(ctx, req.(*ResumeStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_SkipStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).SkipStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/SkipStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).SkipStandingOrder(ctx, req.(*SkipStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_ListStandingOrderExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStandingOrderExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).ListStandingOrderExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/ListStandingOrderExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).ListStandingOrderExecutions(ctx, req.(*ListStandingOrderExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_CheckLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).CheckLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/CheckLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).CheckLedger(ctx, req.(*CheckLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/ListTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_ReverseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).ReverseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/ReverseTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).ReverseTransaction(ctx, req.(*ReverseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_CreateInboundCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InboundCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).CreateInboundCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/CreateInboundCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).CreateInboundCredit(ctx, req.(*InboundCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/PlaceHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/CaptureHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/ReleaseHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_GetBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).GetBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/GetBankAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).GetBankAccount(ctx, req.(*GetBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_SetOverdraftLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOverdraftLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).SetOverdraftLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/SetOverdraftLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).SetOverdraftLimit(ctx, req.(*SetOverdraftLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QontoService_SetTransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QontoServiceServer).SetTransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.qonto.QontoService/SetTransferLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QontoServiceServer).SetTransferLimits(ctx, req.(*SetTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QontoService_ServiceDesc is the grpc.ServiceDesc for QontoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QontoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.qonto.QontoService",
+	HandlerType: (*QontoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TransferBulk",
+			Handler:    _QontoService_TransferBulk_Handler,
+		},
+		{
+			MethodName: "ImportTransferBulk",
+			Handler:    _QontoService_ImportTransferBulk_Handler,
+		},
+		{
+			MethodName: "GetTransferBulkJob",
+			Handler:    _QontoService_GetTransferBulkJob_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransferBulks",
+			Handler:    _QontoService_ListScheduledTransferBulks_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransferBulk",
+			Handler:    _QontoService_CancelScheduledTransferBulk_Handler,
+		},
+		{
+			MethodName: "ReverseTransferBulk",
+			Handler:    _QontoService_ReverseTransferBulk_Handler,
+		},
+		{
+			MethodName: "ApproveTransferBulk",
+			Handler:    _QontoService_ApproveTransferBulk_Handler,
+		},
+		{
+			MethodName: "RejectTransferBulk",
+			Handler:    _QontoService_RejectTransferBulk_Handler,
+		},
+		{
+			MethodName: "CreateStandingOrder",
+			Handler:    _QontoService_CreateStandingOrder_Handler,
+		},
+		{
+			MethodName: "GetStandingOrder",
+			Handler:    _QontoService_GetStandingOrder_Handler,
+		},
+		{
+			MethodName: "ListStandingOrders",
+			Handler:    _QontoService_ListStandingOrders_Handler,
+		},
+		{
+			MethodName: "UpdateStandingOrder",
+			Handler:    _QontoService_UpdateStandingOrder_Handler,
+		},
+		{
+			MethodName: "DeleteStandingOrder",
+			Handler:    _QontoService_DeleteStandingOrder_Handler,
+		},
+		{
+			MethodName: "PauseStandingOrder",
+			Handler:    _QontoService_PauseStandingOrder_Handler,
+		},
+		{
+			MethodName: "ResumeStandingOrder",
+			Handler:    _QontoService_ResumeStandingOrder_Handler,
+		},
+		{
+			MethodName: "SkipStandingOrder",
+			Handler:    _QontoService_SkipStandingOrder_Handler,
+		},
+		{
+			MethodName: "ListStandingOrderExecutions",
+			Handler:    _QontoService_ListStandingOrderExecutions_Handler,
+		},
+		{
+			MethodName: "CheckLedger",
+			Handler:    _QontoService_CheckLedger_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _QontoService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _QontoService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "ReverseTransaction",
+			Handler:    _QontoService_ReverseTransaction_Handler,
+		},
+		{
+			MethodName: "CreateInboundCredit",
+			Handler:    _QontoService_CreateInboundCredit_Handler,
+		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _QontoService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _QontoService_CaptureHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _QontoService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "GetBankAccount",
+			Handler:    _QontoService_GetBankAccount_Handler,
+		},
+		{
+			MethodName: "SetOverdraftLimit",
+			Handler:    _QontoService_SetOverdraftLimit_Handler,
+		},
+		{
+			MethodName: "SetTransferLimits",
+			Handler:    _QontoService_SetTransferLimits_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,2 @@
+// Package worker provides a service running a process periodically until shutdown.
+package worker
@@ -0,0 +1,110 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/dohernandez/qonto/pkg/servicing"
+)
+
+// ProcessFunc processes a unit of work.
+//
+// Returns whether there was work to process, the worker processes the next one without waiting for the interval.
+type ProcessFunc func(ctx context.Context) (bool, error)
+
+// Option sets up a worker.
+type Option func(w *Worker)
+
+// WithInterval sets the time the worker waits when there is no work to process, default 1s.
+func WithInterval(interval time.Duration) Option {
+	return func(w *Worker) {
+		w.interval = interval
+	}
+}
+
+// WithErrorHandler sets the function called with the errors of the process.
+func WithErrorHandler(onError func(ctx context.Context, err error)) Option {
+	return func(w *Worker) {
+		w.onError = onError
+	}
+}
+
+// Worker is a service running a process periodically until shutdown.
+type Worker struct {
+	name     string
+	process  ProcessFunc
+	interval time.Duration
+	onError  func(ctx context.Context, err error)
+
+	shutdownSignal <-chan struct{}
+	shutdownDone   chan<- struct{}
+}
+
+// NewWorker initiates a new worker running the process.
+func NewWorker(name string, process ProcessFunc, opts ...Option) *Worker {
+	w := &Worker{
+		name:     name,
+		process:  process,
+		interval: time.Second,
+		onError:  func(context.Context, error) {},
+	}
+
+	for _, o := range opts {
+		o(w)
+	}
+
+	return w
+}
+
+// WithShutdownSignal adds channels to wait for shutdown and to report shutdown finished.
+func (w *Worker) WithShutdownSignal(shutdown <-chan struct{}, done chan<- struct{}) servicing.Service {
+	w.shutdownSignal = shutdown
+	w.shutdownDone = done
+
+	return w
+}
+
+// Start runs the process until the shutdown signal.
+//
+// The process running when the shutdown signal comes is not interrupted, the worker stops once it returns.
+func (w *Worker) Start() error {
+	ctx := context.Background()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.shutdownSignal:
+			if w.shutdownDone != nil {
+				close(w.shutdownDone)
+			}
+
+			return nil
+		case <-timer.C:
+		}
+
+		processed, err := w.process(ctx)
+		if err != nil {
+			w.onError(ctx, err)
+		}
+
+		if processed && err == nil {
+			timer.Reset(0)
+
+			continue
+		}
+
+		timer.Reset(w.interval)
+	}
+}
+
+// Name Service name.
+func (w *Worker) Name() string {
+	return w.name
+}
+
+// Addr service address, a worker does not listen.
+func (w *Worker) Addr() string {
+	return "-"
+}
@@ -0,0 +1,66 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dohernandez/qonto/pkg/worker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker_Start(t *testing.T) {
+	t.Parallel()
+
+	var calls, failures int32
+
+	processErr := errors.New("process failed")
+
+	w := worker.NewWorker(
+		"Test",
+		func(ctx context.Context) (bool, error) {
+			switch atomic.AddInt32(&calls, 1) {
+			case 1, 2:
+				// work is processed without waiting for the interval.
+				return true, nil
+			case 3:
+				return true, processErr
+			default:
+				return false, nil
+			}
+		},
+		worker.WithInterval(time.Millisecond),
+		worker.WithErrorHandler(func(_ context.Context, err error) {
+			assert.ErrorIs(t, err, processErr)
+
+			atomic.AddInt32(&failures, 1)
+		}),
+	)
+
+	shutdownCh := make(chan struct{})
+	shutdownDoneCh := make(chan struct{})
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- w.WithShutdownSignal(shutdownCh, shutdownDoneCh).Start()
+	}()
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&calls) > 4
+	}, time.Second, time.Millisecond)
+
+	close(shutdownCh)
+
+	select {
+	case <-shutdownDoneCh:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not shutdown")
+	}
+
+	require.NoError(t, <-errCh)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&failures))
+	assert.Equal(t, "Test", w.Name())
+}
@@ -0,0 +1 @@
+drop table transfer_bulks;
\ No newline at end of file
@@ -0,0 +1,9 @@
+create table transfer_bulks
+(
+    id              serial primary key,
+    idempotency_key TEXT NOT NULL DEFAULT '',
+    request_hash    TEXT NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+create unique index transfer_bulks_idempotency_key_idx on transfer_bulks (idempotency_key) where idempotency_key <> '';
@@ -0,0 +1,7 @@
+alter table transactions
+    drop column transfer_bulk_id;
+
+alter table transfer_bulks
+    drop column bank_account_id,
+    drop column debited_cents,
+    drop column balance_cents;
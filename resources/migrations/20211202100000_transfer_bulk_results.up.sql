@@ -0,0 +1,7 @@
+alter table transfer_bulks
+    add column bank_account_id INTEGER NOT NULL DEFAULT 0,
+    add column debited_cents   BIGINT  NOT NULL DEFAULT 0,
+    add column balance_cents   BIGINT  NOT NULL DEFAULT 0;
+
+alter table transactions
+    add column transfer_bulk_id INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,17 @@
+alter table transfer_bulks
+    drop column currency;
+
+update transactions
+set amount_cents = -amount_cents
+where id in (1, 2)
+  and transfer_bulk_id = 0;
+
+alter table transactions
+    drop column debited_cents,
+    drop column debited_currency,
+    drop column exchange_rate,
+    alter column amount_cents type INTEGER;
+
+alter table bank_accounts
+    drop column currency,
+    alter column balance_cents type INTEGER;
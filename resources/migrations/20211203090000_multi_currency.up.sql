@@ -0,0 +1,24 @@
+-- the amounts of the accounts exceeding 2^31 cents, about 21 million in the account currency, are not truncated.
+alter table bank_accounts
+    alter column balance_cents type BIGINT,
+    add column currency TEXT NOT NULL DEFAULT 'EUR';
+
+alter table transactions
+    alter column amount_cents type BIGINT,
+    add column debited_cents    BIGINT  NOT NULL DEFAULT 0,
+    add column debited_currency TEXT    NOT NULL DEFAULT '',
+    add column exchange_rate    NUMERIC NOT NULL DEFAULT 1;
+
+-- the seed transactions, the first two inserted by the init migration, are signed the other way around, credits
+-- positive, as the transactions booked debit the bank account by their positive amounts.
+update transactions
+set amount_cents = -amount_cents
+where id in (1, 2)
+  and transfer_bulk_id = 0;
+
+update transactions
+set debited_cents    = amount_cents,
+    debited_currency = amount_currency;
+
+alter table transfer_bulks
+    add column currency TEXT NOT NULL DEFAULT '';
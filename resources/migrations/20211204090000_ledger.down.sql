@@ -0,0 +1,3 @@
+drop table postings;
+
+drop table journal_entries;
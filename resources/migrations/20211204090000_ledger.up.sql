@@ -0,0 +1,41 @@
+-- journal_entries and postings are append-only, the ledger is never updated nor deleted.
+create table journal_entries
+(
+    id               serial primary key,
+    transfer_bulk_id INTEGER     NOT NULL DEFAULT 0,
+    description      TEXT        NOT NULL,
+    created_at       timestamptz NOT NULL DEFAULT now()
+);
+
+create table postings
+(
+    id               serial primary key,
+    journal_entry_id INTEGER NOT NULL,
+    ledger_account   TEXT    NOT NULL,
+    bank_account_id  INTEGER NOT NULL DEFAULT 0,
+    transaction_id   INTEGER NOT NULL DEFAULT 0,
+    amount_cents     BIGINT  NOT NULL,
+    currency         TEXT    NOT NULL,
+
+    FOREIGN KEY (journal_entry_id) REFERENCES journal_entries (id)
+);
+
+create index postings_bank_account_id_idx on postings (bank_account_id) where bank_account_id <> 0;
+
+-- the existing balances are booked as opening balances.
+do
+$$
+    declare
+        account  record;
+        entry_id integer;
+    begin
+        for account in select id, balance_cents, currency from bank_accounts where balance_cents <> 0 order by id
+            loop
+                insert into journal_entries (description) values ('Opening balance') returning id into entry_id;
+
+                insert into postings (journal_entry_id, ledger_account, bank_account_id, amount_cents, currency)
+                values (entry_id, 'bank_account', account.id, account.balance_cents, account.currency),
+                       (entry_id, 'opening_balances', 0, -account.balance_cents, account.currency);
+            end loop;
+    end
+$$;
@@ -0,0 +1 @@
+drop table transfer_bulk_jobs;
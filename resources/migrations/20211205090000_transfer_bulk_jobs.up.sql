@@ -0,0 +1,23 @@
+create table transfer_bulk_jobs
+(
+    id               serial primary key,
+    idempotency_key  TEXT NOT NULL DEFAULT '',
+    request_hash     TEXT NOT NULL,
+    status           TEXT NOT NULL DEFAULT 'pending',
+    request          JSONB NOT NULL,
+    total_rows       INTEGER NOT NULL DEFAULT 0,
+    processed_rows   INTEGER NOT NULL DEFAULT 0,
+    rows             JSONB NOT NULL DEFAULT '[]',
+    transfer_bulk_id INTEGER NOT NULL DEFAULT 0,
+    debited_cents    BIGINT  NOT NULL DEFAULT 0,
+    balance_cents    BIGINT  NOT NULL DEFAULT 0,
+    currency         TEXT NOT NULL DEFAULT '',
+    error            TEXT NOT NULL DEFAULT '',
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+create unique index transfer_bulk_jobs_idempotency_key_idx on transfer_bulk_jobs (idempotency_key) where idempotency_key <> '';
+
+-- workers claim the oldest pending or stale processing job.
+create index transfer_bulk_jobs_status_idx on transfer_bulk_jobs (status, id) where status in ('pending', 'processing');
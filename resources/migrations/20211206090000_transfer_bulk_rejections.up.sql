@@ -0,0 +1,2 @@
+alter table transfer_bulks
+    add column rejections JSONB NOT NULL DEFAULT '[]';
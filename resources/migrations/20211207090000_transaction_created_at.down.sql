@@ -0,0 +1,4 @@
+drop index transactions_bank_account_id_created_at_idx;
+
+alter table transactions
+    drop column created_at;
@@ -0,0 +1,5 @@
+alter table transactions
+    add column created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now();
+
+-- transactions are listed by bank account in creation order.
+create index transactions_bank_account_id_created_at_idx on transactions (bank_account_id, created_at, id);
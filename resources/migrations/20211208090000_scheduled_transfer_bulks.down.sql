@@ -0,0 +1,3 @@
+drop index transfer_bulk_jobs_execution_date_idx;
+alter table transfer_bulk_jobs drop column execution_date;
+alter table transfer_bulk_jobs drop column organization_iban;
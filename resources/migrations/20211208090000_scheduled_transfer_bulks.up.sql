@@ -0,0 +1,5 @@
+alter table transfer_bulk_jobs add column organization_iban TEXT NOT NULL DEFAULT '';
+alter table transfer_bulk_jobs add column execution_date DATE;
+
+-- workers claim the scheduled jobs which execution date is reached.
+create index transfer_bulk_jobs_execution_date_idx on transfer_bulk_jobs (execution_date, id) where status = 'scheduled';
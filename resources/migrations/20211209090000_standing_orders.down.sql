@@ -0,0 +1,2 @@
+drop table standing_order_executions;
+drop table standing_orders;
@@ -0,0 +1,43 @@
+create table standing_orders
+(
+    id                  serial primary key,
+    organization_name   TEXT NOT NULL,
+    organization_iban   TEXT NOT NULL,
+    organization_bic    TEXT NOT NULL,
+    amount_cents        BIGINT NOT NULL,
+    currency            TEXT NOT NULL,
+    counterparty_name   TEXT NOT NULL,
+    counterparty_iban   TEXT NOT NULL,
+    counterparty_bic    TEXT NOT NULL,
+    description         TEXT NOT NULL,
+    frequency           TEXT NOT NULL,
+    day_of_month        INTEGER NOT NULL DEFAULT 0,
+    start_date          DATE NOT NULL,
+    end_date            DATE,
+    count               INTEGER NOT NULL DEFAULT 0,
+    status              TEXT NOT NULL DEFAULT 'active',
+    next_execution_date DATE,
+    execution_count     INTEGER NOT NULL DEFAULT 0,
+    created_at          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+create index standing_orders_organization_iban_idx on standing_orders (organization_iban);
+
+-- workers claim the active standing orders which next execution date is reached.
+create index standing_orders_next_execution_date_idx on standing_orders (next_execution_date, id) where status = 'active';
+
+create table standing_order_executions
+(
+    id                   serial primary key,
+    standing_order_id    INTEGER NOT NULL,
+    execution_date       DATE NOT NULL,
+    status               TEXT NOT NULL,
+    transfer_bulk_job_id INTEGER NOT NULL DEFAULT 0,
+    created_at           TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (standing_order_id) REFERENCES standing_orders (id)
+);
+
+-- an execution date is either executed or skipped, once.
+create unique index standing_order_executions_date_idx on standing_order_executions (standing_order_id, execution_date);
@@ -0,0 +1,3 @@
+alter table transactions
+    drop column reversed_transaction_id,
+    drop column reversal_reason;
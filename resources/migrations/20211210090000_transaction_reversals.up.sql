@@ -0,0 +1,6 @@
+-- a reversal is a transaction crediting back the bank account, linked to the transaction it reverses.
+alter table transactions
+    add column reversed_transaction_id INTEGER NOT NULL DEFAULT 0,
+    add column reversal_reason         TEXT    NOT NULL DEFAULT '';
+
+create index transactions_reversed_transaction_id_idx on transactions (reversed_transaction_id) where reversed_transaction_id <> 0;
@@ -0,0 +1,5 @@
+-- inbound credits are booked once per external reference of the credited bank account.
+alter table transactions
+    add column external_reference TEXT NOT NULL DEFAULT '';
+
+create unique index transactions_bank_account_id_external_reference_idx on transactions (bank_account_id, external_reference) where external_reference <> '';
@@ -0,0 +1,3 @@
+-- transfers between two bank accounts of the service, both the debit and the credit are tagged.
+alter table transactions
+    add column internal BOOLEAN NOT NULL DEFAULT false;
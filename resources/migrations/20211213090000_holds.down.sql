@@ -0,0 +1,8 @@
+alter table transactions
+    alter column counterparty_iban drop default,
+    alter column counterparty_bic drop default;
+
+drop table holds;
+
+alter table bank_accounts
+    drop column held_cents;
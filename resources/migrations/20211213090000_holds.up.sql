@@ -0,0 +1,29 @@
+-- amount reserved by the active holds, the available balance is the balance minus the held amount.
+alter table bank_accounts
+    add column held_cents BIGINT NOT NULL DEFAULT 0;
+
+create table holds
+(
+    id                serial primary key,
+    bank_account_id   INTEGER NOT NULL,
+    amount_cents      BIGINT NOT NULL,
+    currency          TEXT NOT NULL,
+    counterparty_name TEXT NOT NULL,
+    description       TEXT NOT NULL,
+    status            TEXT NOT NULL DEFAULT 'active',
+    captured_cents    BIGINT NOT NULL DEFAULT 0,
+    transaction_id    INTEGER NOT NULL DEFAULT 0,
+    expires_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (bank_account_id) REFERENCES bank_accounts (id)
+);
+
+-- workers claim the active holds which expiry is reached.
+create index holds_expires_at_idx on holds (expires_at, id) where status = 'active';
+
+-- the transactions capturing a hold have no counterparty iban and bic.
+alter table transactions
+    alter column counterparty_iban set default '',
+    alter column counterparty_bic set default '';
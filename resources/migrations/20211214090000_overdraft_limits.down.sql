@@ -0,0 +1,2 @@
+alter table bank_accounts
+    drop column overdraft_limit_cents;
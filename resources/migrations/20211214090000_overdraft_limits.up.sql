@@ -0,0 +1,3 @@
+-- agreed overdraft facility, the balance can go negative down to minus the limit.
+alter table bank_accounts
+    add column overdraft_limit_cents BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+drop index transactions_outgoing_created_at_idx;
+
+drop table transfer_limits;
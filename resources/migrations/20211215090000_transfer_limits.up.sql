@@ -0,0 +1,23 @@
+-- limits of the credit transfers of a bank account, or of all the bank accounts of an organization, 0 for no limit.
+create table transfer_limits
+(
+    id                 serial primary key,
+    bank_account_id    INTEGER NOT NULL DEFAULT 0,
+    organization_name  TEXT NOT NULL DEFAULT '',
+    max_transfer_cents BIGINT NOT NULL DEFAULT 0,
+    max_batch_cents    BIGINT NOT NULL DEFAULT 0,
+    daily_cents        BIGINT NOT NULL DEFAULT 0,
+    monthly_cents      BIGINT NOT NULL DEFAULT 0,
+    max_transfers      BIGINT NOT NULL DEFAULT 0,
+    window_seconds     BIGINT NOT NULL DEFAULT 0,
+    updated_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+
+    CHECK ((bank_account_id = 0) <> (organization_name = ''))
+);
+
+-- a bank account, or an organization, has a single set of limits.
+create unique index transfer_limits_bank_account_id_idx on transfer_limits (bank_account_id) where bank_account_id <> 0;
+create unique index transfer_limits_organization_name_idx on transfer_limits (organization_name) where organization_name <> '';
+
+-- the usage of the limits sums the debits of the bank account from the start of the month.
+create index transactions_outgoing_created_at_idx on transactions (bank_account_id, created_at) where debited_cents > 0;
@@ -0,0 +1,10 @@
+alter table transfer_bulk_jobs
+    drop column fees_cents;
+
+alter table transfer_bulks
+    drop column fees_cents;
+
+drop index transactions_charged_transaction_id_idx;
+
+alter table transactions
+    drop column charged_transaction_id;
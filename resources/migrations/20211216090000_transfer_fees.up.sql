@@ -0,0 +1,11 @@
+-- fees charged for the credit transfers, debited by a transaction linked to the transaction charged.
+alter table transactions
+    add column charged_transaction_id INTEGER NOT NULL DEFAULT 0;
+
+create index transactions_charged_transaction_id_idx on transactions (charged_transaction_id) where charged_transaction_id <> 0;
+
+alter table transfer_bulks
+    add column fees_cents BIGINT NOT NULL DEFAULT 0;
+
+alter table transfer_bulk_jobs
+    add column fees_cents BIGINT NOT NULL DEFAULT 0;
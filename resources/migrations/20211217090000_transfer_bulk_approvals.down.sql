@@ -0,0 +1,13 @@
+alter table standing_orders
+    drop column created_by;
+alter table transfer_bulk_jobs
+    drop column submitted_by;
+
+drop table transfer_bulk_decisions;
+
+alter table transfer_bulks
+    drop column submitted_by;
+alter table transfer_bulks
+    drop column request;
+alter table transfer_bulks
+    drop column status;
@@ -0,0 +1,26 @@
+-- transfer bulks exceeding the approval threshold are stored awaiting approval, their request performed on approval.
+alter table transfer_bulks
+    add column status TEXT NOT NULL DEFAULT 'executed';
+alter table transfer_bulks
+    add column request TEXT NOT NULL DEFAULT '';
+alter table transfer_bulks
+    add column submitted_by TEXT NOT NULL DEFAULT '';
+
+-- decision trail of the transfer bulks awaiting approval.
+create table transfer_bulk_decisions
+(
+    id               serial primary key,
+    transfer_bulk_id INTEGER NOT NULL,
+    decision         TEXT NOT NULL,
+    user_id          TEXT NOT NULL DEFAULT '',
+    reason           TEXT NOT NULL DEFAULT '',
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+create index transfer_bulk_decisions_transfer_bulk_id_idx on transfer_bulk_decisions (transfer_bulk_id, id);
+
+-- jobs and standing orders keep their submitter, the approval of their transfer bulks requiring a known submitter.
+alter table transfer_bulk_jobs
+    add column submitted_by TEXT NOT NULL DEFAULT '';
+alter table standing_orders
+    add column created_by TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+alter table transfer_bulks
+    drop column screening_hits;
@@ -0,0 +1,3 @@
+-- transfer bulks with counterparties matching the sanctions and block lists are held for review, with the matches.
+alter table transfer_bulks
+    add column screening_hits JSONB NOT NULL DEFAULT '[]';